@@ -0,0 +1,59 @@
+package gedcom
+
+// AnnulmentNode is the event of declaring a marriage void from the beginning
+// (never existed).
+type AnnulmentNode struct {
+	*SimpleNode
+}
+
+// NewAnnulmentNode creates a new ANUL node.
+func NewAnnulmentNode(value string, children ...Node) *AnnulmentNode {
+	return &AnnulmentNode{
+		newSimpleNode(TagAnnulment, value, "", children...),
+	}
+}
+
+// Dates returns zero or more dates associated with the annulment.
+//
+// When more than one date is returned you should not assume that the order has
+// any significance for the importance of the dates.
+//
+// If the node is nil the result will also be nil.
+func (node *AnnulmentNode) Dates() DateNodes {
+	return Dates(node)
+}
+
+// Places returns zero or more places associated with the annulment.
+//
+// If the node is nil the result will also be nil.
+func (node *AnnulmentNode) Places() []*PlaceNode {
+	return Places(node)
+}
+
+// Equal will always return true if both nodes are not nil.
+//
+// If either node is nil (including both) or if the right side is not a
+// AnnulmentNode then false is always returned. Otherwise Equals will always return
+// true.
+//
+// The reason Equals always returns true is because Equals is a shallow test and
+// a family is expected to only have one annulment event. Therefore it is safe to
+// assume that annulment events themselves are equal, even if the children they
+// contain are not.
+//
+// This logic is especially important for CompareNodes.
+func (node *AnnulmentNode) Equals(node2 Node) bool {
+	if IsNil(node) {
+		return false
+	}
+
+	if IsNil(node2) {
+		return false
+	}
+
+	if _, ok := node2.(*AnnulmentNode); !ok {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,68 @@
+package gedcom_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAnnulmentNode(t *testing.T) {
+	child := gedcom.NewDateNode("")
+	node := gedcom.NewAnnulmentNode("foo", child)
+
+	assert.Equal(t, gedcom.TagAnnulment, node.Tag())
+	assert.Equal(t, gedcom.Nodes{child}, node.Nodes())
+	assert.Equal(t, "foo", node.Value())
+	assert.Equal(t, "", node.Pointer())
+}
+
+func TestAnnulmentNode_Dates(t *testing.T) {
+	Dates := tf.NamedFunction(t, "AnnulmentNode_Dates", (*gedcom.AnnulmentNode).Dates)
+
+	Dates((*gedcom.AnnulmentNode)(nil)).Returns([]*gedcom.DateNode(nil))
+
+	Dates(gedcom.NewAnnulmentNode("")).Returns([]*gedcom.DateNode(nil))
+
+	Dates(gedcom.NewAnnulmentNode("",
+		gedcom.NewDateNode("3 Sep 2001"),
+		gedcom.NewPlaceNode("Sydney"),
+	)).Returns([]*gedcom.DateNode{
+		gedcom.NewDateNode("3 Sep 2001"),
+	})
+}
+
+func TestAnnulmentNode_Places(t *testing.T) {
+	Places := tf.NamedFunction(t, "AnnulmentNode_Places", (*gedcom.AnnulmentNode).Places)
+
+	Places((*gedcom.AnnulmentNode)(nil)).Returns([]*gedcom.PlaceNode(nil))
+
+	Places(gedcom.NewAnnulmentNode("")).Returns([]*gedcom.PlaceNode(nil))
+
+	Places(gedcom.NewAnnulmentNode("",
+		gedcom.NewDateNode("3 Sep 2001"),
+		gedcom.NewPlaceNode("Sydney"),
+	)).Returns([]*gedcom.PlaceNode{
+		gedcom.NewPlaceNode("Sydney"),
+	})
+}
+
+func TestAnnulmentNode_Equals(t *testing.T) {
+	Equals := tf.Function(t, (*gedcom.AnnulmentNode).Equals)
+
+	n1 := gedcom.NewAnnulmentNode("foo")
+	n2 := gedcom.NewAnnulmentNode("bar")
+
+	// nils
+	Equals((*gedcom.AnnulmentNode)(nil), (*gedcom.AnnulmentNode)(nil)).Returns(false)
+	Equals(n1, (*gedcom.AnnulmentNode)(nil)).Returns(false)
+	Equals((*gedcom.AnnulmentNode)(nil), n1).Returns(false)
+
+	// Wrong node type.
+	Equals(n1, gedcom.NewNameNode("foo")).Returns(false)
+
+	// All other cases are success.
+	Equals(n1, n1).Returns(true)
+	Equals(n1, n2).Returns(true)
+}
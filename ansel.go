@@ -0,0 +1,168 @@
+package gedcom
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// anselCharacters maps the non-combining characters in the upper half of ANSEL
+// to Unicode. This includes the extensions defined by GEDCOM 5.5 (0xBE, 0xBF,
+// 0xCD, 0xCE and 0xCF) and MARC-21 (0xC7 and 0xC8).
+var anselCharacters = map[byte]rune{
+	0xA1: 'Ł', 0xA2: 'Ø', 0xA3: 'Đ', 0xA4: 'Þ', 0xA5: 'Æ', 0xA6: 'Œ',
+	0xA7: 'ʹ', 0xA8: '·', 0xA9: '♭', 0xAA: '®', 0xAB: '±', 0xAC: 'Ơ',
+	0xAD: 'Ư', 0xAE: 'ʼ', 0xB0: 'ʻ', 0xB1: 'ł', 0xB2: 'ø', 0xB3: 'đ',
+	0xB4: 'þ', 0xB5: 'æ', 0xB6: 'œ', 0xB7: 'ʺ', 0xB8: 'ı', 0xB9: '£',
+	0xBA: 'ð', 0xBC: 'ơ', 0xBD: 'ư', 0xBE: '□', 0xBF: '■', 0xC0: '°',
+	0xC1: 'ℓ', 0xC2: '℗', 0xC3: '©', 0xC4: '♯', 0xC5: '¿', 0xC6: '¡',
+	0xC7: 'ß', 0xC8: '€', 0xCD: 'e', 0xCE: 'o', 0xCF: 'ß',
+}
+
+// anselCombining maps the ANSEL combining diacritics to the equivalent Unicode
+// combining characters.
+var anselCombining = map[byte]rune{
+	0xE0: '̉', // hook above
+	0xE1: '̀', // grave
+	0xE2: '́', // acute
+	0xE3: '̂', // circumflex
+	0xE4: '̃', // tilde
+	0xE5: '̄', // macron
+	0xE6: '̆', // breve
+	0xE7: '̇', // dot above
+	0xE8: '̈', // diaeresis
+	0xE9: '̌', // caron
+	0xEA: '̊', // ring above
+	0xEB: '︠', // ligature, left half
+	0xEC: '︡', // ligature, right half
+	0xED: '̕', // comma above right
+	0xEE: '̋', // double acute
+	0xEF: '̐', // candrabindu
+	0xF0: '̧', // cedilla
+	0xF1: '̨', // ogonek
+	0xF2: '̣', // dot below
+	0xF3: '̤', // double dot below
+	0xF4: '̥', // ring below
+	0xF5: '̳', // double underscore
+	0xF6: '̲', // underscore
+	0xF7: '̦', // comma below
+	0xF8: '̜', // right cedilla
+	0xF9: '̮', // breve below
+	0xFA: '︢', // double tilde, left half
+	0xFB: '︣', // double tilde, right half
+	0xFE: '̓', // comma above
+}
+
+// anselReverse is the reverse of anselCharacters and anselCombining. It is
+// built once when the package is initialised.
+var anselReverse = map[rune]byte{}
+
+func init() {
+	for b, r := range anselCombining {
+		anselReverse[r] = b
+	}
+
+	for b, r := range anselCharacters {
+		// 0xCD and 0xCE (midline e and o) would otherwise replace the ASCII
+		// characters. 0xC7 is preferred for ß because it is more widely
+		// supported.
+		if r < utf8.RuneSelf || b == 0xCF {
+			continue
+		}
+
+		anselReverse[r] = b
+	}
+}
+
+// decodeANSEL converts an ANSEL string to UTF-8.
+//
+// ANSEL combining diacritics precede the character they modify whereas in
+// Unicode they follow it. The result is normalized (NFC) so that characters
+// with diacritics will be represented by a single code point where possible.
+//
+// Any bytes that are not valid ANSEL are replaced with the Unicode replacement
+// character.
+func decodeANSEL(s string) string {
+	buf := strings.Builder{}
+	var pending []rune
+
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+
+		if r, ok := anselCombining[b]; ok {
+			pending = append(pending, r)
+			continue
+		}
+
+		switch r, ok := anselCharacters[b]; {
+		case b < utf8.RuneSelf:
+			buf.WriteByte(b)
+
+		case ok:
+			buf.WriteRune(r)
+
+		default:
+			buf.WriteRune(utf8.RuneError)
+		}
+
+		for _, r := range pending {
+			buf.WriteRune(r)
+		}
+
+		pending = nil
+	}
+
+	// Diacritics at the end of the line have nothing to combine with.
+	for _, r := range pending {
+		buf.WriteRune(r)
+	}
+
+	return norm.NFC.String(buf.String())
+}
+
+// encodeANSEL converts a UTF-8 string to ANSEL.
+//
+// Characters are decomposed (NFD) so that diacritics can be written before the
+// character they modify. Any character that cannot be represented in ANSEL
+// will be replaced with a "?".
+func encodeANSEL(s string) []byte {
+	var result, pending []byte
+
+	flush := func() {
+		result = append(result, pending...)
+		pending = nil
+	}
+
+	for _, r := range norm.NFD.String(s) {
+		if b, ok := anselReverse[r]; ok && b >= 0xE0 {
+			// The combining character must be moved in front of the
+			// character it belongs to, which has already been written.
+			if len(pending) == 0 {
+				result = append(result, b)
+			} else {
+				pending = append(pending[:len(pending)-1], b,
+					pending[len(pending)-1])
+			}
+
+			continue
+		}
+
+		flush()
+
+		switch b, ok := anselReverse[r]; {
+		case r < utf8.RuneSelf:
+			pending = append(pending, byte(r))
+
+		case ok:
+			pending = append(pending, b)
+
+		default:
+			pending = append(pending, '?')
+		}
+	}
+
+	flush()
+
+	return result
+}
@@ -0,0 +1,148 @@
+// Character Sets
+//
+// The Decoder will detect the character set of a GEDCOM stream from the Byte
+// Order Mark (if any) and then from the CHAR in the HEAD record:
+//
+//   0 HEAD
+//   1 CHAR ANSEL
+//
+// All values are converted to UTF-8 so no special handling is needed once the
+// Document has been decoded. The character set that was detected is recorded
+// in Document.CharacterSet.
+//
+// The Encoder will write the Document in Encoder.CharacterSet (or the
+// Document.CharacterSet if that is not set). The CHAR in the HEAD is rewritten
+// to match the character set that is used.
+package gedcom
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// CharacterSet is the encoding used by a GEDCOM stream. It is represented by
+// the CHAR node in the HEAD.
+type CharacterSet string
+
+const (
+	// CharacterSetUTF8 is the default character set if one cannot be
+	// determined.
+	CharacterSetUTF8 = CharacterSet("UTF-8")
+
+	// CharacterSetUnicode is UTF-16. The byte order is determined by the Byte
+	// Order Mark when decoding. When encoding it will always be written as
+	// little endian with a Byte Order Mark.
+	CharacterSetUnicode = CharacterSet("UNICODE")
+
+	// CharacterSetANSEL is the American National Standard for Extended Latin
+	// Alphabet Coded Character Set for Bibliographic Use (ANSI Z39.47). It was
+	// the default character set for GEDCOM 5.5.
+	//
+	// Unlike Unicode, the combining diacritics of ANSEL appear before the
+	// character they modify.
+	CharacterSetANSEL = CharacterSet("ANSEL")
+
+	// CharacterSetASCII is 7-bit ASCII. Any characters that cannot be
+	// represented when encoding will have their diacritics removed, or
+	// otherwise be replaced with a "?".
+	CharacterSetASCII = CharacterSet("ASCII")
+)
+
+// CharacterSetFromString returns the CharacterSet for a CHAR value. It is not
+// case sensitive and accepts some common variations, like "UTF8" and "UTF-16".
+//
+// An empty CharacterSet is returned if the value is not recognised.
+func CharacterSetFromString(s string) CharacterSet {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "UTF-8", "UTF8":
+		return CharacterSetUTF8
+
+	case "UNICODE", "UTF-16", "UTF16":
+		return CharacterSetUnicode
+
+	case "ANSEL":
+		return CharacterSetANSEL
+
+	case "ASCII", "US-ASCII":
+		return CharacterSetASCII
+	}
+
+	return ""
+}
+
+// String returns the value used by the CHAR node.
+func (charset CharacterSet) String() string {
+	return string(charset)
+}
+
+// orUTF8 returns CharacterSetUTF8 if the character set is empty, since values
+// are not converted in that case.
+func (charset CharacterSet) orUTF8() CharacterSet {
+	if charset == "" {
+		return CharacterSetUTF8
+	}
+
+	return charset
+}
+
+// decodeLine converts a single line that was read in this character set into
+// UTF-8.
+//
+// UTF-16 is not handled here because it must be converted before lines can be
+// separated.
+func (charset CharacterSet) decodeLine(line string) string {
+	if charset == CharacterSetANSEL {
+		return decodeANSEL(line)
+	}
+
+	return line
+}
+
+// encodeLine converts a UTF-8 line into this character set.
+func (charset CharacterSet) encodeLine(line string) []byte {
+	switch charset {
+	case CharacterSetANSEL:
+		return encodeANSEL(line)
+
+	case CharacterSetASCII:
+		return encodeASCII(line)
+
+	case CharacterSetUnicode:
+		return encodeUTF16LE(line)
+	}
+
+	return []byte(line)
+}
+
+func encodeASCII(s string) []byte {
+	var result []byte
+
+	for _, r := range norm.NFD.String(s) {
+		switch {
+		case r < utf8.RuneSelf:
+			result = append(result, byte(r))
+
+		case unicode.Is(unicode.Mn, r):
+			// Drop the diacritic and keep the base character.
+
+		default:
+			result = append(result, '?')
+		}
+	}
+
+	return result
+}
+
+func encodeUTF16LE(s string) []byte {
+	var result []byte
+
+	for _, c := range utf16.Encode([]rune(s)) {
+		result = append(result, byte(c), byte(c>>8))
+	}
+
+	return result
+}
@@ -0,0 +1,22 @@
+package gedcom_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/tf"
+)
+
+func TestCharacterSetFromString(t *testing.T) {
+	CharacterSetFromString := tf.Function(t, gedcom.CharacterSetFromString)
+
+	CharacterSetFromString("UTF-8").Returns(gedcom.CharacterSetUTF8)
+	CharacterSetFromString("utf8").Returns(gedcom.CharacterSetUTF8)
+	CharacterSetFromString("UNICODE").Returns(gedcom.CharacterSetUnicode)
+	CharacterSetFromString("UTF-16").Returns(gedcom.CharacterSetUnicode)
+	CharacterSetFromString("ANSEL").Returns(gedcom.CharacterSetANSEL)
+	CharacterSetFromString(" ansel ").Returns(gedcom.CharacterSetANSEL)
+	CharacterSetFromString("ASCII").Returns(gedcom.CharacterSetASCII)
+	CharacterSetFromString("IBMPC").Returns(gedcom.CharacterSet(""))
+	CharacterSetFromString("").Returns(gedcom.CharacterSet(""))
+}
@@ -2,12 +2,13 @@ package gedcom
 
 type ChildNodes []*ChildNode
 
+// Individuals returns the individual of each child. Children that do not point
+// to an individual, such as a GEDCOM 7.0 "@VOID@" pointer, are not included.
 func (nodes ChildNodes) Individuals() (individuals IndividualNodes) {
 	for _, child := range nodes {
-		pointer := valueToPointer(child.Value())
-		individual := nodes[0].Family().Document().NodeByPointer(pointer)
-
-		individuals = append(individuals, individual.(*IndividualNode))
+		if individual := child.Individual(); individual != nil {
+			individuals = append(individuals, individual)
+		}
 	}
 
 	return
@@ -5,6 +5,9 @@
 //
 //   gedcom diff -left-gedcom file1.ged -right-gedcom file2.ged -output out.html
 //
+// Gramps databases (".gramps" files) and GEDCOM X JSON can be used in place of
+// either GEDCOM file.
+//
 // For a complete list of options use:
 //
 //   gedcom diff -help
@@ -17,20 +20,52 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/cheggaaa/pb"
 	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/gedcomx"
+	"github.com/elliotchance/gedcom/v39/gramps"
 	"github.com/elliotchance/gedcom/v39/html"
 	"github.com/elliotchance/gedcom/v39/util"
 )
 
+// isGrampsFile is true for a Gramps database, which must be read with the
+// gramps package rather than the gedcom.Decoder.
+func isGrampsFile(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".gramps")
+}
+
+// isGEDCOMXFile is true for GEDCOM X JSON, which must be read with the gedcomx
+// package rather than the gedcom.Decoder. The contents of the file are checked
+// because other JSON files (such as the mapping for import-csv) are not GEDCOM
+// X.
+func isGEDCOMXFile(path string) bool {
+	file, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	return gedcomx.IsGEDCOMX(file)
+}
+
 func newDocumentFromGEDCOMFile(path string, optionAllowMultiLine, optionAllowInvalidIndents bool) (*gedcom.Document, error) {
 	file, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
 
+	switch {
+	case isGrampsFile(path):
+		return gramps.NewDecoder(file).Decode()
+
+	case isGEDCOMXFile(path):
+		return gedcomx.NewDecoder(file).Decode()
+	}
+
 	decoder := gedcom.NewDecoder(file)
 	decoder.AllowMultiLine = optionAllowMultiLine
 	decoder.AllowInvalidIndents = optionAllowInvalidIndents
@@ -55,10 +90,10 @@ func runDiffCommand() {
 
 	// Input files. Must be provided.
 	flag.StringVar(&optionLeftGedcomFile, "left-gedcom", "",
-		"Required. Left GEDCOM file.")
+		"Required. Left GEDCOM (or .gramps) file.")
 
 	flag.StringVar(&optionRightGedcomFile, "right-gedcom", "",
-		"Required. Right GEDCOM file.")
+		"Required. Right GEDCOM (or .gramps) file.")
 
 	flag.StringVar(&optionOutputFile, "output", "", "Output file.")
 
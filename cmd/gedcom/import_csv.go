@@ -0,0 +1,146 @@
+// "gedcom import-csv" creates individuals and families from a CSV file, such
+// as a spreadsheet that has been exported.
+//
+// Usage
+//
+//   gedcom import-csv -mapping mapping.json -output family.ged people.csv
+//
+// The first row of the CSV file must contain the name of each column. The
+// mapping file describes which column contains each fact (see
+// gedcom.CSVMapping), for example:
+//
+//   {
+//     "ID": "Ref",
+//     "Name": "Full name",
+//     "BirthDate": "Born",
+//     "FatherID": "Father",
+//     "MotherID": "Mother",
+//     "SpouseIDs": "Spouses"
+//   }
+//
+// If -mapping is not provided the columns are named like "id", "name",
+// "birth_date", "father_id" and "spouse_ids" (see gedcom.DefaultCSVMapping).
+//
+// The individuals can also be added to an existing GEDCOM file with -gedcom.
+// The parents and spouses may then refer to individuals in that file.
+//
+// Rows that could not be imported or linked are reported as warnings on stderr.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"unicode/utf8"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/util"
+)
+
+func runImportCSVCommand() {
+	var optionMappingFile string
+	var optionGedcomFile string
+	var optionOutputFile string
+	var optionComma string
+
+	flag.StringVar(&optionMappingFile, "mapping", "", util.CLIDescription(`
+		JSON file that contains the name of the column for each fact. The
+		default column names are used if mapping is not provided.`))
+
+	flag.StringVar(&optionGedcomFile, "gedcom", "", util.CLIDescription(`
+		Existing GEDCOM (or .gramps) file to add the individuals to.`))
+
+	flag.StringVar(&optionOutputFile, "output", "", util.CLIDescription(`
+		Output GEDCOM file. The file is written to stdout if output is not
+		provided.`))
+
+	flag.StringVar(&optionComma, "comma", ",", util.CLIDescription(`
+		The character that separates each field, like ";".`))
+
+	err := flag.CommandLine.Parse(os.Args[2:])
+	if err != nil {
+		fatalln(err)
+	}
+
+	csvFile := flag.Arg(0)
+	if csvFile == "" {
+		fatalln("you must provide a CSV file")
+	}
+
+	comma, size := utf8.DecodeRuneInString(optionComma)
+	if size == 0 || size != len(optionComma) {
+		fatalln("comma must be a single character")
+	}
+
+	mapping, err := csvMapping(optionMappingFile)
+	if err != nil {
+		fatalln(err)
+	}
+
+	document := gedcom.NewDocument()
+	if optionGedcomFile != "" {
+		document, err = newDocumentFromGEDCOMFile(optionGedcomFile, false, false)
+		if err != nil {
+			fatalln(err)
+		}
+	}
+
+	file, err := os.Open(csvFile)
+	if err != nil {
+		fatalln(err)
+	}
+	defer file.Close()
+
+	importer := gedcom.NewCSVImporter(file, mapping)
+	importer.Comma = comma
+	importer.IgnoreMissingColumns = optionMappingFile == ""
+
+	warnings, err := importer.Import(document)
+	if err != nil {
+		fatalln(err)
+	}
+
+	for _, warning := range warnings {
+		fmt.Fprintln(os.Stderr, warning)
+	}
+
+	var output io.Writer = os.Stdout
+	if optionOutputFile != "" {
+		outputFile, err := os.Create(optionOutputFile)
+		if err != nil {
+			fatalln(err)
+		}
+		defer outputFile.Close()
+
+		output = outputFile
+	}
+
+	encoder := gedcom.NewEncoder(output, document)
+	encoder.RegenerateHeader = true
+
+	err = encoder.Encode()
+	if err != nil {
+		fatalln(err)
+	}
+}
+
+// csvMapping loads the mapping from a JSON file. DefaultCSVMapping is used if
+// the path is empty.
+func csvMapping(path string) (gedcom.CSVMapping, error) {
+	if path == "" {
+		return gedcom.DefaultCSVMapping, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return gedcom.CSVMapping{}, err
+	}
+	defer file.Close()
+
+	var mapping gedcom.CSVMapping
+	err = json.NewDecoder(file).Decode(&mapping)
+
+	return mapping, err
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Control characters read from the terminal.
+const (
+	keyCtrlC     = 3
+	keyCtrlD     = 4
+	keyBackspace = 8
+	keyTab       = 9
+	keyNewLine   = 10
+	keyReturn    = 13
+	keyCtrlU     = 21
+	keyEscape    = 27
+	keyDelete    = 127
+)
+
+var lastWordRegexp = regexp.MustCompile(`\.?[a-zA-Z0-9_]*$`)
+
+// lineReader reads one line at a time with basic editing, history (the up and
+// down arrows) and tab completion.
+//
+// The terminal is put into raw mode with stty while a line is being read. If
+// that is not possible, such as when the input is not a terminal, the lines
+// are read without any editing.
+type lineReader struct {
+	input    *bufio.Reader
+	complete func(line string) []string
+	history  []string
+}
+
+func newLineReader(input io.Reader, complete func(line string) []string) *lineReader {
+	return &lineReader{
+		input:    bufio.NewReader(input),
+		complete: complete,
+	}
+}
+
+// ReadLine returns io.EOF when there are no more lines.
+func (r *lineReader) ReadLine(prompt string) (string, error) {
+	fmt.Print(prompt)
+
+	state, err := stty("-g")
+	if err != nil {
+		return r.readPlainLine()
+	}
+
+	_, err = stty("-icanon", "-echo", "-isig", "min", "1")
+	if err != nil {
+		return r.readPlainLine()
+	}
+
+	defer stty(strings.TrimSpace(state))
+
+	line, err := r.readRawLine(prompt)
+	if err == nil && line != "" {
+		r.history = append(r.history, line)
+	}
+
+	return line, err
+}
+
+func (r *lineReader) readPlainLine() (string, error) {
+	line, err := r.input.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+
+	return strings.TrimRight(line, "\r\n"), err
+}
+
+func (r *lineReader) readRawLine(prompt string) (string, error) {
+	line := ""
+	historyIndex := len(r.history)
+
+	redraw := func() {
+		// Return to the start of the line and clear it.
+		fmt.Print("\r\033[K", prompt, line)
+	}
+
+	for {
+		c, err := r.input.ReadByte()
+		if err != nil {
+			return "", err
+		}
+
+		switch c {
+		case keyReturn, keyNewLine:
+			fmt.Println()
+
+			return line, nil
+
+		case keyCtrlD:
+			if line == "" {
+				fmt.Println()
+
+				return "", io.EOF
+			}
+
+		case keyCtrlC:
+			fmt.Println("^C")
+			line = ""
+			historyIndex = len(r.history)
+			redraw()
+
+		case keyCtrlU:
+			line = ""
+			redraw()
+
+		case keyBackspace, keyDelete:
+			if line != "" {
+				_, size := utf8.DecodeLastRuneInString(line)
+				line = line[:len(line)-size]
+				redraw()
+			}
+
+		case keyTab:
+			line = r.completeLine(line)
+			redraw()
+
+		case keyEscape:
+			// Arrow keys are sent as "ESC [ A" (up) and "ESC [ B" (down).
+			sequence := make([]byte, 2)
+			if _, err := io.ReadFull(r.input, sequence); err != nil {
+				return "", err
+			}
+
+			switch {
+			case string(sequence) == "[A" && historyIndex > 0:
+				historyIndex--
+				line = r.history[historyIndex]
+
+			case string(sequence) == "[B" && historyIndex < len(r.history):
+				historyIndex++
+				line = ""
+				if historyIndex < len(r.history) {
+					line = r.history[historyIndex]
+				}
+			}
+
+			redraw()
+
+		default:
+			// Other control characters are ignored. Bytes of multibyte
+			// characters are always 128 or greater.
+			if c >= 32 {
+				line += string([]byte{c})
+				os.Stdout.Write([]byte{c})
+			}
+		}
+	}
+}
+
+// completeLine returns the line completed as far as possible. If there is more
+// than one choice they are all shown.
+func (r *lineReader) completeLine(line string) string {
+	choices := r.complete(line)
+
+	switch len(choices) {
+	case 0:
+		// Ring the bell.
+		fmt.Print("\a")
+
+		return line
+
+	case 1:
+		return choices[0]
+	}
+
+	common := commonPrefix(choices)
+	if len(common) > len(line) {
+		return common
+	}
+
+	words := []string{}
+	for _, choice := range choices {
+		words = append(words, lastWordRegexp.FindString(choice))
+	}
+
+	fmt.Printf("\n%s\n", strings.Join(words, "  "))
+
+	return line
+}
+
+func commonPrefix(values []string) string {
+	prefix := values[0]
+
+	for _, value := range values[1:] {
+		for !strings.HasPrefix(value, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	return prefix
+}
+
+// stty changes the settings of the terminal connected to stdin.
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	output, err := cmd.Output()
+
+	return string(output), err
+}
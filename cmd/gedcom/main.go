@@ -21,6 +21,8 @@ func usage() string {
 	lines := []string{
 		"Missing command, use one of:",
 		fmt.Sprintf("\t%s diff      - Compare gedcom files", os.Args[0]),
+		fmt.Sprintf("\t%s import-csv - Create individuals from a CSV file", os.Args[0]),
+		fmt.Sprintf("\t%s normalize-dates - Rewrite dates in the standard GEDCOM form", os.Args[0]),
 		fmt.Sprintf("\t%s publish   - Publish as HTML", os.Args[0]),
 		fmt.Sprintf("\t%s query     - Query with gedcomq", os.Args[0]),
 		fmt.Sprintf("\t%s tune      - Used to calculate ideal weights and similarities", os.Args[0]),
@@ -40,6 +42,12 @@ func main() {
 	case "diff":
 		runDiffCommand()
 
+	case "import-csv":
+		runImportCSVCommand()
+
+	case "normalize-dates":
+		runNormalizeDatesCommand()
+
 	case "publish":
 		runPublishCommand()
 
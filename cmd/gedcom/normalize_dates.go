@@ -0,0 +1,112 @@
+// "gedcom normalize-dates" rewrites every DATE in a GEDCOM file to the strict
+// form of the GEDCOM standard, such as "ABT 3 MAR 1890".
+//
+// Usage
+//
+//   gedcom normalize-dates -output normalized.ged file.ged
+//
+// Dates that cannot be normalized without losing some of their meaning are not
+// changed. They are reported as warnings on stderr instead.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/util"
+)
+
+func runNormalizeDatesCommand() {
+	var optionOutputFile string
+	var optionDateLocale string
+
+	flag.StringVar(&optionOutputFile, "output", "", util.CLIDescription(`
+		Output GEDCOM file. The normalized file is written to stdout if output
+		is not provided.`))
+
+	flag.StringVar(&optionDateLocale, "date-locale", "", util.CLIDescription(`
+		The language that dates may also be written in, like "nl". These dates
+		will be translated into English.`))
+
+	err := flag.CommandLine.Parse(os.Args[2:])
+	if err != nil {
+		fatalln(err)
+	}
+
+	gedcomFile := flag.Arg(0)
+	if gedcomFile == "" {
+		fatalln("you must provide a gedcom file")
+	}
+
+	file, err := os.Open(gedcomFile)
+	if err != nil {
+		fatalln(err)
+	}
+	defer file.Close()
+
+	decoder := gedcom.NewDecoder(file)
+	if optionDateLocale != "" {
+		dateLocale := gedcom.DateLocaleForLanguage(optionDateLocale)
+		if dateLocale == nil {
+			fatalln("unknown date-locale:", optionDateLocale)
+		}
+
+		decoder.DateLocales = []*gedcom.DateLocale{dateLocale}
+	}
+
+	document, err := decoder.Decode()
+	if err != nil {
+		fatalln(err)
+	}
+
+	var warnings gedcom.Warnings
+	normalized := normalizeDates(document, &warnings)
+
+	for _, warning := range warnings {
+		fmt.Fprintln(os.Stderr, warning)
+	}
+
+	var output io.Writer = os.Stdout
+	if optionOutputFile != "" {
+		outputFile, err := os.Create(optionOutputFile)
+		if err != nil {
+			fatalln(err)
+		}
+		defer outputFile.Close()
+
+		output = outputFile
+	}
+
+	encoder := gedcom.NewEncoder(output, normalized)
+	err = encoder.Encode()
+	if err != nil {
+		fatalln(err)
+	}
+}
+
+// normalizeDates returns a copy of the document with the DATE values
+// normalized. The original document is not modified.
+func normalizeDates(document *gedcom.Document, warnings *gedcom.Warnings) *gedcom.Document {
+	filter := gedcom.NormalizeDatesFilter(warnings)
+
+	// Filter will add the families that are referenced by husband, wife and
+	// child nodes to the document it is given. These must not be added to the
+	// result a second time.
+	scratch := gedcom.NewDocument()
+
+	nodes := gedcom.Nodes{}
+	for _, node := range document.Nodes() {
+		nodes = append(nodes, gedcom.Filter(node, scratch, filter))
+	}
+
+	normalized := gedcom.NewDocumentWithNodes(nodes)
+	normalized.HasBOM = document.HasBOM
+	normalized.CharacterSet = document.CharacterSet
+	normalized.Version = document.Version
+	normalized.LineEnding = document.LineEnding
+
+	return normalized
+}
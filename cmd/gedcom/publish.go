@@ -5,6 +5,9 @@
 //
 //   gedcom publish -gedcom file.ged
 //
+// A Gramps database (a ".gramps" file) can be used in place of the GEDCOM
+// file.
+//
 // You can view the full list of options using:
 //
 //   gedcom publish -help
@@ -15,8 +18,10 @@ import (
 	"flag"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/gramps"
 	"github.com/elliotchance/gedcom/v39/html"
 	"github.com/elliotchance/gedcom/v39/html/core"
 	"github.com/elliotchance/gedcom/v39/util"
@@ -28,6 +33,8 @@ func runPublishCommand() {
 	var optionGoogleAnalyticsID string
 	var optionLivingVisibility string
 	var optionJobs int
+	var optionDateLocale string
+	var optionRoot string
 
 	var optionNoIndividuals bool
 	var optionNoPlaces bool
@@ -36,7 +43,7 @@ func runPublishCommand() {
 	var optionNoSources bool
 	var optionNoStatistics bool
 
-	flag.StringVar(&optionGedcomFile, "gedcom", "", "Input GEDCOM file.")
+	flag.StringVar(&optionGedcomFile, "gedcom", "", "Input GEDCOM (or .gramps) file.")
 
 	flag.StringVar(&optionOutputDir, "output-dir", ".", "Output directory. It"+
 		" will use the current directory if output-dir is not provided. "+
@@ -62,6 +69,15 @@ func runPublishCommand() {
 			"website faster. An ideal value would be the number of CPUs "+
 			"available, if you can spare it.")
 
+	flag.StringVar(&optionDateLocale, "date-locale", "", util.CLIDescription(`
+			The language to render dates in, like "nl". Dates that are
+			written in this language will also be understood. Dates are
+			rendered in English if date-locale is not provided.`))
+
+	flag.StringVar(&optionRoot, "root", "", util.CLIDescription(`
+			The pointer of an individual, like "P1". Each individual page
+			will show how they are related to this individual.`))
+
 	flag.BoolVar(&optionNoIndividuals, "no-individuals", false,
 		"Exclude Individuals.")
 
@@ -94,12 +110,39 @@ func runPublishCommand() {
 		fatalln(err)
 	}
 
-	decoder := gedcom.NewDecoder(file)
-	document, err := decoder.Decode()
+	var dateLocale *gedcom.DateLocale
+	if optionDateLocale != "" {
+		dateLocale = gedcom.DateLocaleForLanguage(optionDateLocale)
+		if dateLocale == nil {
+			fatalln("unknown date-locale:", optionDateLocale)
+		}
+	}
+
+	var document *gedcom.Document
+	if isGrampsFile(optionGedcomFile) {
+		document, err = gramps.NewDecoder(file).Decode()
+	} else {
+		decoder := gedcom.NewDecoder(file)
+		if dateLocale != nil {
+			decoder.DateLocales = []*gedcom.DateLocale{dateLocale}
+		}
+
+		document, err = decoder.Decode()
+	}
+
 	if err != nil {
 		fatalln(err)
 	}
 
+	var root *gedcom.IndividualNode
+	if optionRoot != "" {
+		pointer := strings.Trim(optionRoot, "@")
+		root = document.Individuals().ByPointer(pointer)
+		if root == nil {
+			fatalln("no individual with pointer", pointer)
+		}
+	}
+
 	options := &html.PublishShowOptions{
 		ShowIndividuals:  !optionNoIndividuals,
 		ShowPlaces:       !optionNoPlaces,
@@ -108,6 +151,8 @@ func runPublishCommand() {
 		ShowSources:      !optionNoSources,
 		ShowStatistics:   !optionNoStatistics,
 		LivingVisibility: html.NewLivingVisibility(optionLivingVisibility),
+		DateLocale:       dateLocale,
+		RootIndividual:   root,
 	}
 
 	writer := core.NewDirectoryFileWriter(optionOutputDir)
@@ -8,10 +8,40 @@
 // You can find the full language documentation in the q package:
 //
 // https://godoc.org/github.com/elliotchance/gedcom/q
+//
+// Very large files can be queried with "-stream". Each record is read and
+// evaluated on its own, as if it were the only record in the document, so the
+// result is written once for every record:
+//
+//   gedcom query -stream -gedcom huge.ged '.Individuals | .Name'
+//
+// Only the "json" and "gedcom" formats can be used with "-stream". The JSON is
+// written as JSON Lines, that is one JSON value on each line for each record,
+// rather than a single JSON document.
+//
+// GEDCOM X JSON and Gramps (".gramps") files can be queried in the same way
+// as GEDCOM files. The result can also be written as GEDCOM X:
+//
+//   gedcom query -gedcom family.json -format gedcomx '.Individuals'
+//
+// Results can be opened in a spreadsheet with "-format xlsx", or pasted into a
+// wiki with "-format markdown":
+//
+//   gedcom query -gedcom file.ged -format xlsx '.Individuals | { name: .Name | .String }' > names.xlsx
+//
+// With "-interactive" the documents are loaded once and queries are read one
+// line at a time. Variables are kept between lines and the tab key completes
+// accessors, functions and variables:
+//
+//   gedcom query -interactive -gedcom file.ged
+//
+// Results are shown as a table when possible. Use ":format json" (or any other
+// format) to change it, or ":help" to see all of the commands.
 package main
 
 import (
 	"flag"
+	"io"
 	"os"
 
 	"github.com/elliotchance/gedcom/v39"
@@ -22,15 +52,38 @@ import (
 func runQueryCommand() {
 	var gedcomFiles util.CLIStringSlice
 	var format string
+	var optionStream bool
+	var optionResolvePointers bool
+	var optionInteractive bool
 
 	flag.Var(&gedcomFiles, "gedcom", util.CLIDescription(`
 		Path to the GEDCOM file. You may specify more than one document by
 		providing -gedcom with an argument multiple times. You must provide at
-		least one document.`))
+		least one document. Files that contain GEDCOM X JSON (with "persons"
+		or "relationships") are read as GEDCOM X and files ending with
+		".gramps" are read as Gramps XML.`))
 
 	flag.StringVar(&format, "format", "json", util.CLIDescription(`
 		Output format, can be one of the following: "json", "pretty-json",
-		"gedcom" or "csv".`))
+		"yaml", "gedcom", "gedcomx", "csv", "tsv", "markdown", "xlsx", "html"
+		or "table". The default is "table" with "-interactive".`))
+
+	flag.BoolVar(&optionStream, "stream", false, util.CLIDescription(`
+		Read and evaluate one record at a time rather than loading the whole
+		file. This allows very large files to be queried in constant memory.
+		The result is written for each record, so only the "json" format
+		(which is written as JSON Lines, one result per line) and "gedcom"
+		format can be used.`))
+
+	flag.BoolVar(&optionResolvePointers, "resolve-pointers", false,
+		util.CLIDescription(`
+		When used with "-stream" pointers to other records will be resolved.
+		Records that have a pointer are kept in memory.`))
+
+	flag.BoolVar(&optionInteractive, "interactive", false,
+		util.CLIDescription(`
+		Read queries one line at a time from the terminal. The documents are
+		only loaded once and variables are kept between lines.`))
 
 	err := flag.CommandLine.Parse(os.Args[2:])
 	if err != nil {
@@ -41,16 +94,35 @@ func runQueryCommand() {
 		fatalln("you must specify at least one -gedcom file")
 	}
 
-	parser := q.NewParser()
-	engine, err := parser.ParseString(flag.Arg(0))
-	if err != nil {
-		fatalln(err)
+	if optionInteractive && optionStream {
+		fatalln("-interactive cannot be used with -stream")
+	}
+
+	if optionStream && !isStreamFormat(format) {
+		fatalln("-stream can only be used with -format json or gedcom, not:",
+			format)
+	}
+
+	var engine *q.Engine
+	if !optionInteractive {
+		engine, err = q.NewParser().ParseString(flag.Arg(0))
+		if err != nil {
+			fatalln(err)
+		}
+	}
+
+	if optionStream {
+		for _, gedcomFile := range gedcomFiles {
+			streamQuery(engine, gedcomFile, format, optionResolvePointers)
+		}
+
+		return
 	}
 
 	docs := []*gedcom.Document{}
 
 	for _, gedcomFile := range gedcomFiles {
-		doc, err := gedcom.NewDocumentFromGEDCOMFile(gedcomFile)
+		doc, err := newDocumentFromGEDCOMFile(gedcomFile, false, false)
 		if err != nil {
 			fatalln(err)
 		}
@@ -62,6 +134,16 @@ func runQueryCommand() {
 		fatalln("you must provide at least one gedcom file")
 	}
 
+	if optionInteractive {
+		if !isFlagPassed("format") {
+			format = "table"
+		}
+
+		runInteractiveQuery(docs, format)
+
+		return
+	}
+
 	result, err := engine.Evaluate(docs)
 	if err != nil {
 		fatalln(err)
@@ -73,23 +155,96 @@ func runQueryCommand() {
 	}
 }
 
+func streamQuery(engine *q.Engine, gedcomFile, format string, resolvePointers bool) {
+	if isGrampsFile(gedcomFile) || isGEDCOMXFile(gedcomFile) {
+		fatalln("-stream can only be used with GEDCOM files:", gedcomFile)
+	}
+
+	file, err := os.Open(gedcomFile)
+	if err != nil {
+		fatalln(err)
+	}
+	defer file.Close()
+
+	decoder := gedcom.NewDecoder(file)
+	decoder.ResolvePointers = resolvePointers
+
+	for {
+		record, err := decoder.NextRecord()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			fatalln(err)
+		}
+
+		doc := gedcom.NewDocumentWithNodes(gedcom.Nodes{record})
+
+		result, err := engine.Evaluate([]*gedcom.Document{doc})
+		if err != nil {
+			fatalln(err)
+		}
+
+		err = output(result, format)
+		if err != nil {
+			fatalln(err)
+		}
+	}
+}
+
+// isStreamFormat is true for the formats that are still valid when they are
+// written once for each record. Other formats, like gedcomx and xlsx, must be
+// a single document.
+func isStreamFormat(format string) bool {
+	return format == "json" || format == "gedcom"
+}
+
 func output(result interface{}, format string) error {
-	var formatter q.Formatter
+	formatter := newFormatter(format, os.Stdout)
+	if formatter == nil {
+		fatalln("unsupported format:", format)
+	}
 
+	return formatter.Write(result)
+}
+
+// newFormatter returns nil if the format is not supported.
+func newFormatter(format string, writer io.Writer) q.Formatter {
 	switch format {
 	case "json":
-		formatter = &q.JSONFormatter{os.Stdout}
+		return &q.JSONFormatter{Writer: writer}
 	case "pretty-json":
-		formatter = &q.PrettyJSONFormatter{os.Stdout}
+		return &q.PrettyJSONFormatter{Writer: writer}
 	case "csv":
-		formatter = &q.CSVFormatter{os.Stdout}
+		return &q.CSVFormatter{Writer: writer}
 	case "gedcom":
-		formatter = &q.GEDCOMFormatter{os.Stdout}
+		return &q.GEDCOMFormatter{Writer: writer}
+	case "gedcomx":
+		return &q.GEDCOMXFormatter{Writer: writer}
 	case "html":
-		formatter = &q.HTMLFormatter{os.Stdout}
-	default:
-		fatalln("unsupported format: %s", format)
+		return &q.HTMLFormatter{Writer: writer}
+	case "table":
+		return &q.TableFormatter{Writer: writer}
+	case "yaml":
+		return &q.YAMLFormatter{Writer: writer}
+	case "tsv":
+		return &q.TSVFormatter{Writer: writer}
+	case "markdown":
+		return &q.MarkdownFormatter{Writer: writer}
+	case "xlsx":
+		return &q.XLSXFormatter{Writer: writer}
 	}
 
-	return formatter.Write(result)
+	return nil
+}
+
+func isFlagPassed(name string) (found bool) {
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			found = true
+		}
+	})
+
+	return
 }
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/q"
+)
+
+const interactiveHelp = `Enter a query to evaluate it, for example:
+
+  .Individuals | Only(.Age > 100) | .Name.String
+
+Variables are kept between queries:
+
+  Living are .Individuals | Only(.IsLiving)
+  Living | Length
+
+Press tab to complete accessors, functions and variables.
+
+Commands:
+  :format <name>  Change the output format. One of: json, pretty-json, yaml,
+                  csv, tsv, markdown, gedcom, gedcomx, html or table.
+  :variables      Show the names of all variables.
+  :help           Show this help.
+  :quit           Exit (or press Ctrl-D).
+`
+
+func runInteractiveQuery(docs []*gedcom.Document, format string) {
+	if !isInteractiveFormat(format) {
+		fatalln("unsupported format for -interactive:", format)
+	}
+
+	session := q.NewSession(docs)
+	reader := newLineReader(os.Stdin, session.Complete)
+
+	individuals := 0
+	for _, doc := range docs {
+		individuals += len(doc.Individuals())
+	}
+
+	fmt.Printf("Loaded %d document(s) with %d individual(s). "+
+		"Type :help for help.\n", len(docs), individuals)
+
+	for {
+		line, err := reader.ReadLine("gedcom> ")
+		if err == io.EOF {
+			return
+		}
+
+		if err != nil {
+			fatalln(err)
+		}
+
+		line = strings.TrimSpace(line)
+
+		switch {
+		case line == "":
+			continue
+
+		case strings.HasPrefix(line, ":"):
+			if !runInteractiveCommand(session, line, &format) {
+				return
+			}
+
+		default:
+			result, err := session.Evaluate(line)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "ERROR:", err)
+				continue
+			}
+
+			writeInteractiveResult(result, format)
+		}
+	}
+}
+
+// runInteractiveCommand returns false if the session should end.
+func runInteractiveCommand(session *q.Session, line string, format *string) bool {
+	args := strings.Fields(line)
+
+	switch args[0] {
+	case ":quit", ":exit":
+		return false
+
+	case ":help":
+		fmt.Print(interactiveHelp)
+
+	case ":variables":
+		fmt.Println(strings.Join(session.Variables(), "\n"))
+
+	case ":format":
+		if len(args) != 2 || !isInteractiveFormat(args[1]) {
+			fmt.Fprintln(os.Stderr, "ERROR: usage is :format <name>, see :help")
+			break
+		}
+
+		*format = args[1]
+
+	default:
+		fmt.Fprintln(os.Stderr, "ERROR: unknown command", args[0])
+	}
+
+	return true
+}
+
+// isInteractiveFormat excludes formats that cannot be shown in a terminal.
+func isInteractiveFormat(format string) bool {
+	return format != "xlsx" && newFormatter(format, os.Stdout) != nil
+}
+
+// writeInteractiveResult shows values that cannot be shown as a table, such as
+// a single individual, as JSON instead.
+func writeInteractiveResult(result interface{}, format string) {
+	err := newFormatter(format, os.Stdout).Write(result)
+
+	if err != nil && format == "table" {
+		err = newFormatter("pretty-json", os.Stdout).Write(result)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "ERROR:", err)
+	}
+}
@@ -3,12 +3,33 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/util"
 )
 
 func runWarningsCommand() {
+	var optionStream bool
+	var optionResolvePointers bool
+
+	flag.BoolVar(&optionStream, "stream", false, util.CLIDescription(`
+		Read one record at a time rather than loading the whole file. This
+		allows very large files to be processed in constant memory. However,
+		warnings for a family that require other records (such as a child
+		born before a parent) will not be reported unless "-resolve-pointers"
+		is also used. Warnings for an individual that require their families
+		(such as pedigree collapse) and cyclic ancestry (an individual that is
+		their own ancestor) are never reported because they need the whole
+		file.`))
+
+	flag.BoolVar(&optionResolvePointers, "resolve-pointers", false,
+		util.CLIDescription(`
+		When used with "-stream" pointers to other records will be resolved,
+		such as the husband, wife and children of a family. Records that have
+		a pointer are kept in memory.`))
+
 	err := flag.CommandLine.Parse(os.Args[2:])
 	if err != nil {
 		fatalln(err)
@@ -19,12 +40,71 @@ func runWarningsCommand() {
 		fatalln("you must provide a gedcom file")
 	}
 
-	doc, err := gedcom.NewDocumentFromGEDCOMFile(gedcomFile)
+	if optionStream {
+		streamWarnings(gedcomFile, optionResolvePointers)
+
+		return
+	}
+
+	file, err := os.Open(gedcomFile)
 	if err != nil {
 		fatalln(err)
 	}
+	defer file.Close()
 
-	for _, warning := range doc.Warnings() {
+	// Lines that cannot be decoded are reported as warnings so that they can
+	// all be fixed at once.
+	decoder := gedcom.NewDecoder(file)
+	decoder.ContinueOnError = true
+
+	doc, err := decoder.Decode()
+	if _, ok := err.(gedcom.DecodeErrors); err != nil && !ok {
+		fatalln(err)
+	}
+
+	warnings := append(decoder.Errors().Warnings(), doc.Warnings()...)
+	for _, warning := range warnings {
 		fmt.Println(warning)
 	}
 }
+
+func streamWarnings(gedcomFile string, resolvePointers bool) {
+	file, err := os.Open(gedcomFile)
+	if err != nil {
+		fatalln(err)
+	}
+	defer file.Close()
+
+	decoder := gedcom.NewDecoder(file)
+	decoder.ResolvePointers = resolvePointers
+	decoder.ContinueOnError = true
+	doc := decoder.Document()
+
+	// Decode errors are reported as soon as they are found.
+	reportedErrors := 0
+	reportErrors := func() {
+		errs := decoder.Errors()
+		for _, err := range errs[reportedErrors:] {
+			fmt.Println(err)
+		}
+
+		reportedErrors = len(errs)
+	}
+
+	for {
+		record, err := decoder.NextRecord()
+		reportErrors()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			fatalln(err)
+		}
+
+		for _, warning := range doc.RecordWarnings(record) {
+			fmt.Println(warning)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Long Values and New Lines
+//
+// GEDCOM lines are limited in length and must not contain new line characters.
+// Long values are split over several lines using CONC (concatenation) and CONT
+// (continued) child nodes.
+//
+// By default the Decoder will fold any CONC and CONT nodes back into the value
+// of the node they belong to. So the following GEDCOM:
+//
+//   0 NOTE This is a long note
+//   1 CONC  that continues
+//   1 CONT on another line.
+//
+// Will produce a single NoteNode with the value "This is a long note that
+// continues\non another line.". The Encoder will split the value again when
+// writing, using MaxLineLength.
+//
+// If the value of a node has not changed since it was decoded the original
+// split points are used when encoding so that round-tripping a file does not
+// produce unnecessary changes.
+package gedcom
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultMaxLineLength is the maximum length of a GEDCOM line, including the
+// indent, pointer and tag. It is the limit imposed by the GEDCOM 5.5.1
+// standard.
+const DefaultMaxLineLength = 255
+
+// continuationLine is a single physical line that made up a value that was
+// split with CONC or CONT.
+type continuationLine struct {
+	// tag will be TagConcatenation or TagContinued. The first line of the value
+	// (the one that belongs to the node itself) has an empty tag.
+	tag Tag
+
+	value string
+}
+
+// continuation records how a value was originally split when it was decoded.
+// It is attached to the SimpleNode so that the Encoder can reproduce the same
+// lines if the value has not been changed.
+type continuation struct {
+	lines []continuationLine
+}
+
+func newContinuation(value string) *continuation {
+	return &continuation{
+		lines: []continuationLine{
+			{value: value},
+		},
+	}
+}
+
+func (c *continuation) add(tag Tag, value string) {
+	c.lines = append(c.lines, continuationLine{tag: tag, value: value})
+}
+
+// value is the logical value that the lines represent.
+func (c *continuation) value() string {
+	return joinContinuationLines(c.lines)
+}
+
+// hasConcatenation returns true if any of the lines is a CONC.
+func (c *continuation) hasConcatenation() bool {
+	for _, line := range c.lines {
+		if line.tag == TagConcatenation {
+			return true
+		}
+	}
+
+	return false
+}
+
+// appendContinuation appends a CONC or CONT value to the node. The original
+// lines are retained so they can be reused by the Encoder.
+func appendContinuation(node Node, tag Tag, value string) {
+	simpleNode := node.RawSimpleNode()
+
+	if simpleNode.continuation == nil {
+		simpleNode.continuation = newContinuation(simpleNode.value)
+	}
+
+	simpleNode.continuation.add(tag, value)
+	simpleNode.value = simpleNode.continuation.value()
+}
+
+func joinContinuationLines(lines []continuationLine) string {
+	buf := strings.Builder{}
+
+	for _, line := range lines {
+		if line.tag == TagContinued {
+			buf.WriteByte('\n')
+		}
+
+		buf.WriteString(line.value)
+	}
+
+	return buf.String()
+}
+
+// isContinuationTag returns true for CONC and CONT.
+func isContinuationTag(tag Tag) bool {
+	return tag == TagConcatenation || tag == TagContinued
+}
+
+// splitValue breaks a value into the lines required to represent it in GEDCOM.
+//
+// New lines always produce a CONT line. Any line that would be longer than
+// maxLength characters (including the prefix that will be written before it)
+// is broken into CONC lines. A CONC line is never split next to a space
+// because many applications trim the values.
+//
+// If maxLength is zero or less lines are never broken with CONC.
+func splitValue(value string, firstPrefixLength, contPrefixLength, maxLength int) (lines []continuationLine) {
+	for i, part := range strings.Split(value, "\n") {
+		tag, prefixLength := Tag{}, firstPrefixLength
+		if i > 0 {
+			tag, prefixLength = TagContinued, contPrefixLength
+		}
+
+		for {
+			available := maxLength - prefixLength
+			if maxLength <= 0 || utf8.RuneCountInString(part) <= available {
+				lines = append(lines, continuationLine{tag: tag, value: part})
+				break
+			}
+
+			head, tail := splitLineAt(part, available)
+			lines = append(lines, continuationLine{tag: tag, value: head})
+			part = tail
+			tag, prefixLength = TagConcatenation, contPrefixLength
+		}
+	}
+
+	return
+}
+
+// splitLineAt breaks s into two parts so that the first part is no more than
+// max characters. It will prefer to break where neither side of the split is a
+// space. The second part will always be non-empty.
+func splitLineAt(s string, max int) (string, string) {
+	runes := []rune(s)
+
+	// There is always at least one character on each line, even if the prefix
+	// alone exceeds the maximum length.
+	if max < 1 {
+		max = 1
+	}
+
+	for i := max; i > 0; i-- {
+		if runes[i-1] != ' ' && runes[i] != ' ' {
+			return string(runes[:i]), string(runes[i:])
+		}
+	}
+
+	// There is no safe place to split (the line is mostly spaces) so we have
+	// to split it at the limit.
+	return string(runes[:max]), string(runes[max:])
+}
@@ -0,0 +1,440 @@
+// Importing CSV Files
+//
+// Transcribed records, such as censuses and parish registers, are often kept
+// in spreadsheets. CSVImporter adds the individuals of a CSV file (with a
+// header row) to a Document:
+//
+//   importer := gedcom.NewCSVImporter(file, gedcom.DefaultCSVMapping)
+//   warnings, err := importer.Import(document)
+//
+// The CSVMapping describes which column contains each fact. Each row is a
+// single individual. Rows may refer to other rows with the ID column, such as
+// the FatherID, MotherID and SpouseIDs columns. Families are created (or
+// reused) for these relationships.
+//
+// Rows that cannot be linked, for example because the father does not exist,
+// are still imported. A CSVImportWarning is returned for each of them. Rows
+// with an ID that cannot be used as a pointer (see IsValidPointer), or is
+// already used, are not imported and also have a CSVImportWarning.
+package gedcom
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// CSVMapping is the name of the column (in the header row) for each fact of an
+// individual. A column that is not needed can be left empty.
+//
+// CSVMapping can be loaded from JSON, such as:
+//
+//   {"ID": "Ref", "Name": "Full name", "FatherID": "Father"}
+type CSVMapping struct {
+	// ID uniquely identifies the row. It is used as the pointer of the
+	// individual and must be provided if any of the FatherID, MotherID or
+	// SpouseIDs are used. If ID is empty the pointers are generated. An ID
+	// must be a valid pointer, like "I1", without the surrounding "@".
+	ID string
+
+	// Name is the full name, like "John /Smith/". The surname may also be
+	// provided without slashes by using GivenName and Surname instead.
+	Name, GivenName, Surname string
+
+	// Sex may be "M", "F", "Male" or "Female" (not case sensitive). Any other
+	// value is unknown.
+	Sex string
+
+	BirthDate, BirthPlace string
+	DeathDate, DeathPlace string
+
+	// FatherID and MotherID are the ID of the parents. They may refer to rows
+	// that appear later in the file.
+	FatherID, MotherID string
+
+	// SpouseIDs contains the ID of each spouse, separated by a semicolon.
+	SpouseIDs string
+}
+
+// DefaultCSVMapping is used by the "gedcom import-csv" command when a mapping
+// is not provided. It is normally used with CSVImporter.IgnoreMissingColumns.
+var DefaultCSVMapping = CSVMapping{
+	ID:         "id",
+	Name:       "name",
+	GivenName:  "given_name",
+	Surname:    "surname",
+	Sex:        "sex",
+	BirthDate:  "birth_date",
+	BirthPlace: "birth_place",
+	DeathDate:  "death_date",
+	DeathPlace: "death_place",
+	FatherID:   "father_id",
+	MotherID:   "mother_id",
+	SpouseIDs:  "spouse_ids",
+}
+
+// csvSpouseSeparator separates each of the SpouseIDs.
+const csvSpouseSeparator = ";"
+
+// CSVImporter adds individuals and families from a CSV file to a Document.
+type CSVImporter struct {
+	r       io.Reader
+	mapping CSVMapping
+
+	// Comma is the field delimiter. It is set to ',' by NewCSVImporter.
+	Comma rune
+
+	// IgnoreMissingColumns will ignore the columns of the mapping that are not
+	// in the header row. Otherwise, a missing column is an error. This is
+	// useful with DefaultCSVMapping.
+	IgnoreMissingColumns bool
+}
+
+// NewCSVImporter creates an importer for a CSV file with a header row.
+func NewCSVImporter(r io.Reader, mapping CSVMapping) *CSVImporter {
+	return &CSVImporter{
+		r:       r,
+		mapping: mapping,
+		Comma:   ',',
+	}
+}
+
+// csvRow is a row of the file that has been added as an individual.
+type csvRow struct {
+	number     int
+	individual *IndividualNode
+	fields     map[string]string
+}
+
+// Import adds the individuals and families to the document.
+//
+// Warnings are returned for rows that could not be imported or linked. An
+// error is returned if the file cannot be read or the header does not contain
+// the columns of the mapping. In this case the document will not be modified.
+func (importer *CSVImporter) Import(document *Document) (Warnings, error) {
+	reader := csv.NewReader(importer.r)
+	reader.Comma = importer.Comma
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns, err := importer.columns(records[0])
+	if err != nil {
+		return nil, err
+	}
+
+	warnings := Warnings{}
+	rows := []*csvRow{}
+	nextPointer := 1
+
+	// Individuals must all exist before they can be linked.
+	for i, record := range records[1:] {
+		row := &csvRow{
+			// The header is the first row of the file.
+			number: i + 2,
+			fields: map[string]string{},
+		}
+
+		for field, column := range columns {
+			if column < len(record) {
+				row.fields[field] = strings.TrimSpace(record[column])
+			}
+		}
+
+		pointer := row.fields["ID"]
+		if pointer == "" {
+			pointer = document.unusedPointer("P", &nextPointer)
+		}
+
+		if !IsValidPointer(pointer) {
+			warnings = append(warnings, NewCSVImportWarning(row.number,
+				fmt.Sprintf("ID %q is not a valid pointer", pointer)))
+
+			continue
+		}
+
+		if document.NodeByPointer(pointer) != nil {
+			warnings = append(warnings, NewCSVImportWarning(row.number,
+				fmt.Sprintf("ID %s is already used", pointer)))
+
+			continue
+		}
+
+		row.individual = document.AddIndividual(pointer, row.nodes()...)
+		rows = append(rows, row)
+	}
+
+	families := newCSVFamilies(document)
+
+	for _, row := range rows {
+		warnings = append(warnings, families.linkParents(row)...)
+		warnings = append(warnings, families.linkSpouses(row)...)
+	}
+
+	return warnings, nil
+}
+
+// columns returns the index of each column of the mapping. The keys are the
+// names of the CSVMapping fields.
+func (importer *CSVImporter) columns(header []string) (map[string]int, error) {
+	columns := map[string]int{}
+	mapping := importer.mapping
+
+	for field, name := range map[string]string{
+		"ID":         mapping.ID,
+		"Name":       mapping.Name,
+		"GivenName":  mapping.GivenName,
+		"Surname":    mapping.Surname,
+		"Sex":        mapping.Sex,
+		"BirthDate":  mapping.BirthDate,
+		"BirthPlace": mapping.BirthPlace,
+		"DeathDate":  mapping.DeathDate,
+		"DeathPlace": mapping.DeathPlace,
+		"FatherID":   mapping.FatherID,
+		"MotherID":   mapping.MotherID,
+		"SpouseIDs":  mapping.SpouseIDs,
+	} {
+		if name == "" {
+			continue
+		}
+
+		found := false
+		for i, column := range header {
+			if strings.EqualFold(strings.TrimSpace(column), name) {
+				columns[field] = i
+				found = true
+			}
+		}
+
+		if !found && !importer.IgnoreMissingColumns {
+			return nil, fmt.Errorf("column %q does not exist", name)
+		}
+	}
+
+	if _, ok := columns["ID"]; !ok {
+		for _, field := range []string{"FatherID", "MotherID", "SpouseIDs"} {
+			if _, ok := columns[field]; ok {
+				return nil, fmt.Errorf("the %s column needs an ID column", field)
+			}
+		}
+	}
+
+	return columns, nil
+}
+
+// nodes returns the facts of the individual. The values are text, so each "@"
+// is escaped in the same way as DocumentBuilder.Add.
+func (row *csvRow) nodes() (nodes Nodes) {
+	name := row.fields["Name"]
+	if name == "" {
+		name = row.fields["GivenName"]
+		if surname := row.fields["Surname"]; surname != "" {
+			name += " /" + surname + "/"
+		}
+	}
+
+	if name = CleanSpace(name); name != "" {
+		nameNode := NewNameNode(escapeValue(name))
+		if givenName := row.fields["GivenName"]; givenName != "" {
+			nameNode.AddNode(NewNode(TagGivenName, escapeValue(givenName), ""))
+		}
+
+		if surname := row.fields["Surname"]; surname != "" {
+			nameNode.AddNode(NewNode(TagSurname, escapeValue(surname), ""))
+		}
+
+		nodes = append(nodes, nameNode)
+	}
+
+	if sex := row.fields["Sex"]; sex != "" {
+		nodes = append(nodes, NewSexNode(csvSex(sex)))
+	}
+
+	if birth := csvEvent(row.fields["BirthDate"], row.fields["BirthPlace"]); len(birth) > 0 {
+		nodes = append(nodes, NewBirthNode("", birth...))
+	}
+
+	if death := csvEvent(row.fields["DeathDate"], row.fields["DeathPlace"]); len(death) > 0 {
+		nodes = append(nodes, NewDeathNode("", death...))
+	}
+
+	return
+}
+
+func csvSex(sex string) string {
+	switch strings.ToLower(sex) {
+	case "m", "male":
+		return SexMale
+
+	case "f", "female":
+		return SexFemale
+	}
+
+	return SexUnknown
+}
+
+// csvEvent returns the DATE and PLAC of an event.
+func csvEvent(date, place string) (nodes Nodes) {
+	if date != "" {
+		nodes = append(nodes, NewDateNode(escapeValue(date)))
+	}
+
+	if place != "" {
+		nodes = append(nodes, NewPlaceNode(escapeValue(place)))
+	}
+
+	return
+}
+
+// unusedPointer returns the first pointer, like "P3", that is not used by the
+// document. The search starts from next, which is updated so that the same
+// pointers are not checked again.
+func (doc *Document) unusedPointer(prefix string, next *int) string {
+	for ; ; *next++ {
+		pointer := fmt.Sprintf("%s%d", prefix, *next)
+		if doc.NodeByPointer(pointer) == nil {
+			return pointer
+		}
+	}
+}
+
+// csvFamilies finds or creates the families that link the rows.
+type csvFamilies struct {
+	document    *Document
+	families    map[string]*FamilyNode
+	nextPointer int
+}
+
+func newCSVFamilies(document *Document) *csvFamilies {
+	families := &csvFamilies{
+		document:    document,
+		families:    map[string]*FamilyNode{},
+		nextPointer: 1,
+	}
+
+	for _, family := range document.Families() {
+		key := csvFamilyKey(family.Husband().Individual(),
+			family.Wife().Individual())
+		families.families[key] = family
+	}
+
+	return families
+}
+
+// csvFamilyKey is the same for a husband and wife in either order. Either
+// individual may be nil.
+func csvFamilyKey(husband, wife *IndividualNode) string {
+	pointers := []string{"", ""}
+	for i, individual := range []*IndividualNode{husband, wife} {
+		if individual != nil {
+			pointers[i] = individual.Pointer()
+		}
+	}
+
+	sort.Strings(pointers)
+
+	return strings.Join(pointers, " ")
+}
+
+// family returns the family for the husband and wife (either may be nil),
+// creating it if it does not exist.
+func (families *csvFamilies) family(husband, wife *IndividualNode) *FamilyNode {
+	key := csvFamilyKey(husband, wife)
+	if family, ok := families.families[key]; ok {
+		return family
+	}
+
+	pointer := families.document.unusedPointer("F", &families.nextPointer)
+	family := families.document.AddFamily(pointer)
+
+	if husband != nil {
+		family.SetHusband(husband)
+	}
+
+	if wife != nil {
+		family.SetWife(wife)
+	}
+
+	families.families[key] = family
+
+	return family
+}
+
+// individual returns the individual for an ID. A warning is returned if the
+// individual does not exist.
+func (families *csvFamilies) individual(row *csvRow, column string) (*IndividualNode, Warnings) {
+	id := row.fields[column]
+	if id == "" {
+		return nil, nil
+	}
+
+	if individual, ok := families.document.NodeByPointer(id).(*IndividualNode); ok {
+		return individual, nil
+	}
+
+	warning := NewCSVImportWarning(row.number,
+		fmt.Sprintf("%s %s does not exist", column, id))
+	warning.SetContext(WarningContext{Individual: row.individual})
+
+	return nil, Warnings{warning}
+}
+
+func (families *csvFamilies) linkParents(row *csvRow) Warnings {
+	father, warnings := families.individual(row, "FatherID")
+	mother, motherWarnings := families.individual(row, "MotherID")
+	warnings = append(warnings, motherWarnings...)
+
+	if father == nil && mother == nil {
+		return warnings
+	}
+
+	family := families.family(father, mother)
+	for _, child := range family.Children() {
+		if child.Individual().Is(row.individual) {
+			return warnings
+		}
+	}
+
+	family.AddChild(row.individual)
+
+	return warnings
+}
+
+func (families *csvFamilies) linkSpouses(row *csvRow) (warnings Warnings) {
+	for _, id := range strings.Split(row.fields["SpouseIDs"], csvSpouseSeparator) {
+		if id = strings.TrimSpace(id); id == "" {
+			continue
+		}
+
+		spouseRow := &csvRow{
+			number:     row.number,
+			individual: row.individual,
+			fields:     map[string]string{"SpouseID": id},
+		}
+
+		spouse, spouseWarnings := families.individual(spouseRow, "SpouseID")
+		warnings = append(warnings, spouseWarnings...)
+
+		if spouse == nil {
+			continue
+		}
+
+		husband, wife := row.individual, spouse
+		if row.individual.Sex().IsFemale() || spouse.Sex().IsMale() {
+			husband, wife = spouse, row.individual
+		}
+
+		families.family(husband, wife)
+	}
+
+	return
+}
@@ -0,0 +1,217 @@
+package gedcom_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVImporter_Import(t *testing.T) {
+	file := strings.Join([]string{
+		"id,name,sex,birth_date,birth_place,death_date,father_id,mother_id,spouse_ids",
+		"I3,Jane /Smith/,female,3 Mar 1910,London,,I1,I2,",
+		"I1,John /Smith/,M,1880,,1950,,,I2",
+		"I2,Mary /Jones/,F,1885,Paris,,,,",
+		"I4,Bob /Smith/,m,1912,,,I1,I2,I5;I9",
+		"I5,Alice /Brown/,,,,,I8,,",
+	}, "\n")
+
+	document := gedcom.NewDocument()
+	importer := gedcom.NewCSVImporter(strings.NewReader(file),
+		gedcom.DefaultCSVMapping)
+	importer.IgnoreMissingColumns = true
+
+	warnings, err := importer.Import(document)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"Row 5: SpouseID I9 does not exist",
+		"Row 6: FatherID I8 does not exist",
+	}, warnings.Strings())
+
+	require.Len(t, document.Individuals(), 5)
+	require.Len(t, document.Families(), 2)
+
+	jane := document.Individuals().ByPointer("I3")
+	assert.Equal(t, "Jane Smith", jane.Name().String())
+	assert.True(t, jane.Sex().IsFemale())
+	birthDate, birthPlace := jane.Birth()
+	assert.Equal(t, "3 Mar 1910", birthDate.Value())
+	assert.Equal(t, "London", birthPlace.Value())
+	assert.Empty(t, jane.Deaths())
+
+	john := document.Individuals().ByPointer("I1")
+	mary := document.Individuals().ByPointer("I2")
+	bob := document.Individuals().ByPointer("I4")
+	alice := document.Individuals().ByPointer("I5")
+
+	deathDate, _ := john.Death()
+	assert.Equal(t, "1950", deathDate.Value())
+	assert.True(t, jane.Parents()[0].Husband().IsIndividual(john))
+	assert.True(t, jane.Parents()[0].Wife().IsIndividual(mary))
+	assert.Equal(t, jane.Parents(), bob.Parents())
+	assert.Equal(t, gedcom.IndividualNodes{mary}, john.Spouses())
+	assert.Equal(t, gedcom.IndividualNodes{alice}, bob.Spouses())
+	assert.True(t, alice.Sex().IsUnknown())
+
+	// The father does not exist and there is no mother to link to.
+	assert.Empty(t, alice.Parents())
+}
+
+func TestCSVImporter_ImportMapping(t *testing.T) {
+	file := strings.Join([]string{
+		"Ref;First;Last;Mother",
+		"1;Jane;Smith;2",
+		"2;Mary;Jones;",
+	}, "\n")
+
+	document := gedcom.NewDocument()
+	importer := gedcom.NewCSVImporter(strings.NewReader(file),
+		gedcom.CSVMapping{
+			ID:        "ref",
+			GivenName: "first",
+			Surname:   "last",
+			MotherID:  "mother",
+		})
+	importer.Comma = ';'
+
+	warnings, err := importer.Import(document)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	jane := document.Individuals().ByPointer("1")
+	assert.Equal(t, "Jane Smith", jane.Name().String())
+	assert.Equal(t, "Jane", jane.Name().GivenName())
+	assert.Equal(t, "Smith", jane.Name().Surname())
+	assert.True(t, jane.Parents()[0].Wife().IsIndividual(
+		document.Individuals().ByPointer("2")))
+	assert.Nil(t, jane.Parents()[0].Husband())
+}
+
+func TestCSVImporter_ImportGeneratedPointers(t *testing.T) {
+	document := gedcom.NewDocument()
+	document.AddIndividual("P1")
+
+	importer := gedcom.NewCSVImporter(strings.NewReader("Name\nJane\nJohn"),
+		gedcom.CSVMapping{Name: "Name"})
+
+	_, err := importer.Import(document)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Jane", document.Individuals().ByPointer("P2").Name().String())
+	assert.Equal(t, "John", document.Individuals().ByPointer("P3").Name().String())
+}
+
+func TestCSVImporter_ImportEscapes(t *testing.T) {
+	file := strings.Join([]string{
+		"Name,Given,Surname,Date,Place",
+		"Jane @home,Jane@,Smith@work,@#DJULIAN@ 1 JAN 1700,me@example.com",
+	}, "\n")
+
+	document := gedcom.NewDocument()
+	importer := gedcom.NewCSVImporter(strings.NewReader(file),
+		gedcom.CSVMapping{
+			Name:       "Name",
+			GivenName:  "Given",
+			Surname:    "Surname",
+			BirthDate:  "Date",
+			BirthPlace: "Place",
+		})
+
+	_, err := importer.Import(document)
+	require.NoError(t, err)
+
+	assert.Equal(t, "0 @P1@ INDI\n"+
+		"1 NAME Jane @@home\n"+
+		"2 GIVN Jane@@\n"+
+		"2 SURN Smith@@work\n"+
+		"1 BIRT\n"+
+		"2 DATE @#DJULIAN@ 1 JAN 1700\n"+
+		"2 PLAC me@@example.com\n", document.String())
+}
+
+func TestCSVImporter_ImportExistingDocument(t *testing.T) {
+	document := gedcom.NewDocument()
+	john := document.AddIndividual("I1", gedcom.NewSexNode(gedcom.SexMale))
+	mary := document.AddIndividual("I2", gedcom.NewSexNode(gedcom.SexFemale))
+	family := document.AddFamily("F1")
+	family.SetHusband(john)
+	family.SetWife(mary)
+
+	file := "id,mother_id,father_id\nI3,I2,I1\nI1,,"
+	importer := gedcom.NewCSVImporter(strings.NewReader(file),
+		gedcom.DefaultCSVMapping)
+	importer.IgnoreMissingColumns = true
+
+	warnings, err := importer.Import(document)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Row 3: ID I1 is already used"}, warnings.Strings())
+	assert.Len(t, document.Families(), 1)
+	assert.Equal(t, gedcom.FamilyNodes{family},
+		document.Individuals().ByPointer("I3").Parents())
+}
+
+func TestCSVImporter_ImportInvalidIDs(t *testing.T) {
+	document := gedcom.NewDocument()
+	file := "id,name,father_id\nI1,John,\n@I2@,Jane,I1\nI 3,Bob,I1\n#I4,Sam,\nI5,Mary,@I2@"
+	importer := gedcom.NewCSVImporter(strings.NewReader(file),
+		gedcom.DefaultCSVMapping)
+	importer.IgnoreMissingColumns = true
+
+	warnings, err := importer.Import(document)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`Row 3: ID "@I2@" is not a valid pointer`,
+		`Row 4: ID "I 3" is not a valid pointer`,
+		`Row 5: ID "#I4" is not a valid pointer`,
+		"Row 6: FatherID @I2@ does not exist",
+	}, warnings.Strings())
+
+	individuals := document.Individuals()
+	require.Len(t, individuals, 2)
+	assert.Equal(t, "I1", individuals[0].Pointer())
+	assert.Equal(t, "I5", individuals[1].Pointer())
+}
+
+func TestCSVImporter_ImportErrors(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		file    string
+		mapping gedcom.CSVMapping
+		err     string
+	}{
+		{
+			name:    "MissingColumn",
+			file:    "id,name",
+			mapping: gedcom.CSVMapping{ID: "id", Sex: "sex"},
+			err:     `column "sex" does not exist`,
+		},
+		{
+			name:    "LinkWithoutID",
+			file:    "name,father",
+			mapping: gedcom.CSVMapping{Name: "name", FatherID: "father"},
+			err:     "the FatherID column needs an ID column",
+		},
+		{
+			name:    "BadQuotes",
+			file:    "name\n\"Jane",
+			mapping: gedcom.CSVMapping{Name: "name"},
+			err:     `parse error on line 2, column 6: extraneous or missing " in quoted-field`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			document := gedcom.NewDocument()
+			importer := gedcom.NewCSVImporter(strings.NewReader(test.file),
+				test.mapping)
+
+			_, err := importer.Import(document)
+			assert.EqualError(t, err, test.err)
+			assert.Empty(t, document.Individuals())
+		})
+	}
+}
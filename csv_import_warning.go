@@ -0,0 +1,30 @@
+package gedcom
+
+import (
+	"fmt"
+)
+
+// CSVImportWarning is produced by CSVImporter when a row cannot be imported or
+// linked to another row.
+type CSVImportWarning struct {
+	SimpleWarning
+
+	// Row is the line number of the row. The header is row 1.
+	Row     int
+	Message string
+}
+
+func NewCSVImportWarning(row int, message string) *CSVImportWarning {
+	return &CSVImportWarning{
+		Row:     row,
+		Message: message,
+	}
+}
+
+func (w *CSVImportWarning) Name() string {
+	return "CSVImport"
+}
+
+func (w *CSVImportWarning) String() string {
+	return fmt.Sprintf("Row %d: %s", w.Row, w.Message)
+}
@@ -0,0 +1,181 @@
+package gedcom
+
+import "sort"
+
+// ancestryGraph contains the children of each individual, built from the
+// HUSB, WIFE and CHIL of each family.
+type ancestryGraph map[*IndividualNode]IndividualNodes
+
+func newAncestryGraph(doc *Document) ancestryGraph {
+	graph := ancestryGraph{}
+
+	for _, family := range doc.Families() {
+		parents := []*IndividualNode{
+			family.Husband().Individual(),
+			family.Wife().Individual(),
+		}
+
+		for _, parent := range parents {
+			if parent == nil {
+				continue
+			}
+
+			for _, child := range family.Children() {
+				if individual := child.Individual(); individual != nil {
+					graph[parent] = append(graph[parent], individual)
+				}
+			}
+		}
+	}
+
+	return graph
+}
+
+// AncestryCycles returns the individuals that are their own ancestor. Each
+// cycle starts with the individual that appears first in the document and is
+// followed by their descendants, in order, until the line returns to the first
+// individual. See CyclicAncestryWarning.
+//
+// Only one (the shortest) cycle is returned for each group of individuals that
+// are connected by cycles.
+func (doc *Document) AncestryCycles() (cycles []IndividualNodes) {
+	graph := newAncestryGraph(doc)
+
+	for _, component := range graph.stronglyConnectedComponents(doc.Individuals()) {
+		if cycle := graph.shortestCycle(component); cycle != nil {
+			cycles = append(cycles, cycle)
+		}
+	}
+
+	return
+}
+
+func (doc *Document) cyclicAncestryWarnings() (warnings Warnings) {
+	for _, cycle := range doc.AncestryCycles() {
+		warning := NewCyclicAncestryWarning(cycle)
+		warning.SetContext(WarningContext{
+			Individual: cycle[0],
+		})
+
+		warnings = append(warnings, warning)
+	}
+
+	return
+}
+
+// stronglyConnectedComponents uses Tarjan's algorithm to group individuals
+// that are all ancestors of each other. Each component is in the same order as
+// the individuals, and the components are ordered by their first individual.
+func (graph ancestryGraph) stronglyConnectedComponents(individuals IndividualNodes) (components []IndividualNodes) {
+	index := map[*IndividualNode]int{}
+	lowLink := map[*IndividualNode]int{}
+	onStack := map[*IndividualNode]bool{}
+	stack := IndividualNodes{}
+	position := map[*IndividualNode]int{}
+
+	for i, individual := range individuals {
+		position[individual] = i
+	}
+
+	var visit func(individual *IndividualNode)
+	visit = func(individual *IndividualNode) {
+		index[individual] = len(index)
+		lowLink[individual] = index[individual]
+		stack = append(stack, individual)
+		onStack[individual] = true
+
+		for _, child := range graph[individual] {
+			if _, ok := index[child]; !ok {
+				visit(child)
+				lowLink[individual] = minInt(lowLink[individual], lowLink[child])
+			} else if onStack[child] {
+				lowLink[individual] = minInt(lowLink[individual], index[child])
+			}
+		}
+
+		if lowLink[individual] != index[individual] {
+			return
+		}
+
+		component := IndividualNodes{}
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = insertByPosition(component, top, position)
+
+			if top == individual {
+				break
+			}
+		}
+
+		components = append(components, component)
+	}
+
+	for _, individual := range individuals {
+		if _, ok := index[individual]; !ok {
+			visit(individual)
+		}
+	}
+
+	sort.Slice(components, func(i, j int) bool {
+		return position[components[i][0]] < position[components[j][0]]
+	})
+
+	return
+}
+
+// shortestCycle returns the shortest path from the first individual of the
+// component back to itself. The result is nil if the component is not a cycle,
+// that is, a single individual that is not their own parent.
+func (graph ancestryGraph) shortestCycle(component IndividualNodes) IndividualNodes {
+	start := component[0]
+	inComponent := map[*IndividualNode]bool{}
+	for _, individual := range component {
+		inComponent[individual] = true
+	}
+
+	// previous is used to rebuild the path once the start is found again by
+	// the breadth first search.
+	previous := map[*IndividualNode]*IndividualNode{}
+	queue := IndividualNodes{start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, child := range graph[current] {
+			if child == start {
+				cycle := IndividualNodes{}
+				for node := current; node != start; node = previous[node] {
+					cycle = append(IndividualNodes{node}, cycle...)
+				}
+
+				return append(IndividualNodes{start}, cycle...)
+			}
+
+			if _, ok := previous[child]; ok || !inComponent[child] {
+				continue
+			}
+
+			previous[child] = current
+			queue = append(queue, child)
+		}
+	}
+
+	return nil
+}
+
+// insertByPosition keeps the individuals in the order of the document.
+func insertByPosition(individuals IndividualNodes, individual *IndividualNode, position map[*IndividualNode]int) IndividualNodes {
+	i := len(individuals)
+	for i > 0 && position[individuals[i-1]] > position[individual] {
+		i--
+	}
+
+	individuals = append(individuals, nil)
+	copy(individuals[i+1:], individuals[i:])
+	individuals[i] = individual
+
+	return individuals
+}
@@ -0,0 +1,112 @@
+package gedcom_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocument_AncestryCycles(t *testing.T) {
+	t.Run("NoCycles", func(t *testing.T) {
+		doc := newDocumentFromString(pedigreeCollapseDocument)
+
+		assert.Nil(t, doc.AncestryCycles())
+	})
+
+	t.Run("OwnGrandparent", func(t *testing.T) {
+		// P3 is the grandchild of P1, and also the father of P1.
+		doc := newDocumentFromString(`0 @P1@ INDI
+1 NAME John /Smith/
+0 @P2@ INDI
+1 NAME Jane /Smith/
+0 @P3@ INDI
+1 NAME Bob /Smith/
+0 @P4@ INDI
+0 @F1@ FAM
+1 HUSB @P1@
+1 CHIL @P2@
+1 CHIL @P4@
+0 @F2@ FAM
+1 WIFE @P2@
+1 CHIL @P3@
+0 @F3@ FAM
+1 HUSB @P3@
+1 CHIL @P1@
+`)
+		individual := doc.Individuals().ByPointer
+
+		assert.Equal(t, []gedcom.IndividualNodes{
+			{individual("P1"), individual("P2"), individual("P3")},
+		}, doc.AncestryCycles())
+	})
+
+	t.Run("OwnParent", func(t *testing.T) {
+		doc := newDocumentFromString(`0 @P1@ INDI
+0 @P2@ INDI
+0 @F1@ FAM
+1 HUSB @P2@
+1 WIFE @P1@
+1 CHIL @P1@
+`)
+
+		assert.Equal(t, []gedcom.IndividualNodes{
+			{doc.Individuals().ByPointer("P1")},
+		}, doc.AncestryCycles())
+	})
+
+	t.Run("MultipleCycles", func(t *testing.T) {
+		// P1 and P2 are each other's parent. P3 and P4 are also a separate
+		// cycle through P5.
+		doc := newDocumentFromString(`0 @P1@ INDI
+0 @P2@ INDI
+0 @P3@ INDI
+0 @P4@ INDI
+0 @P5@ INDI
+0 @F1@ FAM
+1 HUSB @P1@
+1 CHIL @P2@
+0 @F2@ FAM
+1 HUSB @P2@
+1 CHIL @P1@
+1 CHIL @P5@
+0 @F3@ FAM
+1 WIFE @P4@
+1 CHIL @P5@
+0 @F4@ FAM
+1 HUSB @P5@
+1 CHIL @P3@
+0 @F5@ FAM
+1 HUSB @P3@
+1 CHIL @P4@
+`)
+		individual := doc.Individuals().ByPointer
+
+		assert.Equal(t, []gedcom.IndividualNodes{
+			{individual("P1"), individual("P2")},
+			{individual("P3"), individual("P4"), individual("P5")},
+		}, doc.AncestryCycles())
+	})
+}
+
+func TestCyclicAncestryWarning(t *testing.T) {
+	doc := newDocumentFromString(`0 @P1@ INDI
+1 NAME John /Smith/
+0 @P2@ INDI
+1 NAME Jane /Smith/
+0 @F1@ FAM
+1 HUSB @P1@
+1 CHIL @P2@
+0 @F2@ FAM
+1 WIFE @P2@
+1 CHIL @P1@
+`)
+	warnings := doc.Warnings()
+
+	assert.Equal(t, []string{
+		"John Smith is their own ancestor: John Smith (P1) -> Jane Smith (P2) -> John Smith (P1).",
+	}, warnings.Strings())
+	assert.Equal(t, "CyclicAncestry", warnings[0].Name())
+	assert.Equal(t, doc.Individuals().ByPointer("P1"),
+		warnings[0].Context().Individual)
+}
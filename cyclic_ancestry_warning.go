@@ -0,0 +1,39 @@
+package gedcom
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CyclicAncestryWarning is raised when an individual is their own ancestor.
+// This is not possible, but can be created by a bad merge.
+type CyclicAncestryWarning struct {
+	SimpleWarning
+
+	// Cycle starts with the individual that is their own ancestor. Each
+	// individual is a parent of the next individual, and the last individual
+	// is a parent of the first one.
+	Cycle IndividualNodes
+}
+
+func NewCyclicAncestryWarning(cycle IndividualNodes) *CyclicAncestryWarning {
+	return &CyclicAncestryWarning{
+		Cycle: cycle,
+	}
+}
+
+func (w *CyclicAncestryWarning) Name() string {
+	return "CyclicAncestry"
+}
+
+func (w *CyclicAncestryWarning) String() string {
+	var path []string
+
+	for _, individual := range append(w.Cycle, w.Cycle[0]) {
+		path = append(path, fmt.Sprintf("%s (%s)", individual,
+			individual.Pointer()))
+	}
+
+	return fmt.Sprintf("%s is their own ancestor: %s.", w.Cycle[0],
+		strings.Join(path, " -> "))
+}
@@ -57,8 +57,10 @@ const DefaultMaxYearsForSimilarity = float64(3)
 // regular expressions. The first value of each constant is important as it is
 // the default when converting back to a string.
 const (
-	DateWordsBetween = "Bet.|bet|between|from"
+	DateWordsBetween = "Bet.|bet|between"
 	DateWordsAnd     = "and|to|-"
+	DateWordsFrom    = "From|from"
+	DateWordsTo      = "To|to|-"
 	DateWordsAbout   = "Abt.|abt|about|c.|ca|ca.|cca|cca.|circa"
 	DateWordsAfter   = "Aft.|aft|after"
 	DateWordsBefore  = "Bef.|bef|before"
@@ -72,8 +74,8 @@ const (
 // Before diving into the full specs below you should be aware of the known
 // limitations:
 //
-// 1. Only the Gregorian calendar with the English language (for month names)
-// is currently supported.
+// 1. Month names and keywords are expected to be in English unless a DateLocale
+// is used. See Decoder.DateLocales for understanding dates in other languages.
 //
 // 2. You should only expect dates that are valid and within the range of Go's
 // supported libraries will work correctly. That is years between 0 and 9999. It
@@ -85,12 +87,13 @@ const (
 // work, not work or retain the same behaviour between releases. If you believe
 // there are other known cases please open an issue or pull request.
 //
-// Now into the specification. There are two basic forms of a DATE value:
+// Now into the specification. There are three basic forms of a DATE value:
 //
 //   between date and date
+//   from date to date
 //   date
 //
-// The second case is actually equivalent to the first case the the same "date"
+// The last case is actually equivalent to the first case the the same "date"
 // substituted twice.
 //
 // The "between" keyword can be any of (non case sensitive):
@@ -98,7 +101,6 @@ const (
 //   between
 //   bet
 //   bet.
-//   from
 //
 // The "and" keyword can be one of (non case sensitive):
 //
@@ -106,11 +108,20 @@ const (
 //   and
 //   to
 //
+// A range ("between date and date") describes a single point in time that is
+// not known exactly, but is somewhere between the two dates. Whereas a period
+// ("from date to date") describes something that was true for the whole time
+// between the two dates, like a residence. See DateRange.IsPeriod.
+//
+// The "from" keyword is "from" and the "to" keyword can be "to" or "-" (both
+// are non case sensitive). A period may also have only one of its boundaries,
+// like "from date" or "to date".
+//
 // A "date" has three basic forms:
 //
-//   prefix? day month year
-//   prefix? month year
-//   prefix? year
+//   prefix? calendar? day month year
+//   prefix? calendar? month year
+//   prefix? calendar? year
 //
 // The "prefix" is optional and can be used to indicate if the date is
 // approximate or not with one of the following keywords:
@@ -131,12 +142,30 @@ const (
 //   bef
 //   bef.
 //
+// The "calendar" is an optional escape that specifies the calendar of the date.
+// When it is not provided the date is Gregorian. See DateCalendar.
+//
+//   @#DGREGORIAN@
+//   @#DJULIAN@
+//   @#DHEBREW@
+//   @#DFRENCH R@
+//   @#DROMAN@
+//   @#DUNKNOWN@
+//
+// GEDCOM 7.0 uses a keyword in place of the escape:
+//
+//   GREGORIAN
+//   JULIAN
+//   HEBREW
+//   FRENCH_R
+//
 // The "day" must be an integer between 1 and 31 and can have a single
 // proceeding zero, like "03". The day should be valid against the month used.
 // The behavior is unexpected when using invalid dates like "31 Feb 1999", but
 // you will likely not receive a date at all if it's invalid.
 //
-// The "month" must be one of the following strings (case in-sensitive):
+// For the Gregorian and Julian calendars the "month" must be one of the
+// following strings (case in-sensitive):
 //
 //   apr
 //   april
@@ -162,6 +191,13 @@ const (
 //   sep
 //   september
 //
+// The Hebrew calendar uses the months (in order) TSH, CSH, KSL, TVT, SHV, ADR,
+// ADS, NSN, IYR, SVN, TMZ, AAV and ELL. ADS is only valid in a leap year.
+//
+// The French Republican calendar uses the months (in order) VEND, BRUM, FRIM,
+// NIVO, PLUV, VENT, GERM, FLOR, PRAI, MESS, THER, FRUC and COMP. COMP is the
+// five or six complementary days at the end of each year.
+//
 // The "year" must be an integer with a value between 0 and 9999 (as to conform
 // to the restrictions of the Go time package). It may be possible to parse
 // dates outside of this range but they behaviour is not defined.
@@ -169,9 +205,15 @@ const (
 // The "year" may be 1 to 4 digits but it always treated as the absolute year.
 // The year 89 is treated as the year 89, not 1989, for example.
 //
-// Values represented by a Date instance must be compatible with Go's time
-// package. This only allows for date ranges of the year between 0 and 9999. So
-// Date would not allow for BC/BCE dates.
+// The "year" may also be a dual year, like "1699/00". These are common in
+// records from before the change of the new year from the 25th of March to the
+// 1st of January. The second year is used to calculate the date. A dual year
+// does not change the calendar, so Julian dates still need the "@#DJULIAN@"
+// escape.
+//
+// The "year" may be followed by "BCE" (GEDCOM 7.0) or "B.C." (GEDCOM 5.5.1)
+// for a Gregorian or Julian date before the common era, like "44 BCE". The
+// Year of these dates is negative.
 //
 // You should be careful about directly creating dates from the defined instance
 // variables because they may contain 0 to signify that a date component was not
@@ -190,6 +232,9 @@ type Date struct {
 	// 9999. If this year is outside of that date you will not be able to use
 	// the Time() function and you will probably run into all sort of other
 	// trouble.
+	//
+	// The year is negative for dates before the common era, so -44 is
+	// "44 BCE". There is no year 0.
 	Year int
 
 	// IsEndOfRange signifies is this date is the start or end of the range
@@ -214,6 +259,20 @@ type Date struct {
 
 	// If the date cannot be parsed this will contain the error.
 	ParseError error
+
+	// Calendar is the calendar that the Day, Month and Year are expressed in.
+	// The Month is the number of the month for that calendar, starting at 1.
+	Calendar DateCalendar
+
+	// DualYear is the second year of a dual year, like 1700 for "1699/00".
+	// When the date does not have a dual year this will be 0.
+	DualYear int
+
+	// MonthName is the month exactly as it was written, like "AUG". It is only
+	// set for calendars that cannot be converted (DateCalendarRoman and
+	// DateCalendarUnknown) because the Month cannot be known. It is used in
+	// place of the Month when the date is written.
+	MonthName string
 }
 
 // NewDateWithTime creates a new Date with the provided time.Time.
@@ -263,6 +322,10 @@ func (date Date) safeParse(s string) time.Time {
 // Time returns the minimum or maximum (depending on IsEndOfRange)
 // representation of the Date as a Go Time instance.
 func (date Date) Time() time.Time {
+	if date.usesDayNumbers() {
+		return date.timeFromDayNumber()
+	}
+
 	var d string
 
 	switch {
@@ -315,30 +378,54 @@ func (date Date) Time() time.Time {
 //   Aft.
 //   Bef.
 //
+// Dates that are not Gregorian will include the calendar escape and use the
+// month names of the calendar, like "@#DHEBREW@ 3 TSH 5780".
 func (date Date) String() string {
+	return date.StringInLocale(nil)
+}
+
+// StringInLocale works the same way as String but uses the month names and
+// constraint words of the locale. If the locale is nil then English is used.
+func (date Date) StringInLocale(locale *DateLocale) string {
 	day := ""
 	if date.Day != 0 {
 		day = strconv.Itoa(date.Day)
 	}
 
 	monthName := ""
-	if date.Month != 0 {
-		monthName = date.Month.String()[:3]
+	switch {
+	case date.MonthName != "":
+		monthName = date.MonthName
+
+	case date.Month >= time.January && date.Month <= time.December &&
+		date.Calendar.usesGregorianMonths():
+		monthName = locale.monthName(date.Month)
+
+	case date.Month != 0:
+		monthName = date.Calendar.monthName(date.Month)
 	}
 
 	year := ""
-	if date.Year != 0 {
+	switch {
+	case date.Year < 0:
+		year = strconv.Itoa(-date.Year) + " B.C."
+
+	case date.Year != 0:
 		year = strconv.Itoa(date.Year)
 	}
 
-	rawDate := fmt.Sprintf("%s %s %s %s",
-		date.Constraint.String(), day, monthName, year)
+	if date.DualYear != 0 {
+		year += fmt.Sprintf("/%02d", date.DualYear%100)
+	}
+
+	rawDate := fmt.Sprintf("%s %s %s %s %s", locale.constraint(date.Constraint),
+		date.Calendar.Escape(), day, monthName, year)
 
 	return CleanSpace(rawDate)
 }
 
 // Is compares two dates. Dates are only considered to be the same if the day,
-// month, year and constraint are all the same.
+// month (or MonthName), year, constraint and calendar are all the same.
 //
 // The IsEndOfRange property is not used as part of the comparison because it
 // only affects the behaviour of Time().
@@ -351,7 +438,11 @@ func (date Date) Is(date2 Date) bool {
 		return false
 	}
 
-	if date.Year != date2.Year {
+	if date.Year != date2.Year || date.DualYear != date2.DualYear {
+		return false
+	}
+
+	if date.Calendar != date2.Calendar || date.MonthName != date2.MonthName {
 		return false
 	}
 
@@ -381,7 +472,21 @@ func (date Date) Is(date2 Date) bool {
 // date. Such as if the date is approximate ("Abt.", etc) or directional
 // ("Bef.", "Aft.", etc). If this property is important to you will need to take
 // it into account in an appropriate way.
+//
+// Dates that are not Gregorian are converted to the Gregorian calendar. The
+// result is the average of the Years of the first and last day of the date.
+// Dates before the common era use astronomical years, where 1 BCE is the year
+// 0 and 2 BCE is the year -1.
 func (date Date) Years() float64 {
+	if date.usesDayNumbers() {
+		first, last, ok := date.dayNumbers()
+		if !ok {
+			return 0
+		}
+
+		return (gregorianYears(first) + gregorianYears(last)) / 2
+	}
+
 	hasDay := date.Day != 0
 	hasMonth := date.Month != 0
 	hasYear := date.Year != 0
@@ -461,7 +566,8 @@ func (date Date) IsZero() bool {
 //  Before    C      D      C       D
 //   After    B      D      D       B
 //
-// A. A match if the day, month and year are all equal.
+// A. A match if the day, month and year are all equal. Dates of different
+// calendars are a match if they cover the same days.
 //
 // B. Match if left.Years() > right.Years().
 //
@@ -493,6 +599,13 @@ func (date Date) Equals(date2 Date) bool {
 
 // See Equals.
 func (date Date) equalsA(date2 Date) bool {
+	if date.usesDayNumbers() || date2.usesDayNumbers() {
+		first1, last1, ok1 := date.dayNumbers()
+		first2, last2, ok2 := date2.dayNumbers()
+
+		return ok1 && ok2 && first1 == first2 && last1 == last2
+	}
+
 	if date.Day != date2.Day {
 		return false
 	}
@@ -562,6 +675,93 @@ func NewZeroDate() Date {
 	return Date{}
 }
 
+// DayNumber returns the Julian Day Number of the first day of the date, or the
+// last day if IsEndOfRange is true. The Julian Day Number is the number of days
+// since the 1st of January 4713 BC (in the Julian calendar) and is the same for
+// dates of any calendar.
+//
+// For example, "1 Jan 2000", "@#DJULIAN@ 19 Dec 1999" and "@#DHEBREW@ 23 TVT
+// 5760" all return 2451545.
+//
+// If the date is zero, invalid or cannot be converted the result will be 0.
+func (date Date) DayNumber() int {
+	first, last, ok := date.dayNumbers()
+	switch {
+	case !ok:
+		return 0
+
+	case date.IsEndOfRange:
+		return last
+	}
+
+	return first
+}
+
+// usesDayNumbers returns true if the date must be converted into a day number
+// to be calculated. Gregorian dates without a dual year (and not before the
+// common era) can be handled directly by the time package.
+func (date Date) usesDayNumbers() bool {
+	return date.Calendar != DateCalendarGregorian || date.DualYear != 0 ||
+		date.Year < 0
+}
+
+// year is the year that is used for calculations. This is the second year of
+// a dual year. Years before the common era are astronomical years, where 1 BCE
+// is the year 0.
+func (date Date) year() int {
+	switch {
+	case date.DualYear != 0:
+		return date.DualYear
+
+	case date.Year < 0:
+		return date.Year + 1
+	}
+
+	return date.Year
+}
+
+// dayNumbers returns the first and last day number of the date. See
+// DayNumber.
+func (date Date) dayNumbers() (first, last int, ok bool) {
+	if date.IsZero() || date.ParseError != nil ||
+		date.Calendar.validate(date.Day, date.Month, date.year()) != nil {
+		return 0, 0, false
+	}
+
+	first, last = date.Calendar.dayNumbers(date.Day, date.Month, date.year())
+
+	return first, last, true
+}
+
+func (date Date) timeFromDayNumber() time.Time {
+	dayNumber := date.DayNumber()
+	if dayNumber == 0 {
+		return time.Time{}
+	}
+
+	day, month, year := gregorianDate(dayNumber)
+	result := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	if date.IsEndOfRange {
+		result = result.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	return result
+}
+
+// gregorianYears returns the Years for a day number.
+func gregorianYears(dayNumber int) float64 {
+	_, _, year := gregorianDate(dayNumber)
+	firstDay := gregorianDayNumber(1, time.January, year)
+	daysInYear := gregorianDayNumber(1, time.January, year+1) - firstDay
+
+	// The same as Years for a complete date. One day is added to the number
+	// of days in the year so that the last day of the year is less than 1.0.
+	yearDay := dayNumber - firstDay + 1
+
+	return float64(year) + float64(yearDay)/float64(daysInYear+1)
+}
+
 var months = map[string]time.Month{
 	"apr":       time.April,
 	"april":     time.April,
@@ -599,8 +799,41 @@ func parseMonthName(parts []string, monthPos int) (string, error) {
 }
 
 var dateRegexp = regexp.MustCompile(
-	fmt.Sprintf(`(?i)^(%s|%s|%s)? ?(\d+ )?(\w+ )?(\d+)$`,
-		DateWordsAbout, DateWordsBefore, DateWordsAfter))
+	fmt.Sprintf(`(?i)^(?:(%s) )?(@#D[^@]+@ |(?:GREGORIAN|JULIAN|HEBREW|FRENCH_R) )?(\d+ )?(\w+ )?(\d+)(/\d+)?( BCE| ?\(?B\.C\.\)?)?$`,
+		dateWordsPattern(DateWordsAbout, DateWordsBefore, DateWordsAfter)))
+
+// dateWordsPattern joins DateWords constants into a single regexp alternation.
+// Each word is quoted so that the period in "Abt." does not match any
+// character, such as the space in "abt 1890".
+func dateWordsPattern(words ...string) string {
+	var patterns []string
+	for _, word := range strings.Split(strings.Join(words, "|"), "|") {
+		patterns = append(patterns, regexp.QuoteMeta(word))
+	}
+
+	return strings.Join(patterns, "|")
+}
+
+// parseDualYear returns the complete second year of a dual year. The second
+// part may be abbreviated, so "1699/00" and "1699/1700" both return 1700.
+func parseDualYear(year int, s string) int {
+	s = strings.TrimPrefix(s, "/")
+	if s == "" {
+		return 0
+	}
+
+	digits := 1
+	for range s {
+		digits *= 10
+	}
+
+	dualYear := year - year%digits + Atoi(s)
+	if dualYear <= year {
+		dualYear += digits
+	}
+
+	return dualYear
+}
 
 func parseDateParts(dateString string, isEndOfRange bool) Date {
 	parts := dateRegexp.FindStringSubmatch(dateString)
@@ -612,7 +845,8 @@ func parseDateParts(dateString string, isEndOfRange bool) Date {
 	}
 
 	// Place holders for the locations of each regexp group.
-	constraintPos, dayPos, monthPos, yearPos := 1, 2, 3, 4
+	constraintPos, calendarPos, dayPos, monthPos, yearPos, dualYearPos, eraPos :=
+		1, 2, 3, 4, 5, 6, 7
 
 	monthName, err := parseMonthName(parts, monthPos)
 	if err != nil {
@@ -622,9 +856,25 @@ func parseDateParts(dateString string, isEndOfRange bool) Date {
 		}
 	}
 
+	calendar := DateCalendarGregorian
+	if parts[calendarPos] != "" {
+		calendar = DateCalendarFromEscape(parts[calendarPos])
+	}
+
 	day := Atoi(parts[dayPos])
-	month := time.Month(months[monthName])
+	month := calendar.parseMonth(monthName)
 	year := Atoi(parts[yearPos])
+	dualYear := parseDualYear(year, parts[dualYearPos])
+
+	// Dual years are not used before the common era.
+	if parts[eraPos] != "" {
+		year, dualYear = -year, 0
+	}
+
+	if calendar != DateCalendarGregorian || dualYear != 0 || year < 0 {
+		return parseCalendarDate(day, month, monthName, year, dualYear,
+			calendar, isEndOfRange, DateConstraintFromString(parts[constraintPos]))
+	}
 
 	// Check the date is valid.
 	_, err = time.Parse("_2 1 2006",
@@ -645,3 +895,41 @@ func parseDateParts(dateString string, isEndOfRange bool) Date {
 		Constraint:   DateConstraintFromString(parts[constraintPos]),
 	}
 }
+
+// parseCalendarDate finishes parsing a date that needs to be converted with a
+// day number. That is, a date that is not Gregorian or has a dual year.
+func parseCalendarDate(day int, month time.Month, monthName string, year, dualYear int, calendar DateCalendar, isEndOfRange bool, constraint DateConstraint) Date {
+	date := Date{
+		Day:          day,
+		Month:        month,
+		Year:         year,
+		IsEndOfRange: isEndOfRange,
+		Constraint:   constraint,
+		Calendar:     calendar,
+		DualYear:     dualYear,
+	}
+
+	err := calendar.validate(day, month, date.year())
+	if err == nil && monthName != "" && month == 0 {
+		err = fmt.Errorf("unknown month for %s calendar: %s", calendar, monthName)
+	}
+
+	// Dates that cannot be converted are retained so that they can be written
+	// back out. Any other invalid date is discarded, the same as a Gregorian
+	// date.
+	if err != nil && calendar.isConvertible() {
+		date = Date{
+			IsEndOfRange: isEndOfRange,
+			Constraint:   constraint,
+			Calendar:     calendar,
+		}
+	}
+
+	if !calendar.isConvertible() {
+		date.MonthName = strings.ToUpper(monthName)
+	}
+
+	date.ParseError = err
+
+	return date
+}
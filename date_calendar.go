@@ -0,0 +1,432 @@
+package gedcom
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateCalendar is the calendar that a Date is expressed in. A calendar is
+// specified with an escape before the date, like "@#DJULIAN@ 12 Feb 1699", or
+// a keyword in GEDCOM 7.0, like "JULIAN 12 Feb 1699".
+//
+// Dates without an escape are Gregorian. The zero value of DateCalendar is
+// DateCalendarGregorian.
+//
+// Dates in all calendars (except DateCalendarRoman and DateCalendarUnknown)
+// are converted to a canonical day number (see Date.DayNumber) so that they
+// can be compared and measured against dates of other calendars.
+type DateCalendar string
+
+const (
+	DateCalendarGregorian        = DateCalendar("")
+	DateCalendarJulian           = DateCalendar("JULIAN")
+	DateCalendarHebrew           = DateCalendar("HEBREW")
+	DateCalendarFrenchRepublican = DateCalendar("FRENCH R")
+
+	// DateCalendarRoman is reserved by the GEDCOM standard but is not
+	// defined. Dates in this calendar are retained but cannot be converted.
+	DateCalendarRoman = DateCalendar("ROMAN")
+
+	// DateCalendarUnknown is a calendar that is not known. Dates in this
+	// calendar are retained but cannot be converted.
+	DateCalendarUnknown = DateCalendar("UNKNOWN")
+)
+
+// Julian Day Numbers for the first day of each calendar that has a fixed
+// epoch.
+const (
+	// 1 Tishri 1 AM (7 Oct 3761 BC, Julian).
+	hebrewEpochDayNumber = 347998
+
+	// 22 Sep 1792 was 1 Vendémiaire I.
+	frenchRepublicanEpochDayNumber = 2375840
+)
+
+var (
+	// hebrewMonths are in the order used by GEDCOM, which is the order of the
+	// months in a year that starts with Tishri. ADS (Adar Sheni) only exists in
+	// leap years.
+	hebrewMonths = []string{
+		"TSH", "CSH", "KSL", "TVT", "SHV", "ADR", "ADS",
+		"NSN", "IYR", "SVN", "TMZ", "AAV", "ELL",
+	}
+
+	// frenchRepublicanMonths ends with the complementary days that end each
+	// year.
+	frenchRepublicanMonths = []string{
+		"VEND", "BRUM", "FRIM", "NIVO", "PLUV", "VENT", "GERM",
+		"FLOR", "PRAI", "MESS", "THER", "FRUC", "COMP",
+	}
+)
+
+// DateCalendarFromEscape returns the calendar for an escape such as
+// "@#DJULIAN@", or a GEDCOM 7.0 keyword such as "JULIAN" or "FRENCH_R". The
+// escape is not case sensitive.
+//
+// Any escape that is not recognised will return DateCalendarUnknown.
+func DateCalendarFromEscape(escape string) DateCalendar {
+	name := strings.ToUpper(strings.TrimSpace(escape))
+	name = strings.TrimSuffix(strings.TrimPrefix(name, "@#D"), "@")
+
+	switch DateCalendar(name) {
+	case "GREGORIAN":
+		return DateCalendarGregorian
+
+	case "FRENCH_R":
+		return DateCalendarFrenchRepublican
+
+	case DateCalendarJulian, DateCalendarHebrew, DateCalendarFrenchRepublican,
+		DateCalendarRoman:
+		return DateCalendar(name)
+	}
+
+	return DateCalendarUnknown
+}
+
+// Escape returns the escape that proceeds dates in this calendar, like
+// "@#DJULIAN@". The Gregorian calendar does not need an escape so an empty
+// string is returned.
+func (calendar DateCalendar) Escape() string {
+	if calendar == DateCalendarGregorian {
+		return ""
+	}
+
+	return fmt.Sprintf("@#D%s@", string(calendar))
+}
+
+// String returns the name of the calendar, like "Julian".
+func (calendar DateCalendar) String() string {
+	switch calendar {
+	case DateCalendarGregorian:
+		return "Gregorian"
+
+	case DateCalendarJulian:
+		return "Julian"
+
+	case DateCalendarHebrew:
+		return "Hebrew"
+
+	case DateCalendarFrenchRepublican:
+		return "French Republican"
+
+	case DateCalendarRoman:
+		return "Roman"
+	}
+
+	return "Unknown"
+}
+
+// usesGregorianMonths is true for calendars that use the English month names
+// of the Gregorian calendar.
+func (calendar DateCalendar) usesGregorianMonths() bool {
+	return calendar == DateCalendarGregorian || calendar == DateCalendarJulian
+}
+
+// isConvertible is true if dates of the calendar can be converted into day
+// numbers.
+func (calendar DateCalendar) isConvertible() bool {
+	return calendar != DateCalendarRoman && calendar != DateCalendarUnknown
+}
+
+// monthNames returns the names of the months that are specific to the
+// calendar. The first month is at index 0. nil is returned for calendars that
+// use the Gregorian month names.
+func (calendar DateCalendar) monthNames() []string {
+	switch calendar {
+	case DateCalendarHebrew:
+		return hebrewMonths
+
+	case DateCalendarFrenchRepublican:
+		return frenchRepublicanMonths
+	}
+
+	return nil
+}
+
+// parseMonth returns the month number (starting at 1) of the month name, or 0
+// if the name is not a month of the calendar.
+func (calendar DateCalendar) parseMonth(name string) time.Month {
+	if calendar.usesGregorianMonths() {
+		return months[name]
+	}
+
+	for i, monthName := range calendar.monthNames() {
+		if strings.EqualFold(monthName, name) {
+			return time.Month(i + 1)
+		}
+	}
+
+	return 0
+}
+
+// monthName returns the abbreviated name for the month.
+func (calendar DateCalendar) monthName(month time.Month) string {
+	if calendar.usesGregorianMonths() {
+		return month.String()[:3]
+	}
+
+	names := calendar.monthNames()
+	if int(month) < 1 || int(month) > len(names) {
+		return ""
+	}
+
+	return names[month-1]
+}
+
+// monthsInYear is the number of months that can be used in a year of the
+// calendar. For the Hebrew calendar this includes ADS, even though it is only
+// valid in leap years.
+func (calendar DateCalendar) monthsInYear() int {
+	if names := calendar.monthNames(); names != nil {
+		return len(names)
+	}
+
+	return 12
+}
+
+// dayNumber returns the Julian Day Number of a complete date. The date must be
+// valid. See validate.
+func (calendar DateCalendar) dayNumber(day int, month time.Month, year int) int {
+	switch calendar {
+	case DateCalendarJulian:
+		return julianDayNumber(day, month, year)
+
+	case DateCalendarHebrew:
+		return hebrewDayNumber(day, month, year)
+
+	case DateCalendarFrenchRepublican:
+		return frenchRepublicanDayNumber(day, month, year)
+	}
+
+	return gregorianDayNumber(day, month, year)
+}
+
+// daysInMonth returns the number of days in the month, or 0 if the month does
+// not exist in that year.
+func (calendar DateCalendar) daysInMonth(month time.Month, year int) int {
+	if month < 1 || int(month) > calendar.monthsInYear() {
+		return 0
+	}
+
+	switch calendar {
+	case DateCalendarHebrew:
+		return hebrewDaysInMonth(month, year)
+
+	case DateCalendarFrenchRepublican:
+		if month == 13 {
+			return frenchRepublicanDaysInYear(year) - 360
+		}
+
+		return 30
+	}
+
+	// The Julian and Gregorian calendars.
+	if month == time.December {
+		return 31
+	}
+
+	return calendar.dayNumber(1, month+1, year) - calendar.dayNumber(1, month, year)
+}
+
+// dayNumbers returns the first and last Julian Day Number that the day, month
+// and year cover. The day and month may be zero.
+func (calendar DateCalendar) dayNumbers(day int, month time.Month, year int) (first, last int) {
+	switch {
+	case day != 0:
+		first = calendar.dayNumber(day, month, year)
+
+		return first, first
+
+	case month != 0:
+		first = calendar.dayNumber(1, month, year)
+
+		return first, first + calendar.daysInMonth(month, year) - 1
+	}
+
+	first = calendar.dayNumber(1, 1, year)
+
+	return first, calendar.dayNumber(1, 1, year+1) - 1
+}
+
+// validate returns an error if the date does not exist in the calendar. The
+// day and month may be zero.
+func (calendar DateCalendar) validate(day int, month time.Month, year int) error {
+	if !calendar.isConvertible() {
+		return fmt.Errorf("dates in the %s calendar cannot be converted", calendar)
+	}
+
+	// The Gregorian and Julian calendars may be used before the common era
+	// (as astronomical years) back to the first Julian Day Number.
+	minimumYear := 1
+	if calendar.usesGregorianMonths() {
+		minimumYear = -4712
+	}
+
+	if year < minimumYear {
+		return fmt.Errorf("year out of range for %s calendar: %d", calendar, year)
+	}
+
+	if month == 0 {
+		if day != 0 {
+			return fmt.Errorf("day without a month for %s calendar", calendar)
+		}
+
+		return nil
+	}
+
+	days := calendar.daysInMonth(month, year)
+	if days == 0 {
+		return fmt.Errorf("month out of range for %s calendar: %d", calendar, month)
+	}
+
+	if day > days {
+		return fmt.Errorf("day out of range for %s calendar: %d", calendar, day)
+	}
+
+	return nil
+}
+
+// gregorianDayNumber is from "Calendars" by L. E. Doggett in the Explanatory
+// Supplement to the Astronomical Almanac.
+func gregorianDayNumber(day int, month time.Month, year int) int {
+	a := (14 - int(month)) / 12
+	y := year + 4800 - a
+	m := int(month) + 12*a - 3
+
+	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// gregorianDate is the inverse of gregorianDayNumber.
+func gregorianDate(dayNumber int) (day int, month time.Month, year int) {
+	a := dayNumber + 32044
+	b := (4*a + 3) / 146097
+	c := a - 146097*b/4
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+
+	day = e - (153*m+2)/5 + 1
+	month = time.Month(m + 3 - 12*(m/10))
+	year = 100*b + d - 4800 + m/10
+
+	return
+}
+
+func julianDayNumber(day int, month time.Month, year int) int {
+	a := (14 - int(month)) / 12
+	y := year + 4800 - a
+	m := int(month) + 12*a - 3
+
+	return day + (153*m+2)/5 + 365*y + y/4 - 32083
+}
+
+// The Hebrew calendar calculations are from "Calendrical Calculations" by
+// Dershowitz and Reingold. They number the months from Nisan, whereas GEDCOM
+// (and hebrewMonths) start from Tishri.
+const (
+	hebrewMonthAdar   = time.Month(6)
+	hebrewMonthAdarII = time.Month(7)
+)
+
+func isHebrewLeapYear(year int) bool {
+	return (7*year+1)%19 < 7
+}
+
+// hebrewElapsedDays is the number of days from the epoch to the molad of
+// Tishri of the year, after the first postponement.
+func hebrewElapsedDays(year int) int {
+	monthsElapsed := (235*year - 234) / 19
+	partsElapsed := 12084 + 13753*monthsElapsed
+	days := 29*monthsElapsed + partsElapsed/25920
+
+	if (3*(days+1))%7 < 3 {
+		return days + 1
+	}
+
+	return days
+}
+
+// hebrewNewYear is the Julian Day Number of 1 Tishri.
+func hebrewNewYear(year int) int {
+	ny0 := hebrewElapsedDays(year - 1)
+	ny1 := hebrewElapsedDays(year)
+	ny2 := hebrewElapsedDays(year + 1)
+
+	correction := 0
+	switch {
+	case ny2-ny1 == 356:
+		correction = 2
+
+	case ny1-ny0 == 382:
+		correction = 1
+	}
+
+	return hebrewEpochDayNumber + ny1 + correction
+}
+
+func hebrewDaysInYear(year int) int {
+	return hebrewNewYear(year+1) - hebrewNewYear(year)
+}
+
+// hebrewDaysInMonth uses the GEDCOM month numbers. ADS (Adar II) has no days
+// in a year that is not a leap year.
+func hebrewDaysInMonth(month time.Month, year int) int {
+	daysInYear := hebrewDaysInYear(year)
+
+	switch month {
+	case 2: // Heshvan
+		if daysInYear%10 == 5 {
+			return 30
+		}
+
+		return 29
+
+	case 3: // Kislev
+		if daysInYear%10 == 3 {
+			return 29
+		}
+
+		return 30
+
+	case hebrewMonthAdar:
+		if isHebrewLeapYear(year) {
+			return 30
+		}
+
+		return 29
+
+	case hebrewMonthAdarII:
+		if isHebrewLeapYear(year) {
+			return 29
+		}
+
+		return 0
+
+	case 4, 9, 11, 13: // Tevet, Iyar, Tammuz and Elul
+		return 29
+	}
+
+	return 30
+}
+
+func hebrewDayNumber(day int, month time.Month, year int) int {
+	dayNumber := hebrewNewYear(year) + day - 1
+	for m := time.Month(1); m < month; m++ {
+		dayNumber += hebrewDaysInMonth(m, year)
+	}
+
+	return dayNumber
+}
+
+func frenchRepublicanDaysInYear(year int) int {
+	return (year+1)*1461/4 - year*1461/4
+}
+
+// frenchRepublicanDayNumber uses the leap years that were used while the
+// calendar was in force (III, VII and XI) and continues the same four year
+// cycle for later years.
+func frenchRepublicanDayNumber(day int, month time.Month, year int) int {
+	return frenchRepublicanEpochDayNumber - 366 + year*1461/4 +
+		30*(int(month)-1) + day
+}
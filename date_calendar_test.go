@@ -0,0 +1,201 @@
+package gedcom_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateCalendarFromEscape(t *testing.T) {
+	DateCalendarFromEscape := tf.Function(t, gedcom.DateCalendarFromEscape)
+
+	DateCalendarFromEscape("@#DGREGORIAN@").Returns(gedcom.DateCalendarGregorian)
+	DateCalendarFromEscape("@#DJULIAN@").Returns(gedcom.DateCalendarJulian)
+	DateCalendarFromEscape("@#djulian@").Returns(gedcom.DateCalendarJulian)
+	DateCalendarFromEscape("@#DHEBREW@").Returns(gedcom.DateCalendarHebrew)
+	DateCalendarFromEscape("@#DFRENCH R@").Returns(gedcom.DateCalendarFrenchRepublican)
+	DateCalendarFromEscape("@#DROMAN@").Returns(gedcom.DateCalendarRoman)
+	DateCalendarFromEscape("@#DUNKNOWN@").Returns(gedcom.DateCalendarUnknown)
+	DateCalendarFromEscape("JULIAN").Returns(gedcom.DateCalendarJulian)
+	DateCalendarFromEscape("FRENCH_R").Returns(gedcom.DateCalendarFrenchRepublican)
+	DateCalendarFromEscape("@#DFOO@").Returns(gedcom.DateCalendarUnknown)
+}
+
+func TestDateCalendar_Escape(t *testing.T) {
+	Escape := tf.Function(t, gedcom.DateCalendar.Escape)
+
+	Escape(gedcom.DateCalendarGregorian).Returns("")
+	Escape(gedcom.DateCalendarJulian).Returns("@#DJULIAN@")
+	Escape(gedcom.DateCalendarFrenchRepublican).Returns("@#DFRENCH R@")
+}
+
+var calendarDateTests = map[string]struct {
+	str                string
+	startDay, endDay   int
+	startTime, endTime time.Time
+}{
+	"1 Jan 2000": {
+		"1 Jan 2000", 2451545, 2451545,
+		time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, time.January, 1, 23, 59, 59, 999999999, time.UTC),
+	},
+	"@#DJULIAN@ 19 DEC 1999": {
+		"@#DJULIAN@ 19 Dec 1999", 2451545, 2451545,
+		time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, time.January, 1, 23, 59, 59, 999999999, time.UTC),
+	},
+	"@#DJULIAN@ 29 FEB 1700": {
+		"@#DJULIAN@ 29 Feb 1700", 2342042, 2342042,
+		time.Date(1700, time.March, 11, 0, 0, 0, 0, time.UTC),
+		time.Date(1700, time.March, 11, 23, 59, 59, 999999999, time.UTC),
+	},
+	"@#DJULIAN@ 1752": {
+		"@#DJULIAN@ 1752", 2360976, 2361341,
+		time.Date(1752, time.January, 12, 0, 0, 0, 0, time.UTC),
+		time.Date(1753, time.January, 11, 23, 59, 59, 999999999, time.UTC),
+	},
+	"@#DJULIAN@ 12 FEB 1699/00": {
+		"@#DJULIAN@ 12 Feb 1699/00", 2342025, 2342025,
+		time.Date(1700, time.February, 22, 0, 0, 0, 0, time.UTC),
+		time.Date(1700, time.February, 22, 23, 59, 59, 999999999, time.UTC),
+	},
+	"@#DJULIAN@ 12 FEB 1699/1700": {
+		"@#DJULIAN@ 12 Feb 1699/00", 2342025, 2342025,
+		time.Date(1700, time.February, 22, 0, 0, 0, 0, time.UTC),
+		time.Date(1700, time.February, 22, 23, 59, 59, 999999999, time.UTC),
+	},
+	"12 FEB 1699/00": {
+		"12 Feb 1699/00", 2342015, 2342015,
+		time.Date(1700, time.February, 12, 0, 0, 0, 0, time.UTC),
+		time.Date(1700, time.February, 12, 23, 59, 59, 999999999, time.UTC),
+	},
+	"@#DHEBREW@ 23 TVT 5760": {
+		"@#DHEBREW@ 23 TVT 5760", 2451545, 2451545,
+		time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, time.January, 1, 23, 59, 59, 999999999, time.UTC),
+	},
+	"@#DHEBREW@ 14 NSN 5784": {
+		"@#DHEBREW@ 14 NSN 5784", 2460423, 2460423,
+		time.Date(2024, time.April, 22, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.April, 22, 23, 59, 59, 999999999, time.UTC),
+	},
+	"@#DHEBREW@ 1 ADS 5779": {
+		"@#DHEBREW@ 1 ADS 5779", 2458551, 2458551,
+		time.Date(2019, time.March, 8, 0, 0, 0, 0, time.UTC),
+		time.Date(2019, time.March, 8, 23, 59, 59, 999999999, time.UTC),
+	},
+	"Abt. @#DHEBREW@ 5780": {
+		"Abt. @#DHEBREW@ 5780", 2458757, 2459111,
+		time.Date(2019, time.September, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.September, 18, 23, 59, 59, 999999999, time.UTC),
+	},
+	"@#DFRENCH R@ 1 VEND 1": {
+		"@#DFRENCH R@ 1 VEND 1", 2375840, 2375840,
+		time.Date(1792, time.September, 22, 0, 0, 0, 0, time.UTC),
+		time.Date(1792, time.September, 22, 23, 59, 59, 999999999, time.UTC),
+	},
+	"@#DFRENCH R@ 18 BRUM 8": {
+		"@#DFRENCH R@ 18 BRUM 8", 2378444, 2378444,
+		time.Date(1799, time.November, 9, 0, 0, 0, 0, time.UTC),
+		time.Date(1799, time.November, 9, 23, 59, 59, 999999999, time.UTC),
+	},
+	"@#DFRENCH R@ COMP 3": {
+		"@#DFRENCH R@ COMP 3", 2376930, 2376935,
+		time.Date(1795, time.September, 17, 0, 0, 0, 0, time.UTC),
+		time.Date(1795, time.September, 22, 23, 59, 59, 999999999, time.UTC),
+	},
+
+	// GEDCOM 7.0 uses keywords in place of the escapes.
+	"JULIAN 19 DEC 1999": {
+		"@#DJULIAN@ 19 Dec 1999", 2451545, 2451545,
+		time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, time.January, 1, 23, 59, 59, 999999999, time.UTC),
+	},
+	"HEBREW 23 TVT 5760": {
+		"@#DHEBREW@ 23 TVT 5760", 2451545, 2451545,
+		time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, time.January, 1, 23, 59, 59, 999999999, time.UTC),
+	},
+	"FRENCH_R 1 VEND 1": {
+		"@#DFRENCH R@ 1 VEND 1", 2375840, 2375840,
+		time.Date(1792, time.September, 22, 0, 0, 0, 0, time.UTC),
+		time.Date(1792, time.September, 22, 23, 59, 59, 999999999, time.UTC),
+	},
+	"GREGORIAN 1 JAN 2000": {
+		"1 Jan 2000", 2451545, 2451545,
+		time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, time.January, 1, 23, 59, 59, 999999999, time.UTC),
+	},
+
+	// Dates before the common era.
+	"1 JAN 100 BCE": {
+		"1 Jan 100 B.C.", 1684901, 1684901,
+		time.Date(-99, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(-99, time.January, 1, 23, 59, 59, 999999999, time.UTC),
+	},
+	"44 B.C.": {
+		"44 B.C.", 1705355, 1705719,
+		time.Date(-43, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(-43, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+	},
+}
+
+func TestDate_Calendars(t *testing.T) {
+	for date, test := range calendarDateTests {
+		t.Run(date, func(t *testing.T) {
+			node := gedcom.NewDateNode(date)
+			start, end := node.StartAndEndDates()
+
+			assert.NoError(t, node.DateRange().ParseError())
+			assert.Equal(t, test.str, start.String())
+			assert.Equal(t, test.startDay, start.DayNumber())
+			assert.Equal(t, test.endDay, end.DayNumber())
+			assert.Equal(t, test.startTime, start.Time())
+			assert.Equal(t, test.endTime, end.Time())
+		})
+	}
+}
+
+func TestDate_CalendarsInvalid(t *testing.T) {
+	for date, expected := range map[string]string{
+		"@#DHEBREW@ 1 ADS 5780":  "@#DHEBREW@",
+		"@#DHEBREW@ 1 JAN 5780":  "@#DHEBREW@",
+		"@#DJULIAN@ 29 FEB 1701": "@#DJULIAN@",
+		"@#DFRENCH R@ 6 COMP 2":  "@#DFRENCH R@",
+		"@#DROMAN@ 1234":         "@#DROMAN@ 1234",
+		"@#DUNKNOWN@ 1234":       "@#DUNKNOWN@ 1234",
+		"@#DROMAN@ 5 AUG 20":     "@#DROMAN@ 5 AUG 20",
+		"@#DROMAN@ ABC 20":       "@#DROMAN@ ABC 20",
+		"@#DUNKNOWN@ 5 aug 20":   "@#DUNKNOWN@ 5 AUG 20",
+		"ABT @#DROMAN@ 5 AUG 20": "Abt. @#DROMAN@ 5 AUG 20",
+	} {
+		t.Run(date, func(t *testing.T) {
+			start := gedcom.NewDateNode(date).StartDate()
+
+			assert.Equal(t, expected, start.String())
+			assert.Error(t, start.ParseError)
+			assert.Equal(t, 0, start.DayNumber())
+		})
+	}
+}
+
+func TestDate_CalendarsCompare(t *testing.T) {
+	gregorian := gedcom.NewDateNode("1 Jan 2000")
+	julian := gedcom.NewDateNode("@#DJULIAN@ 19 Dec 1999")
+	hebrew := gedcom.NewDateNode("@#DHEBREW@ 24 TVT 5760")
+
+	assert.True(t, gregorian.Equals(julian))
+	assert.False(t, gregorian.StartDate().Is(julian.StartDate()))
+	assert.Equal(t, gregorian.Years(), julian.Years())
+	assert.True(t, julian.IsBefore(hebrew))
+	assert.True(t, hebrew.IsAfter(gregorian))
+	assert.Equal(t, 1.0, gregorian.Similarity(julian, gedcom.DefaultMaxYearsForSimilarity))
+
+	min, max, err := hebrew.Sub(julian)
+	assert.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, min.Duration)
+	assert.Equal(t, 24*time.Hour, max.Duration)
+}
@@ -0,0 +1,331 @@
+package gedcom
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// DateLocale contains the words used to write dates in a language.
+//
+// Each field contains one or more words separated by pipes, in the same way
+// as the DateWords constants. Words are not case sensitive and may contain
+// spaces, like "antes de". The first word of each field is used when rendering
+// a date with Date.StringInLocale.
+//
+// Dates are always written in a GEDCOM file in English. However, some
+// applications export dates in the language of the user. A Decoder can be
+// configured with extra locales (see Decoder.DateLocales) so that these dates
+// can still be understood.
+//
+// The month names only apply to the Gregorian and Julian calendars. The names
+// of the months of other calendars are always the names used by GEDCOM.
+//
+// The words of a locale are read the first time it is used to parse a date so
+// a locale must not be changed after that.
+type DateLocale struct {
+	// Language is the ISO 639-1 code of the language, like "nl".
+	Language string
+
+	// Months are the names of the months, starting at January.
+	Months [12]string
+
+	Between, And, From, To, About, After, Before string
+
+	// Ignore are words that are not needed to understand the date, like the
+	// "de" in the Spanish "3 de mayo de 1890".
+	Ignore string
+}
+
+var (
+	DateLocaleEnglish = &DateLocale{
+		Language: "en",
+		Months: [12]string{
+			"Jan|january", "Feb|february", "Mar|march", "Apr|april", "May",
+			"Jun|june", "Jul|july", "Aug|august", "Sep|september",
+			"Oct|october", "Nov|november", "Dec|december",
+		},
+		Between: DateWordsBetween,
+		And:     DateWordsAnd,
+		From:    DateWordsFrom,
+		To:      DateWordsTo,
+		About:   DateWordsAbout,
+		After:   DateWordsAfter,
+		Before:  DateWordsBefore,
+	}
+
+	DateLocaleDutch = &DateLocale{
+		Language: "nl",
+		Months: [12]string{
+			"jan|januari", "feb|februari", "mrt|maart|maa", "apr|april", "mei",
+			"jun|juni", "jul|juli", "aug|augustus", "sep|september|sept",
+			"okt|oktober", "nov|november", "dec|december",
+		},
+		Between: "tussen",
+		And:     "en",
+		From:    "van|vanaf",
+		To:      "tot|t/m",
+		About:   "ca.|circa|omstreeks|omstr.|ongeveer|rond",
+		After:   "na|later dan",
+		Before:  "voor|vóór|vr.",
+	}
+
+	DateLocaleFrench = &DateLocale{
+		Language: "fr",
+		Months: [12]string{
+			"janv.|janvier|jan", "févr.|février|fevrier|fév|fev", "mars",
+			"avr.|avril", "mai", "juin", "juil.|juillet", "août|aout",
+			"sept.|septembre|sep", "oct.|octobre", "nov.|novembre",
+			"déc.|décembre|decembre|dec",
+		},
+		Between: "entre",
+		And:     "et",
+		From:    "de|du|depuis",
+		To:      "à|au|jusqu'à|jusqu'au",
+		About:   "vers|env.|environ|circa",
+		After:   "après|apres|ap.",
+		Before:  "avant|av.",
+	}
+
+	DateLocaleGerman = &DateLocale{
+		Language: "de",
+		Months: [12]string{
+			"Jan|januar|jänner", "Feb|februar", "März|mär|maerz|mrz",
+			"Apr|april", "Mai", "Juni|jun", "Juli|jul", "Aug|august",
+			"Sep|september|sept", "Okt|oktober", "Nov|november",
+			"Dez|dezember",
+		},
+		Between: "zwischen",
+		And:     "und",
+		From:    "von|vom|ab",
+		To:      "bis",
+		About:   "um|ca.|circa|etwa|ungefähr",
+		After:   "nach",
+		Before:  "vor",
+	}
+
+	DateLocaleItalian = &DateLocale{
+		Language: "it",
+		Months: [12]string{
+			"gen|gennaio", "feb|febbraio", "mar|marzo", "apr|aprile",
+			"mag|maggio", "giu|giugno", "lug|luglio", "ago|agosto",
+			"set|settembre", "ott|ottobre", "nov|novembre", "dic|dicembre",
+		},
+		Between: "tra|fra",
+		And:     "e",
+		From:    "dal|da",
+		To:      "al|a",
+		About:   "circa|ca.|verso",
+		After:   "dopo il|dopo",
+		Before:  "prima del|prima di|prima",
+	}
+
+	DateLocalePortuguese = &DateLocale{
+		Language: "pt",
+		Months: [12]string{
+			"jan|janeiro", "fev|fevereiro", "mar|março|marco", "abr|abril",
+			"mai|maio", "jun|junho", "jul|julho", "ago|agosto",
+			"set|setembro", "out|outubro", "nov|novembro", "dez|dezembro",
+		},
+		Between: "entre",
+		And:     "e",
+		From:    "desde",
+		To:      "até|ate",
+		About:   "cerca de|por volta de|c.",
+		After:   "depois de|depois",
+		Before:  "antes de|antes",
+		Ignore:  "de",
+	}
+
+	DateLocaleSpanish = &DateLocale{
+		Language: "es",
+		Months: [12]string{
+			"ene|enero", "feb|febrero", "mar|marzo", "abr|abril", "may|mayo",
+			"jun|junio", "jul|julio", "ago|agosto",
+			"sep|septiembre|setiembre|sept", "oct|octubre", "nov|noviembre",
+			"dic|diciembre",
+		},
+		Between: "entre",
+		And:     "y",
+		From:    "desde",
+		To:      "hasta",
+		About:   "hacia|aprox.|aproximadamente|alrededor de|cerca de|c.",
+		After:   "después de|despues de|después|despues",
+		Before:  "antes de|antes",
+		Ignore:  "de|del",
+	}
+)
+
+// DateLocales are all of the built in locales.
+var DateLocales = []*DateLocale{
+	DateLocaleEnglish,
+	DateLocaleDutch,
+	DateLocaleFrench,
+	DateLocaleGerman,
+	DateLocaleItalian,
+	DateLocalePortuguese,
+	DateLocaleSpanish,
+}
+
+// DateLocaleForLanguage returns the built in locale for the ISO 639-1 language
+// code, like "nl". The language is not case sensitive.
+//
+// If the language does not have a built in locale the result will be nil.
+func DateLocaleForLanguage(language string) *DateLocale {
+	for _, locale := range DateLocales {
+		if strings.EqualFold(locale.Language, language) {
+			return locale
+		}
+	}
+
+	return nil
+}
+
+// orEnglish returns DateLocaleEnglish if the locale is nil.
+func (locale *DateLocale) orEnglish() *DateLocale {
+	if locale == nil {
+		return DateLocaleEnglish
+	}
+
+	return locale
+}
+
+func firstWord(words string) string {
+	return strings.Split(words, "|")[0]
+}
+
+// monthName is the name that is used when rendering the month.
+func (locale *DateLocale) monthName(month time.Month) string {
+	return firstWord(locale.orEnglish().Months[month-1])
+}
+
+// constraint is the word that is used when rendering the constraint. Exact
+// dates do not have a word.
+func (locale *DateLocale) constraint(constraint DateConstraint) string {
+	locale = locale.orEnglish()
+
+	switch constraint {
+	case DateConstraintAbout:
+		return firstWord(locale.About)
+
+	case DateConstraintAfter:
+		return firstWord(locale.After)
+
+	case DateConstraintBefore:
+		return firstWord(locale.Before)
+	}
+
+	return ""
+}
+
+// dateDictionaries caches the dictionary of each locale because it is needed
+// for every date that is parsed.
+var dateDictionaries = &sync.Map{} // map[*DateLocale]*dateDictionary
+
+// dateDictionary maps each word (in lower case and without a trailing period)
+// to the English word that can be parsed, like "Abt.". Words that should be
+// ignored map to an empty string.
+type dateDictionary struct {
+	words    map[string]string
+	maxWords int
+}
+
+func (locale *DateLocale) dictionary() *dateDictionary {
+	if dictionary, ok := dateDictionaries.Load(locale); ok {
+		return dictionary.(*dateDictionary)
+	}
+
+	dictionary := newDateDictionary(locale)
+	dateDictionaries.Store(locale, dictionary)
+
+	return dictionary
+}
+
+func newDateDictionary(locale *DateLocale) *dateDictionary {
+	dictionary := &dateDictionary{
+		words: map[string]string{},
+	}
+
+	add := func(words, english string) {
+		for _, word := range strings.Split(words, "|") {
+			word = normalizeDateWord(word)
+			if word == "" {
+				continue
+			}
+
+			dictionary.words[word] = english
+
+			if n := len(strings.Fields(word)); n > dictionary.maxWords {
+				dictionary.maxWords = n
+			}
+		}
+	}
+
+	add(locale.Ignore, "")
+
+	for i, month := range locale.Months {
+		add(month, strings.ToLower(time.Month(i+1).String()))
+	}
+
+	add(locale.Between, firstWord(DateWordsBetween))
+	add(locale.And, firstWord(DateWordsAnd))
+	add(locale.From, firstWord(DateWordsFrom))
+	add(locale.To, firstWord(DateWordsTo))
+	add(locale.About, firstWord(DateWordsAbout))
+	add(locale.After, firstWord(DateWordsAfter))
+	add(locale.Before, firstWord(DateWordsBefore))
+
+	return dictionary
+}
+
+func normalizeDateWord(word string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(word)), ".")
+}
+
+// translate replaces the words of the locale with the English words that can
+// be parsed. Words that are not part of the locale are not changed.
+func (locale *DateLocale) translate(s string) string {
+	dictionary := locale.dictionary()
+	words := strings.Fields(s)
+	translated := []string{}
+
+	for i := 0; i < len(words); {
+		found := false
+
+		// Longer phrases must be tried first so that "antes de" is not
+		// confused with "antes" followed by the ignored word "de".
+		for n := dictionary.maxWords; n > 0 && !found; n-- {
+			if i+n > len(words) {
+				continue
+			}
+
+			phrase := normalizeDateWord(strings.Join(words[i:i+n], " "))
+			if english, ok := dictionary.words[phrase]; ok {
+				if english != "" {
+					translated = append(translated, english)
+				}
+
+				i += n
+				found = true
+			}
+		}
+
+		if !found {
+			translated = append(translated, trimOrdinal(words[i]))
+			i++
+		}
+	}
+
+	return strings.Join(translated, " ")
+}
+
+// trimOrdinal removes the period from a German day, like the "3." in
+// "3. März 1890".
+func trimOrdinal(word string) string {
+	number := strings.TrimSuffix(word, ".")
+	if number == "" || strings.Trim(number, "0123456789") != "" {
+		return word
+	}
+
+	return number
+}
@@ -0,0 +1,108 @@
+package gedcom_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateLocaleForLanguage(t *testing.T) {
+	DateLocaleForLanguage := tf.Function(t, gedcom.DateLocaleForLanguage)
+
+	DateLocaleForLanguage("en").Returns(gedcom.DateLocaleEnglish)
+	DateLocaleForLanguage("NL").Returns(gedcom.DateLocaleDutch)
+	DateLocaleForLanguage("xx").Returns((*gedcom.DateLocale)(nil))
+}
+
+func TestNewDateRangeWithLocales(t *testing.T) {
+	for _, test := range []struct {
+		locale   *gedcom.DateLocale
+		date     string
+		expected string
+	}{
+		{gedcom.DateLocaleDutch, "3 mei 1890", "3 May 1890"},
+		{gedcom.DateLocaleDutch, "ca. mrt 1890", "Abt. Mar 1890"},
+		{gedcom.DateLocaleDutch, "tussen 1890 en 1900", "Bet. 1890 and 1900"},
+		{gedcom.DateLocaleGerman, "3. März 1890", "3 Mar 1890"},
+		{gedcom.DateLocaleGerman, "vor Dez. 1890", "Bef. Dec 1890"},
+		{gedcom.DateLocaleGerman, "zwischen Jan 1890 und Feb 1890", "Bet. Jan 1890 and Feb 1890"},
+		{gedcom.DateLocaleFrench, "vers 1890", "Abt. 1890"},
+		{gedcom.DateLocaleFrench, "avant 12 févr. 1890", "Bef. 12 Feb 1890"},
+		{gedcom.DateLocaleFrench, "après août 1890", "Aft. Aug 1890"},
+		{gedcom.DateLocaleItalian, "dopo il 3 maggio 1890", "Aft. 3 May 1890"},
+		{gedcom.DateLocalePortuguese, "3 de março de 1890", "3 Mar 1890"},
+		{gedcom.DateLocaleSpanish, "antes de 3 de mayo de 1890", "Bef. 3 May 1890"},
+		{gedcom.DateLocaleSpanish, "entre 1890 y 1900", "Bet. 1890 and 1900"},
+
+		// English is always understood.
+		{gedcom.DateLocaleDutch, "Abt. 3 May 1890", "Abt. 3 May 1890"},
+	} {
+		t.Run(test.locale.Language+"/"+test.date, func(t *testing.T) {
+			locales := []*gedcom.DateLocale{test.locale}
+			dateRange := gedcom.NewDateRangeWithLocales(test.date, locales)
+
+			assert.NoError(t, dateRange.ParseError())
+			assert.Equal(t, test.expected, dateRange.String())
+		})
+	}
+
+	t.Run("Unknown", func(t *testing.T) {
+		locales := []*gedcom.DateLocale{gedcom.DateLocaleDutch}
+		dateRange := gedcom.NewDateRangeWithLocales("3 foo 1890", locales)
+
+		assert.Error(t, dateRange.ParseError())
+	})
+
+	t.Run("WithoutLocales", func(t *testing.T) {
+		dateRange := gedcom.NewDateRangeWithLocales("3 mei 1890", nil)
+
+		assert.Error(t, dateRange.ParseError())
+	})
+}
+
+func TestDate_StringInLocale(t *testing.T) {
+	StringInLocale := tf.Function(t, gedcom.Date.StringInLocale)
+
+	date := gedcom.NewDateNode("Abt. 3 Mar 1890").StartDate()
+
+	StringInLocale(date, nil).Returns("Abt. 3 Mar 1890")
+	StringInLocale(date, gedcom.DateLocaleEnglish).Returns("Abt. 3 Mar 1890")
+	StringInLocale(date, gedcom.DateLocaleDutch).Returns("ca. 3 mrt 1890")
+	StringInLocale(date, gedcom.DateLocaleGerman).Returns("um 3 März 1890")
+	StringInLocale(date, gedcom.DateLocaleFrench).Returns("vers 3 mars 1890")
+
+	hebrew := gedcom.NewDateNode("@#DHEBREW@ 3 TSH 5780").StartDate()
+	StringInLocale(hebrew, gedcom.DateLocaleFrench).Returns("@#DHEBREW@ 3 TSH 5780")
+}
+
+func TestDateNode_StringInLocale(t *testing.T) {
+	StringInLocale := tf.Function(t, (*gedcom.DateNode).StringInLocale)
+
+	StringInLocale((*gedcom.DateNode)(nil), gedcom.DateLocaleDutch).Returns("")
+	StringInLocale(gedcom.NewDateNode("Bet. 1890 and 1900"), gedcom.DateLocaleDutch).
+		Returns("tussen 1890 en 1900")
+	StringInLocale(gedcom.NewDateNode("Bef. Jan 1890"), gedcom.DateLocaleSpanish).
+		Returns("antes de ene 1890")
+}
+
+func TestDecoder_DateLocales(t *testing.T) {
+	ged := "0 @P1@ INDI\n1 BIRT\n2 DATE ca. 3 mei 1890\n"
+
+	decoder := gedcom.NewDecoder(strings.NewReader(ged))
+	doc, err := decoder.Decode()
+	assert.NoError(t, err)
+	assert.Len(t, doc.Warnings(), 1)
+
+	decoder = gedcom.NewDecoder(strings.NewReader(ged))
+	decoder.DateLocales = []*gedcom.DateLocale{gedcom.DateLocaleDutch}
+	doc, err = decoder.Decode()
+	assert.NoError(t, err)
+	assert.Empty(t, doc.Warnings())
+
+	birth, _ := doc.Individuals()[0].Birth()
+	assert.Equal(t, "Abt. 3 May 1890", birth.String())
+	assert.Equal(t, "ca. 3 mei 1890", birth.Value())
+}
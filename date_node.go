@@ -1,9 +1,5 @@
 package gedcom
 
-import (
-	"fmt"
-)
-
 // DateNode represents a DATE node.
 //
 // See the full specification for dates in the documentation for Date.
@@ -15,13 +11,20 @@ type DateNode struct {
 	// should not be parsed again.
 	alreadyParsed   bool
 	parsedDateRange DateRange
+
+	// document provides the Document.DateLocales.
+	document *Document
 }
 
 // NewDateNode creates a new DATE node.
 func NewDateNode(value string, children ...Node) *DateNode {
+	return newDateNode(nil, value, children...)
+}
+
+func newDateNode(document *Document, value string, children ...Node) *DateNode {
 	return &DateNode{
 		newSimpleNode(TagDate, value, "", children...),
-		false, DateRange{},
+		false, DateRange{}, document,
 	}
 }
 
@@ -41,6 +44,10 @@ func (node *DateNode) DateRange() (dateRange DateRange) {
 		node.alreadyParsed = true
 	}(node)
 
+	if node.document != nil {
+		return NewDateRangeWithLocales(node.Value(), node.document.DateLocales)
+	}
+
 	return NewDateRangeWithString(node.Value())
 }
 
@@ -53,13 +60,23 @@ func (node *DateNode) DateRange() (dateRange DateRange) {
 //   Abt. 13 Nov 1983
 //
 func (node *DateNode) String() string {
+	return node.StringInLocale(nil)
+}
+
+// StringInLocale works the same way as String but uses the words of the
+// locale. If the locale is nil then English is used.
+func (node *DateNode) StringInLocale(locale *DateLocale) string {
+	if node.IsPeriod() {
+		return node.DateRange().StringInLocale(locale)
+	}
+
 	startDate, endDate := node.StartAndEndDates()
 
 	if startDate.Is(endDate) {
-		return startDate.String()
+		return startDate.StringInLocale(locale)
 	}
 
-	return fmt.Sprintf("Bet. %s and %s", startDate, endDate)
+	return betweenInLocale(locale, startDate, endDate)
 }
 
 // Years fulfils the Yearer interface and is a convenience for:
@@ -145,6 +162,26 @@ func (node *DateNode) IsPhrase() bool {
 	return node.DateRange().IsPhrase()
 }
 
+// IsPeriod returns true if the date is a period, like "From 1900 to 1910". See
+// DateRange.IsPeriod.
+func (node *DateNode) IsPeriod() bool {
+	if node == nil {
+		return false
+	}
+
+	return node.DateRange().IsPeriod()
+}
+
+// IsRange returns true if the date is a range, like "Bet. 1900 and 1910". See
+// DateRange.IsRange.
+func (node *DateNode) IsRange() bool {
+	if node == nil {
+		return false
+	}
+
+	return node.DateRange().IsRange()
+}
+
 func (node *DateNode) Sub(node2 *DateNode) (min Duration, max Duration, errs error) {
 	nodeStart, nodeEnd := node.DateRange().StartAndEndDates()
 	node2Start, node2End := node2.DateRange().StartAndEndDates()
@@ -37,277 +37,277 @@ var dateTests = map[string]struct {
 	// Valid dates, testing each 3 digit month name. The days are a mix of DD
 	// and D.
 	"01 Jan 1980": {
-		gedcom.Date{1, time.January, 1980, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jan 1980 00"),
-		gedcom.Date{1, time.January, 1980, true, gedcom.DateConstraintExact, nil}, parseTime("1 Jan 1980 23"),
+		gedcom.Date{Day: 1, Month: time.January, Year: 1980}, parseTime("1 Jan 1980 00"),
+		gedcom.Date{Day: 1, Month: time.January, Year: 1980, IsEndOfRange: true}, parseTime("1 Jan 1980 23"),
 		"1 Jan 1980",
 	},
 	"15 Feb 1880": {
-		gedcom.Date{15, time.February, 1880, false, gedcom.DateConstraintExact, nil}, parseTime("15 Feb 1880 00"),
-		gedcom.Date{15, time.February, 1880, true, gedcom.DateConstraintExact, nil}, parseTime("15 Feb 1880 23"),
+		gedcom.Date{Day: 15, Month: time.February, Year: 1880}, parseTime("15 Feb 1880 00"),
+		gedcom.Date{Day: 15, Month: time.February, Year: 1880, IsEndOfRange: true}, parseTime("15 Feb 1880 23"),
 		"15 Feb 1880",
 	},
 	"03 Mar 1870": {
-		gedcom.Date{3, time.March, 1870, false, gedcom.DateConstraintExact, nil}, parseTime("3 Mar 1870 00"),
-		gedcom.Date{3, time.March, 1870, true, gedcom.DateConstraintExact, nil}, parseTime("3 Mar 1870 23"),
+		gedcom.Date{Day: 3, Month: time.March, Year: 1870}, parseTime("3 Mar 1870 00"),
+		gedcom.Date{Day: 3, Month: time.March, Year: 1870, IsEndOfRange: true}, parseTime("3 Mar 1870 23"),
 		"3 Mar 1870",
 	},
 	"7 Apr 2020": {
-		gedcom.Date{7, time.April, 2020, false, gedcom.DateConstraintExact, nil}, parseTime("7 Apr 2020 00"),
-		gedcom.Date{7, time.April, 2020, true, gedcom.DateConstraintExact, nil}, parseTime("7 Apr 2020 23"),
+		gedcom.Date{Day: 7, Month: time.April, Year: 2020}, parseTime("7 Apr 2020 00"),
+		gedcom.Date{Day: 7, Month: time.April, Year: 2020, IsEndOfRange: true}, parseTime("7 Apr 2020 23"),
 		"7 Apr 2020",
 	},
 	"6 May 1989": {
-		gedcom.Date{6, time.May, 1989, false, gedcom.DateConstraintExact, nil}, parseTime("6 May 1989 00"),
-		gedcom.Date{6, time.May, 1989, true, gedcom.DateConstraintExact, nil}, parseTime("6 May 1989 23"),
+		gedcom.Date{Day: 6, Month: time.May, Year: 1989}, parseTime("6 May 1989 00"),
+		gedcom.Date{Day: 6, Month: time.May, Year: 1989, IsEndOfRange: true}, parseTime("6 May 1989 23"),
 		"6 May 1989",
 	},
 	"8 Jun 2001": {
-		gedcom.Date{8, time.June, 2001, false, gedcom.DateConstraintExact, nil}, parseTime("8 Jun 2001 00"),
-		gedcom.Date{8, time.June, 2001, true, gedcom.DateConstraintExact, nil}, parseTime("8 Jun 2001 23"),
+		gedcom.Date{Day: 8, Month: time.June, Year: 2001}, parseTime("8 Jun 2001 00"),
+		gedcom.Date{Day: 8, Month: time.June, Year: 2001, IsEndOfRange: true}, parseTime("8 Jun 2001 23"),
 		"8 Jun 2001",
 	},
 	"19 Jul 2003": {
-		gedcom.Date{19, time.July, 2003, false, gedcom.DateConstraintExact, nil}, parseTime("19 Jul 2003 00"),
-		gedcom.Date{19, time.July, 2003, true, gedcom.DateConstraintExact, nil}, parseTime("19 Jul 2003 23"),
+		gedcom.Date{Day: 19, Month: time.July, Year: 2003}, parseTime("19 Jul 2003 00"),
+		gedcom.Date{Day: 19, Month: time.July, Year: 2003, IsEndOfRange: true}, parseTime("19 Jul 2003 23"),
 		"19 Jul 2003",
 	},
 	"29 Aug 1640": {
-		gedcom.Date{29, time.August, 1640, false, gedcom.DateConstraintExact, nil}, parseTime("29 Aug 1640 00"),
-		gedcom.Date{29, time.August, 1640, true, gedcom.DateConstraintExact, nil}, parseTime("29 Aug 1640 23"),
+		gedcom.Date{Day: 29, Month: time.August, Year: 1640}, parseTime("29 Aug 1640 00"),
+		gedcom.Date{Day: 29, Month: time.August, Year: 1640, IsEndOfRange: true}, parseTime("29 Aug 1640 23"),
 		"29 Aug 1640",
 	},
 	"13 Sep 1733": {
-		gedcom.Date{13, time.September, 1733, false, gedcom.DateConstraintExact, nil}, parseTime("13 Sep 1733 00"),
-		gedcom.Date{13, time.September, 1733, true, gedcom.DateConstraintExact, nil}, parseTime("13 Sep 1733 23"),
+		gedcom.Date{Day: 13, Month: time.September, Year: 1733}, parseTime("13 Sep 1733 00"),
+		gedcom.Date{Day: 13, Month: time.September, Year: 1733, IsEndOfRange: true}, parseTime("13 Sep 1733 23"),
 		"13 Sep 1733",
 	},
 	"6 Oct 1848": {
-		gedcom.Date{6, time.October, 1848, false, gedcom.DateConstraintExact, nil}, parseTime("6 Oct 1848 00"),
-		gedcom.Date{6, time.October, 1848, true, gedcom.DateConstraintExact, nil}, parseTime("6 Oct 1848 23"),
+		gedcom.Date{Day: 6, Month: time.October, Year: 1848}, parseTime("6 Oct 1848 00"),
+		gedcom.Date{Day: 6, Month: time.October, Year: 1848, IsEndOfRange: true}, parseTime("6 Oct 1848 23"),
 		"6 Oct 1848",
 	},
 	"18 Nov 1992": {
-		gedcom.Date{18, time.November, 1992, false, gedcom.DateConstraintExact, nil}, parseTime("18 Nov 1992 00"),
-		gedcom.Date{18, time.November, 1992, true, gedcom.DateConstraintExact, nil}, parseTime("18 Nov 1992 23"),
+		gedcom.Date{Day: 18, Month: time.November, Year: 1992}, parseTime("18 Nov 1992 00"),
+		gedcom.Date{Day: 18, Month: time.November, Year: 1992, IsEndOfRange: true}, parseTime("18 Nov 1992 23"),
 		"18 Nov 1992",
 	},
 	"25 Dec 1901": {
-		gedcom.Date{25, time.December, 1901, false, gedcom.DateConstraintExact, nil}, parseTime("25 Dec 1901 00"),
-		gedcom.Date{25, time.December, 1901, true, gedcom.DateConstraintExact, nil}, parseTime("25 Dec 1901 23"),
+		gedcom.Date{Day: 25, Month: time.December, Year: 1901}, parseTime("25 Dec 1901 00"),
+		gedcom.Date{Day: 25, Month: time.December, Year: 1901, IsEndOfRange: true}, parseTime("25 Dec 1901 23"),
 		"25 Dec 1901",
 	},
 
 	// Valid dates, testing each full month name. The days are a mix of dd
 	// and d.
 	"01 January 1980": {
-		gedcom.Date{1, time.January, 1980, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jan 1980 00"),
-		gedcom.Date{1, time.January, 1980, true, gedcom.DateConstraintExact, nil}, parseTime("1 Jan 1980 23"),
+		gedcom.Date{Day: 1, Month: time.January, Year: 1980}, parseTime("1 Jan 1980 00"),
+		gedcom.Date{Day: 1, Month: time.January, Year: 1980, IsEndOfRange: true}, parseTime("1 Jan 1980 23"),
 		"1 Jan 1980",
 	},
 	"15 February 1880": {
-		gedcom.Date{15, time.February, 1880, false, gedcom.DateConstraintExact, nil}, parseTime("15 Feb 1880 00"),
-		gedcom.Date{15, time.February, 1880, true, gedcom.DateConstraintExact, nil}, parseTime("15 Feb 1880 23"),
+		gedcom.Date{Day: 15, Month: time.February, Year: 1880}, parseTime("15 Feb 1880 00"),
+		gedcom.Date{Day: 15, Month: time.February, Year: 1880, IsEndOfRange: true}, parseTime("15 Feb 1880 23"),
 		"15 Feb 1880",
 	},
 	"03 March 1870": {
-		gedcom.Date{3, time.March, 1870, false, gedcom.DateConstraintExact, nil}, parseTime("3 Mar 1870 00"),
-		gedcom.Date{3, time.March, 1870, true, gedcom.DateConstraintExact, nil}, parseTime("3 Mar 1870 23"),
+		gedcom.Date{Day: 3, Month: time.March, Year: 1870}, parseTime("3 Mar 1870 00"),
+		gedcom.Date{Day: 3, Month: time.March, Year: 1870, IsEndOfRange: true}, parseTime("3 Mar 1870 23"),
 		"3 Mar 1870",
 	},
 	"7 April 2020": {
-		gedcom.Date{7, time.April, 2020, false, gedcom.DateConstraintExact, nil}, parseTime("7 Apr 2020 00"),
-		gedcom.Date{7, time.April, 2020, true, gedcom.DateConstraintExact, nil}, parseTime("7 Apr 2020 23"),
+		gedcom.Date{Day: 7, Month: time.April, Year: 2020}, parseTime("7 Apr 2020 00"),
+		gedcom.Date{Day: 7, Month: time.April, Year: 2020, IsEndOfRange: true}, parseTime("7 Apr 2020 23"),
 		"7 Apr 2020",
 	},
 	"8 June 2001": {
-		gedcom.Date{8, time.June, 2001, false, gedcom.DateConstraintExact, nil}, parseTime("8 Jun 2001 00"),
-		gedcom.Date{8, time.June, 2001, true, gedcom.DateConstraintExact, nil}, parseTime("8 Jun 2001 23"),
+		gedcom.Date{Day: 8, Month: time.June, Year: 2001}, parseTime("8 Jun 2001 00"),
+		gedcom.Date{Day: 8, Month: time.June, Year: 2001, IsEndOfRange: true}, parseTime("8 Jun 2001 23"),
 		"8 Jun 2001",
 	},
 	"19 July 2003": {
-		gedcom.Date{19, time.July, 2003, false, gedcom.DateConstraintExact, nil}, parseTime("19 Jul 2003 00"),
-		gedcom.Date{19, time.July, 2003, true, gedcom.DateConstraintExact, nil}, parseTime("19 Jul 2003 23"),
+		gedcom.Date{Day: 19, Month: time.July, Year: 2003}, parseTime("19 Jul 2003 00"),
+		gedcom.Date{Day: 19, Month: time.July, Year: 2003, IsEndOfRange: true}, parseTime("19 Jul 2003 23"),
 		"19 Jul 2003",
 	},
 	"29 August 1640": {
-		gedcom.Date{29, time.August, 1640, false, gedcom.DateConstraintExact, nil}, parseTime("29 Aug 1640 00"),
-		gedcom.Date{29, time.August, 1640, true, gedcom.DateConstraintExact, nil}, parseTime("29 Aug 1640 23"),
+		gedcom.Date{Day: 29, Month: time.August, Year: 1640}, parseTime("29 Aug 1640 00"),
+		gedcom.Date{Day: 29, Month: time.August, Year: 1640, IsEndOfRange: true}, parseTime("29 Aug 1640 23"),
 		"29 Aug 1640",
 	},
 	"13 September 1733": {
-		gedcom.Date{13, time.September, 1733, false, gedcom.DateConstraintExact, nil}, parseTime("13 Sep 1733 00"),
-		gedcom.Date{13, time.September, 1733, true, gedcom.DateConstraintExact, nil}, parseTime("13 Sep 1733 23"),
+		gedcom.Date{Day: 13, Month: time.September, Year: 1733}, parseTime("13 Sep 1733 00"),
+		gedcom.Date{Day: 13, Month: time.September, Year: 1733, IsEndOfRange: true}, parseTime("13 Sep 1733 23"),
 		"13 Sep 1733",
 	},
 	"6 October 1848": {
-		gedcom.Date{6, time.October, 1848, false, gedcom.DateConstraintExact, nil}, parseTime("6 Oct 1848 00"),
-		gedcom.Date{6, time.October, 1848, true, gedcom.DateConstraintExact, nil}, parseTime("6 Oct 1848 23"),
+		gedcom.Date{Day: 6, Month: time.October, Year: 1848}, parseTime("6 Oct 1848 00"),
+		gedcom.Date{Day: 6, Month: time.October, Year: 1848, IsEndOfRange: true}, parseTime("6 Oct 1848 23"),
 		"6 Oct 1848",
 	},
 	"18 November 1992": {
-		gedcom.Date{18, time.November, 1992, false, gedcom.DateConstraintExact, nil}, parseTime("18 Nov 1992 00"),
-		gedcom.Date{18, time.November, 1992, true, gedcom.DateConstraintExact, nil}, parseTime("18 Nov 1992 23"),
+		gedcom.Date{Day: 18, Month: time.November, Year: 1992}, parseTime("18 Nov 1992 00"),
+		gedcom.Date{Day: 18, Month: time.November, Year: 1992, IsEndOfRange: true}, parseTime("18 Nov 1992 23"),
 		"18 Nov 1992",
 	},
 	"25 December 1901": {
-		gedcom.Date{25, time.December, 1901, false, gedcom.DateConstraintExact, nil}, parseTime("25 Dec 1901 00"),
-		gedcom.Date{25, time.December, 1901, true, gedcom.DateConstraintExact, nil}, parseTime("25 Dec 1901 23"),
+		gedcom.Date{Day: 25, Month: time.December, Year: 1901}, parseTime("25 Dec 1901 00"),
+		gedcom.Date{Day: 25, Month: time.December, Year: 1901, IsEndOfRange: true}, parseTime("25 Dec 1901 23"),
 		"25 Dec 1901",
 	},
 
 	// Only month and year combinations.
 	"Jan 1980": {
-		gedcom.Date{0, time.January, 1980, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jan 1980 00"),
-		gedcom.Date{0, time.January, 1980, true, gedcom.DateConstraintExact, nil}, parseTime("31 Jan 1980 23"),
+		gedcom.Date{Month: time.January, Year: 1980}, parseTime("1 Jan 1980 00"),
+		gedcom.Date{Month: time.January, Year: 1980, IsEndOfRange: true}, parseTime("31 Jan 1980 23"),
 		"Jan 1980",
 	},
 	"Feb 1880": {
-		gedcom.Date{0, time.February, 1880, false, gedcom.DateConstraintExact, nil}, parseTime("1 Feb 1880 00"),
-		gedcom.Date{0, time.February, 1880, true, gedcom.DateConstraintExact, nil}, parseTime("29 Feb 1880 23"),
+		gedcom.Date{Month: time.February, Year: 1880}, parseTime("1 Feb 1880 00"),
+		gedcom.Date{Month: time.February, Year: 1880, IsEndOfRange: true}, parseTime("29 Feb 1880 23"),
 		"Feb 1880",
 	},
 	"Mar 1870": {
-		gedcom.Date{0, time.March, 1870, false, gedcom.DateConstraintExact, nil}, parseTime("1 Mar 1870 00"),
-		gedcom.Date{0, time.March, 1870, true, gedcom.DateConstraintExact, nil}, parseTime("31 Mar 1870 23"),
+		gedcom.Date{Month: time.March, Year: 1870}, parseTime("1 Mar 1870 00"),
+		gedcom.Date{Month: time.March, Year: 1870, IsEndOfRange: true}, parseTime("31 Mar 1870 23"),
 		"Mar 1870",
 	},
 	"Apr 2020": {
-		gedcom.Date{0, time.April, 2020, false, gedcom.DateConstraintExact, nil}, parseTime("1 Apr 2020 00"),
-		gedcom.Date{0, time.April, 2020, true, gedcom.DateConstraintExact, nil}, parseTime("30 Apr 2020 23"),
+		gedcom.Date{Month: time.April, Year: 2020}, parseTime("1 Apr 2020 00"),
+		gedcom.Date{Month: time.April, Year: 2020, IsEndOfRange: true}, parseTime("30 Apr 2020 23"),
 		"Apr 2020",
 	},
 	"May 1989": {
-		gedcom.Date{0, time.May, 1989, false, gedcom.DateConstraintExact, nil}, parseTime("1 May 1989 00"),
-		gedcom.Date{0, time.May, 1989, true, gedcom.DateConstraintExact, nil}, parseTime("31 May 1989 23"),
+		gedcom.Date{Month: time.May, Year: 1989}, parseTime("1 May 1989 00"),
+		gedcom.Date{Month: time.May, Year: 1989, IsEndOfRange: true}, parseTime("31 May 1989 23"),
 		"May 1989",
 	},
 	"Jun 2001": {
-		gedcom.Date{0, time.June, 2001, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jun 2001 00"),
-		gedcom.Date{0, time.June, 2001, true, gedcom.DateConstraintExact, nil}, parseTime("30 Jun 2001 23"),
+		gedcom.Date{Month: time.June, Year: 2001}, parseTime("1 Jun 2001 00"),
+		gedcom.Date{Month: time.June, Year: 2001, IsEndOfRange: true}, parseTime("30 Jun 2001 23"),
 		"Jun 2001",
 	},
 	"Jul 2003": {
-		gedcom.Date{0, time.July, 2003, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jul 2003 00"),
-		gedcom.Date{0, time.July, 2003, true, gedcom.DateConstraintExact, nil}, parseTime("31 Jul 2003 23"),
+		gedcom.Date{Month: time.July, Year: 2003}, parseTime("1 Jul 2003 00"),
+		gedcom.Date{Month: time.July, Year: 2003, IsEndOfRange: true}, parseTime("31 Jul 2003 23"),
 		"Jul 2003",
 	},
 	"Aug 1640": {
-		gedcom.Date{0, time.August, 1640, false, gedcom.DateConstraintExact, nil}, parseTime("1 Aug 1640 00"),
-		gedcom.Date{0, time.August, 1640, true, gedcom.DateConstraintExact, nil}, parseTime("31 Aug 1640 23"),
+		gedcom.Date{Month: time.August, Year: 1640}, parseTime("1 Aug 1640 00"),
+		gedcom.Date{Month: time.August, Year: 1640, IsEndOfRange: true}, parseTime("31 Aug 1640 23"),
 		"Aug 1640",
 	},
 	"Sep 1733": {
-		gedcom.Date{0, time.September, 1733, false, gedcom.DateConstraintExact, nil}, parseTime("1 Sep 1733 00"),
-		gedcom.Date{0, time.September, 1733, true, gedcom.DateConstraintExact, nil}, parseTime("30 Sep 1733 23"),
+		gedcom.Date{Month: time.September, Year: 1733}, parseTime("1 Sep 1733 00"),
+		gedcom.Date{Month: time.September, Year: 1733, IsEndOfRange: true}, parseTime("30 Sep 1733 23"),
 		"Sep 1733",
 	},
 	"Oct 1848": {
-		gedcom.Date{0, time.October, 1848, false, gedcom.DateConstraintExact, nil}, parseTime("1 Oct 1848 00"),
-		gedcom.Date{0, time.October, 1848, true, gedcom.DateConstraintExact, nil}, parseTime("31 Oct 1848 23"),
+		gedcom.Date{Month: time.October, Year: 1848}, parseTime("1 Oct 1848 00"),
+		gedcom.Date{Month: time.October, Year: 1848, IsEndOfRange: true}, parseTime("31 Oct 1848 23"),
 		"Oct 1848",
 	},
 	"Nov 1992": {
-		gedcom.Date{0, time.November, 1992, false, gedcom.DateConstraintExact, nil}, parseTime("1 Nov 1992 00"),
-		gedcom.Date{0, time.November, 1992, true, gedcom.DateConstraintExact, nil}, parseTime("30 Nov 1992 23"),
+		gedcom.Date{Month: time.November, Year: 1992}, parseTime("1 Nov 1992 00"),
+		gedcom.Date{Month: time.November, Year: 1992, IsEndOfRange: true}, parseTime("30 Nov 1992 23"),
 		"Nov 1992",
 	},
 	"Dec 1901": {
-		gedcom.Date{0, time.December, 1901, false, gedcom.DateConstraintExact, nil}, parseTime("1 Dec 1901 00"),
-		gedcom.Date{0, time.December, 1901, true, gedcom.DateConstraintExact, nil}, parseTime("31 Dec 1901 23"),
+		gedcom.Date{Month: time.December, Year: 1901}, parseTime("1 Dec 1901 00"),
+		gedcom.Date{Month: time.December, Year: 1901, IsEndOfRange: true}, parseTime("31 Dec 1901 23"),
 		"Dec 1901",
 	},
 	"January 1980": {
-		gedcom.Date{0, time.January, 1980, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jan 1980 00"),
-		gedcom.Date{0, time.January, 1980, true, gedcom.DateConstraintExact, nil}, parseTime("31 Jan 1980 23"),
+		gedcom.Date{Month: time.January, Year: 1980}, parseTime("1 Jan 1980 00"),
+		gedcom.Date{Month: time.January, Year: 1980, IsEndOfRange: true}, parseTime("31 Jan 1980 23"),
 		"Jan 1980",
 	},
 	"February 1880": {
-		gedcom.Date{0, time.February, 1880, false, gedcom.DateConstraintExact, nil}, parseTime("1 Feb 1880 00"),
-		gedcom.Date{0, time.February, 1880, true, gedcom.DateConstraintExact, nil}, parseTime("29 Feb 1880 23"),
+		gedcom.Date{Month: time.February, Year: 1880}, parseTime("1 Feb 1880 00"),
+		gedcom.Date{Month: time.February, Year: 1880, IsEndOfRange: true}, parseTime("29 Feb 1880 23"),
 		"Feb 1880",
 	},
 	"March 1870": {
-		gedcom.Date{0, time.March, 1870, false, gedcom.DateConstraintExact, nil}, parseTime("1 Mar 1870 00"),
-		gedcom.Date{0, time.March, 1870, true, gedcom.DateConstraintExact, nil}, parseTime("31 Mar 1870 23"),
+		gedcom.Date{Month: time.March, Year: 1870}, parseTime("1 Mar 1870 00"),
+		gedcom.Date{Month: time.March, Year: 1870, IsEndOfRange: true}, parseTime("31 Mar 1870 23"),
 		"Mar 1870",
 	},
 	"April 2020": {
-		gedcom.Date{0, time.April, 2020, false, gedcom.DateConstraintExact, nil}, parseTime("1 Apr 2020 00"),
-		gedcom.Date{0, time.April, 2020, true, gedcom.DateConstraintExact, nil}, parseTime("30 Apr 2020 23"),
+		gedcom.Date{Month: time.April, Year: 2020}, parseTime("1 Apr 2020 00"),
+		gedcom.Date{Month: time.April, Year: 2020, IsEndOfRange: true}, parseTime("30 Apr 2020 23"),
 		"Apr 2020",
 	},
 	"June 2001": {
-		gedcom.Date{0, time.June, 2001, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jun 2001 00"),
-		gedcom.Date{0, time.June, 2001, true, gedcom.DateConstraintExact, nil}, parseTime("30 Jun 2001 23"),
+		gedcom.Date{Month: time.June, Year: 2001}, parseTime("1 Jun 2001 00"),
+		gedcom.Date{Month: time.June, Year: 2001, IsEndOfRange: true}, parseTime("30 Jun 2001 23"),
 		"Jun 2001",
 	},
 	"July 2003": {
-		gedcom.Date{0, time.July, 2003, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jul 2003 00"),
-		gedcom.Date{0, time.July, 2003, true, gedcom.DateConstraintExact, nil}, parseTime("31 Jul 2003 23"),
+		gedcom.Date{Month: time.July, Year: 2003}, parseTime("1 Jul 2003 00"),
+		gedcom.Date{Month: time.July, Year: 2003, IsEndOfRange: true}, parseTime("31 Jul 2003 23"),
 		"Jul 2003",
 	},
 	"August 1640": {
-		gedcom.Date{0, time.August, 1640, false, gedcom.DateConstraintExact, nil}, parseTime("1 Aug 1640 00"),
-		gedcom.Date{0, time.August, 1640, true, gedcom.DateConstraintExact, nil}, parseTime("31 Aug 1640 23"),
+		gedcom.Date{Month: time.August, Year: 1640}, parseTime("1 Aug 1640 00"),
+		gedcom.Date{Month: time.August, Year: 1640, IsEndOfRange: true}, parseTime("31 Aug 1640 23"),
 		"Aug 1640",
 	},
 	"September 1733": {
-		gedcom.Date{0, time.September, 1733, false, gedcom.DateConstraintExact, nil}, parseTime("1 Sep 1733 00"),
-		gedcom.Date{0, time.September, 1733, true, gedcom.DateConstraintExact, nil}, parseTime("30 Sep 1733 23"),
+		gedcom.Date{Month: time.September, Year: 1733}, parseTime("1 Sep 1733 00"),
+		gedcom.Date{Month: time.September, Year: 1733, IsEndOfRange: true}, parseTime("30 Sep 1733 23"),
 		"Sep 1733",
 	},
 	"October 1848": {
-		gedcom.Date{0, time.October, 1848, false, gedcom.DateConstraintExact, nil}, parseTime("1 Oct 1848 00"),
-		gedcom.Date{0, time.October, 1848, true, gedcom.DateConstraintExact, nil}, parseTime("31 Oct 1848 23"),
+		gedcom.Date{Month: time.October, Year: 1848}, parseTime("1 Oct 1848 00"),
+		gedcom.Date{Month: time.October, Year: 1848, IsEndOfRange: true}, parseTime("31 Oct 1848 23"),
 		"Oct 1848",
 	},
 	"November 1992": {
-		gedcom.Date{0, time.November, 1992, false, gedcom.DateConstraintExact, nil}, parseTime("1 Nov 1992 00"),
-		gedcom.Date{0, time.November, 1992, true, gedcom.DateConstraintExact, nil}, parseTime("30 Nov 1992 23"),
+		gedcom.Date{Month: time.November, Year: 1992}, parseTime("1 Nov 1992 00"),
+		gedcom.Date{Month: time.November, Year: 1992, IsEndOfRange: true}, parseTime("30 Nov 1992 23"),
 		"Nov 1992",
 	},
 	"December 1901": {
-		gedcom.Date{0, time.December, 1901, false, gedcom.DateConstraintExact, nil}, parseTime("1 Dec 1901 00"),
-		gedcom.Date{0, time.December, 1901, true, gedcom.DateConstraintExact, nil}, parseTime("31 Dec 1901 23"),
+		gedcom.Date{Month: time.December, Year: 1901}, parseTime("1 Dec 1901 00"),
+		gedcom.Date{Month: time.December, Year: 1901, IsEndOfRange: true}, parseTime("31 Dec 1901 23"),
 		"Dec 1901",
 	},
 
 	// Months with different capitalization.
 	"DECEMBER 1901": {
-		gedcom.Date{0, time.December, 1901, false, gedcom.DateConstraintExact, nil}, parseTime("1 Dec 1901 00"),
-		gedcom.Date{0, time.December, 1901, true, gedcom.DateConstraintExact, nil}, parseTime("31 Dec 1901 23"),
+		gedcom.Date{Month: time.December, Year: 1901}, parseTime("1 Dec 1901 00"),
+		gedcom.Date{Month: time.December, Year: 1901, IsEndOfRange: true}, parseTime("31 Dec 1901 23"),
 		"Dec 1901",
 	},
 	"13 SEP 1733": {
-		gedcom.Date{13, time.September, 1733, false, gedcom.DateConstraintExact, nil}, parseTime("13 Sep 1733 00"),
-		gedcom.Date{13, time.September, 1733, true, gedcom.DateConstraintExact, nil}, parseTime("13 Sep 1733 23"),
+		gedcom.Date{Day: 13, Month: time.September, Year: 1733}, parseTime("13 Sep 1733 00"),
+		gedcom.Date{Day: 13, Month: time.September, Year: 1733, IsEndOfRange: true}, parseTime("13 Sep 1733 23"),
 		"13 Sep 1733",
 	},
 
 	// Only year.
 	"834": {
-		gedcom.Date{0, 0, 834, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jan 0834 00"),
-		gedcom.Date{0, 0, 834, true, gedcom.DateConstraintExact, nil}, parseTime("31 Dec 0834 23"),
+		gedcom.Date{Year: 834}, parseTime("1 Jan 0834 00"),
+		gedcom.Date{Year: 834, IsEndOfRange: true}, parseTime("31 Dec 0834 23"),
 		"834",
 	},
 	"0834": {
-		gedcom.Date{0, 0, 834, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jan 0834 00"),
-		gedcom.Date{0, 0, 834, true, gedcom.DateConstraintExact, nil}, parseTime("31 Dec 0834 23"),
+		gedcom.Date{Year: 834}, parseTime("1 Jan 0834 00"),
+		gedcom.Date{Year: 834, IsEndOfRange: true}, parseTime("31 Dec 0834 23"),
 		"834",
 	},
 	"1901": {
-		gedcom.Date{0, 0, 1901, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jan 1901 00"),
-		gedcom.Date{0, 0, 1901, true, gedcom.DateConstraintExact, nil}, parseTime("31 Dec 1901 23"),
+		gedcom.Date{Year: 1901}, parseTime("1 Jan 1901 00"),
+		gedcom.Date{Year: 1901, IsEndOfRange: true}, parseTime("31 Dec 1901 23"),
 		"1901",
 	},
 	"2020": {
-		gedcom.Date{0, 0, 2020, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jan 2020 00"),
-		gedcom.Date{0, 0, 2020, true, gedcom.DateConstraintExact, nil}, parseTime("31 Dec 2020 23"),
+		gedcom.Date{Year: 2020}, parseTime("1 Jan 2020 00"),
+		gedcom.Date{Year: 2020, IsEndOfRange: true}, parseTime("31 Dec 2020 23"),
 		"2020",
 	},
 	"0066": {
-		gedcom.Date{0, 0, 66, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jan 0066 00"),
-		gedcom.Date{0, 0, 66, true, gedcom.DateConstraintExact, nil}, parseTime("31 Dec 0066 23"),
+		gedcom.Date{Year: 66}, parseTime("1 Jan 0066 00"),
+		gedcom.Date{Year: 66, IsEndOfRange: true}, parseTime("31 Dec 0066 23"),
 		"66",
 	},
 
@@ -315,165 +315,175 @@ var dateTests = map[string]struct {
 	// lines or carriage returns in the node value so we do not need to test
 	// those cases.
 	"  18 November 1992": {
-		gedcom.Date{18, time.November, 1992, false, gedcom.DateConstraintExact, nil}, parseTime("18 Nov 1992 00"),
-		gedcom.Date{18, time.November, 1992, true, gedcom.DateConstraintExact, nil}, parseTime("18 Nov 1992 23"),
+		gedcom.Date{Day: 18, Month: time.November, Year: 1992}, parseTime("18 Nov 1992 00"),
+		gedcom.Date{Day: 18, Month: time.November, Year: 1992, IsEndOfRange: true}, parseTime("18 Nov 1992 23"),
 		"18 Nov 1992",
 	},
 	"15 Feb   1880": {
-		gedcom.Date{15, time.February, 1880, false, gedcom.DateConstraintExact, nil}, parseTime("15 Feb 1880 00"),
-		gedcom.Date{15, time.February, 1880, true, gedcom.DateConstraintExact, nil}, parseTime("15 Feb 1880 23"),
+		gedcom.Date{Day: 15, Month: time.February, Year: 1880}, parseTime("15 Feb 1880 00"),
+		gedcom.Date{Day: 15, Month: time.February, Year: 1880, IsEndOfRange: true}, parseTime("15 Feb 1880 23"),
 		"15 Feb 1880",
 	},
 	"Feb   1880": {
-		gedcom.Date{0, time.February, 1880, false, gedcom.DateConstraintExact, nil}, parseTime("1 Feb 1880 00"),
-		gedcom.Date{0, time.February, 1880, true, gedcom.DateConstraintExact, nil}, parseTime("29 Feb 1880 23"),
+		gedcom.Date{Month: time.February, Year: 1880}, parseTime("1 Feb 1880 00"),
+		gedcom.Date{Month: time.February, Year: 1880, IsEndOfRange: true}, parseTime("29 Feb 1880 23"),
 		"Feb 1880",
 	},
 	"25 December 1901  ": {
-		gedcom.Date{25, time.December, 1901, false, gedcom.DateConstraintExact, nil}, parseTime("25 Dec 1901 00"),
-		gedcom.Date{25, time.December, 1901, true, gedcom.DateConstraintExact, nil}, parseTime("25 Dec 1901 23"),
+		gedcom.Date{Day: 25, Month: time.December, Year: 1901}, parseTime("25 Dec 1901 00"),
+		gedcom.Date{Day: 25, Month: time.December, Year: 1901, IsEndOfRange: true}, parseTime("25 Dec 1901 23"),
 		"25 Dec 1901",
 	},
 	" 1901  ": {
-		gedcom.Date{0, 0, 1901, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jan 1901 00"),
-		gedcom.Date{0, 0, 1901, true, gedcom.DateConstraintExact, nil}, parseTime("31 Dec 1901 23"),
+		gedcom.Date{Year: 1901}, parseTime("1 Jan 1901 00"),
+		gedcom.Date{Year: 1901, IsEndOfRange: true}, parseTime("31 Dec 1901 23"),
 		"1901",
 	},
 
 	// Before dates.
 	"Before Feb 1907": {
-		gedcom.Date{0, time.February, 1907, false, gedcom.DateConstraintBefore, nil}, parseTime("1 Feb 1907 00"),
-		gedcom.Date{0, time.February, 1907, true, gedcom.DateConstraintBefore, nil}, parseTime("28 Feb 1907 23"),
+		gedcom.Date{Month: time.February, Year: 1907, Constraint: gedcom.DateConstraintBefore}, parseTime("1 Feb 1907 00"),
+		gedcom.Date{Month: time.February, Year: 1907, IsEndOfRange: true, Constraint: gedcom.DateConstraintBefore}, parseTime("28 Feb 1907 23"),
 		"Bef. Feb 1907",
 	},
 	"bef. 21 Dec 1884": {
-		gedcom.Date{21, time.December, 1884, false, gedcom.DateConstraintBefore, nil}, parseTime("21 Dec 1884 00"),
-		gedcom.Date{21, time.December, 1884, true, gedcom.DateConstraintBefore, nil}, parseTime("21 Dec 1884 23"),
+		gedcom.Date{Day: 21, Month: time.December, Year: 1884, Constraint: gedcom.DateConstraintBefore}, parseTime("21 Dec 1884 00"),
+		gedcom.Date{Day: 21, Month: time.December, Year: 1884, IsEndOfRange: true, Constraint: gedcom.DateConstraintBefore}, parseTime("21 Dec 1884 23"),
 		"Bef. 21 Dec 1884",
 	},
 
 	// After dates.
 	"after Feb 1907": {
-		gedcom.Date{0, time.February, 1907, false, gedcom.DateConstraintAfter, nil}, parseTime("1 Feb 1907 00"),
-		gedcom.Date{0, time.February, 1907, true, gedcom.DateConstraintAfter, nil}, parseTime("28 Feb 1907 23"),
+		gedcom.Date{Month: time.February, Year: 1907, Constraint: gedcom.DateConstraintAfter}, parseTime("1 Feb 1907 00"),
+		gedcom.Date{Month: time.February, Year: 1907, IsEndOfRange: true, Constraint: gedcom.DateConstraintAfter}, parseTime("28 Feb 1907 23"),
 		"Aft. Feb 1907",
 	},
 	"Aft. 21 Dec 1884": {
-		gedcom.Date{21, time.December, 1884, false, gedcom.DateConstraintAfter, nil}, parseTime("21 Dec 1884 00"),
-		gedcom.Date{21, time.December, 1884, true, gedcom.DateConstraintAfter, nil}, parseTime("21 Dec 1884 23"),
+		gedcom.Date{Day: 21, Month: time.December, Year: 1884, Constraint: gedcom.DateConstraintAfter}, parseTime("21 Dec 1884 00"),
+		gedcom.Date{Day: 21, Month: time.December, Year: 1884, IsEndOfRange: true, Constraint: gedcom.DateConstraintAfter}, parseTime("21 Dec 1884 23"),
 		"Aft. 21 Dec 1884",
 	},
 
 	// Approximate dates.
 	"Abt. 1945": {
-		gedcom.Date{0, 0, 1945, false, gedcom.DateConstraintAbout, nil}, parseTime("1 Jan 1945 00"),
-		gedcom.Date{0, 0, 1945, true, gedcom.DateConstraintAbout, nil}, parseTime("31 Dec 1945 23"),
+		gedcom.Date{Year: 1945, Constraint: gedcom.DateConstraintAbout}, parseTime("1 Jan 1945 00"),
+		gedcom.Date{Year: 1945, IsEndOfRange: true, Constraint: gedcom.DateConstraintAbout}, parseTime("31 Dec 1945 23"),
 		"Abt. 1945",
 	},
+	"abt 1945": {
+		gedcom.Date{Year: 1945, Constraint: gedcom.DateConstraintAbout}, parseTime("1 Jan 1945 00"),
+		gedcom.Date{Year: 1945, IsEndOfRange: true, Constraint: gedcom.DateConstraintAbout}, parseTime("31 Dec 1945 23"),
+		"Abt. 1945",
+	},
+	"before 1907": {
+		gedcom.Date{Year: 1907, Constraint: gedcom.DateConstraintBefore}, parseTime("1 Jan 1907 00"),
+		gedcom.Date{Year: 1907, IsEndOfRange: true, Constraint: gedcom.DateConstraintBefore}, parseTime("31 Dec 1907 23"),
+		"Bef. 1907",
+	},
 	"about Feb 1907": {
-		gedcom.Date{0, time.February, 1907, false, gedcom.DateConstraintAbout, nil}, parseTime("1 Feb 1907 00"),
-		gedcom.Date{0, time.February, 1907, true, gedcom.DateConstraintAbout, nil}, parseTime("28 Feb 1907 23"),
+		gedcom.Date{Month: time.February, Year: 1907, Constraint: gedcom.DateConstraintAbout}, parseTime("1 Feb 1907 00"),
+		gedcom.Date{Month: time.February, Year: 1907, IsEndOfRange: true, Constraint: gedcom.DateConstraintAbout}, parseTime("28 Feb 1907 23"),
 		"Abt. Feb 1907",
 	},
 	"c. 8 Mar 1505": {
-		gedcom.Date{8, time.March, 1505, false, gedcom.DateConstraintAbout, nil}, parseTime("8 Mar 1505 00"),
-		gedcom.Date{8, time.March, 1505, true, gedcom.DateConstraintAbout, nil}, parseTime("8 Mar 1505 23"),
+		gedcom.Date{Day: 8, Month: time.March, Year: 1505, Constraint: gedcom.DateConstraintAbout}, parseTime("8 Mar 1505 00"),
+		gedcom.Date{Day: 8, Month: time.March, Year: 1505, IsEndOfRange: true, Constraint: gedcom.DateConstraintAbout}, parseTime("8 Mar 1505 23"),
 		"Abt. 8 Mar 1505",
 	},
 	"ca. 8 Mar 1505": {
-		gedcom.Date{8, time.March, 1505, false, gedcom.DateConstraintAbout, nil}, parseTime("8 Mar 1505 00"),
-		gedcom.Date{8, time.March, 1505, true, gedcom.DateConstraintAbout, nil}, parseTime("8 Mar 1505 23"),
+		gedcom.Date{Day: 8, Month: time.March, Year: 1505, Constraint: gedcom.DateConstraintAbout}, parseTime("8 Mar 1505 00"),
+		gedcom.Date{Day: 8, Month: time.March, Year: 1505, IsEndOfRange: true, Constraint: gedcom.DateConstraintAbout}, parseTime("8 Mar 1505 23"),
 		"Abt. 8 Mar 1505",
 	},
 	"CA 8 Mar 1505": {
-		gedcom.Date{8, time.March, 1505, false, gedcom.DateConstraintAbout, nil}, parseTime("8 Mar 1505 00"),
-		gedcom.Date{8, time.March, 1505, true, gedcom.DateConstraintAbout, nil}, parseTime("8 Mar 1505 23"),
+		gedcom.Date{Day: 8, Month: time.March, Year: 1505, Constraint: gedcom.DateConstraintAbout}, parseTime("8 Mar 1505 00"),
+		gedcom.Date{Day: 8, Month: time.March, Year: 1505, IsEndOfRange: true, Constraint: gedcom.DateConstraintAbout}, parseTime("8 Mar 1505 23"),
 		"Abt. 8 Mar 1505",
 	},
 	"cca. 8 Mar 1505": {
-		gedcom.Date{8, time.March, 1505, false, gedcom.DateConstraintAbout, nil}, parseTime("8 Mar 1505 00"),
-		gedcom.Date{8, time.March, 1505, true, gedcom.DateConstraintAbout, nil}, parseTime("8 Mar 1505 23"),
+		gedcom.Date{Day: 8, Month: time.March, Year: 1505, Constraint: gedcom.DateConstraintAbout}, parseTime("8 Mar 1505 00"),
+		gedcom.Date{Day: 8, Month: time.March, Year: 1505, IsEndOfRange: true, Constraint: gedcom.DateConstraintAbout}, parseTime("8 Mar 1505 23"),
 		"Abt. 8 Mar 1505",
 	},
 	"Cca 8 Mar 1505": {
-		gedcom.Date{8, time.March, 1505, false, gedcom.DateConstraintAbout, nil}, parseTime("8 Mar 1505 00"),
-		gedcom.Date{8, time.March, 1505, true, gedcom.DateConstraintAbout, nil}, parseTime("8 Mar 1505 23"),
+		gedcom.Date{Day: 8, Month: time.March, Year: 1505, Constraint: gedcom.DateConstraintAbout}, parseTime("8 Mar 1505 00"),
+		gedcom.Date{Day: 8, Month: time.March, Year: 1505, IsEndOfRange: true, Constraint: gedcom.DateConstraintAbout}, parseTime("8 Mar 1505 23"),
 		"Abt. 8 Mar 1505",
 	},
 	"circa 21 Dec 1884": {
-		gedcom.Date{21, time.December, 1884, false, gedcom.DateConstraintAbout, nil}, parseTime("21 Dec 1884 00"),
-		gedcom.Date{21, time.December, 1884, true, gedcom.DateConstraintAbout, nil}, parseTime("21 Dec 1884 23"),
+		gedcom.Date{Day: 21, Month: time.December, Year: 1884, Constraint: gedcom.DateConstraintAbout}, parseTime("21 Dec 1884 00"),
+		gedcom.Date{Day: 21, Month: time.December, Year: 1884, IsEndOfRange: true, Constraint: gedcom.DateConstraintAbout}, parseTime("21 Dec 1884 23"),
 		"Abt. 21 Dec 1884",
 	},
 
 	// Invalid dates.
 	"25 D 1901": {
-		gedcom.Date{0, 0, 0, false, gedcom.DateConstraintExact, errors.New(`parsing time "25 0 1901": month out of range`)}, time.Time{},
-		gedcom.Date{0, 0, 0, true, gedcom.DateConstraintExact, errors.New(`parsing time "25 0 1901": month out of range`)}, time.Time{},
+		gedcom.Date{ParseError: errors.New(`parsing time "25 0 1901": month out of range`)}, time.Time{},
+		gedcom.Date{IsEndOfRange: true, ParseError: errors.New(`parsing time "25 0 1901": month out of range`)}, time.Time{},
 		"",
 	},
 	"5 Decmbr 1901": {
-		gedcom.Date{0, 0, 0, false, gedcom.DateConstraintExact, errors.New(`parsing time "5 0 1901": month out of range`)}, time.Time{},
-		gedcom.Date{0, 0, 0, true, gedcom.DateConstraintExact, errors.New(`parsing time "5 0 1901": month out of range`)}, time.Time{},
+		gedcom.Date{ParseError: errors.New(`parsing time "5 0 1901": month out of range`)}, time.Time{},
+		gedcom.Date{IsEndOfRange: true, ParseError: errors.New(`parsing time "5 0 1901": month out of range`)}, time.Time{},
 		"",
 	},
 	"13 Jan": {
-		gedcom.Date{0, 0, 0, false, gedcom.DateConstraintExact, errors.New("unable to parse date: 13 Jan")}, time.Time{},
-		gedcom.Date{0, 0, 0, true, gedcom.DateConstraintExact, errors.New("unable to parse date: 13 Jan")}, time.Time{},
+		gedcom.Date{ParseError: errors.New("unable to parse date: 13 Jan")}, time.Time{},
+		gedcom.Date{IsEndOfRange: true, ParseError: errors.New("unable to parse date: 13 Jan")}, time.Time{},
 		"",
 	},
 	"73 November 1992": {
-		gedcom.Date{0, 0, 0, false, gedcom.DateConstraintExact, errors.New(`parsing time "73 11 1992": day out of range`)}, time.Time{},
-		gedcom.Date{0, 0, 0, true, gedcom.DateConstraintExact, errors.New(`parsing time "73 11 1992": day out of range`)}, time.Time{},
+		gedcom.Date{ParseError: errors.New(`parsing time "73 11 1992": day out of range`)}, time.Time{},
+		gedcom.Date{IsEndOfRange: true, ParseError: errors.New(`parsing time "73 11 1992": day out of range`)}, time.Time{},
 		"",
 	},
 	"31 Feb 1992": {
-		gedcom.Date{0, 0, 0, false, gedcom.DateConstraintExact, errors.New(`parsing time "31 2 1992": day out of range`)}, time.Time{},
-		gedcom.Date{0, 0, 0, true, gedcom.DateConstraintExact, errors.New(`parsing time "31 2 1992": day out of range`)}, time.Time{},
+		gedcom.Date{ParseError: errors.New(`parsing time "31 2 1992": day out of range`)}, time.Time{},
+		gedcom.Date{IsEndOfRange: true, ParseError: errors.New(`parsing time "31 2 1992": day out of range`)}, time.Time{},
 		"",
 	},
 	"3 Febuary 1992": {
-		gedcom.Date{0, 0, 0, false, gedcom.DateConstraintExact, errors.New(`parsing time "3 0 1992": month out of range`)}, time.Time{},
-		gedcom.Date{0, 0, 0, true, gedcom.DateConstraintExact, errors.New(`parsing time "3 0 1992": month out of range`)}, time.Time{},
+		gedcom.Date{ParseError: errors.New(`parsing time "3 0 1992": month out of range`)}, time.Time{},
+		gedcom.Date{IsEndOfRange: true, ParseError: errors.New(`parsing time "3 0 1992": month out of range`)}, time.Time{},
 		"",
 	},
 
 	// Date ranges.
 	"Bet 29 August 1640 and 19 Feb 1992": {
-		gedcom.Date{29, time.August, 1640, false, gedcom.DateConstraintExact, nil}, parseTime("29 Aug 1640 00"),
-		gedcom.Date{19, time.February, 1992, true, gedcom.DateConstraintExact, nil}, parseTime("19 Feb 1992 23"),
+		gedcom.Date{Day: 29, Month: time.August, Year: 1640}, parseTime("29 Aug 1640 00"),
+		gedcom.Date{Day: 19, Month: time.February, Year: 1992, IsEndOfRange: true}, parseTime("19 Feb 1992 23"),
 		"Bet. 29 Aug 1640 and 19 Feb 1992",
 	},
 	"Between July 2003 and 7 Dec 2020": {
-		gedcom.Date{0, time.July, 2003, false, gedcom.DateConstraintExact, nil}, parseTime("1 Jul 2003 00"),
-		gedcom.Date{7, time.December, 2020, true, gedcom.DateConstraintExact, nil}, parseTime("7 Dec 2020 23"),
+		gedcom.Date{Month: time.July, Year: 2003}, parseTime("1 Jul 2003 00"),
+		gedcom.Date{Day: 7, Month: time.December, Year: 2020, IsEndOfRange: true}, parseTime("7 Dec 2020 23"),
 		"Bet. Jul 2003 and 7 Dec 2020",
 	},
 	"Bet. 29 August 1640 AND 19 Feb 1992": {
-		gedcom.Date{29, time.August, 1640, false, gedcom.DateConstraintExact, nil}, parseTime("29 Aug 1640 00"),
-		gedcom.Date{19, time.February, 1992, true, gedcom.DateConstraintExact, nil}, parseTime("19 Feb 1992 23"),
+		gedcom.Date{Day: 29, Month: time.August, Year: 1640}, parseTime("29 Aug 1640 00"),
+		gedcom.Date{Day: 19, Month: time.February, Year: 1992, IsEndOfRange: true}, parseTime("19 Feb 1992 23"),
 		"Bet. 29 Aug 1640 and 19 Feb 1992",
 	},
 	"from 29 August 1640 to 19 Feb 1992": {
-		gedcom.Date{29, time.August, 1640, false, gedcom.DateConstraintExact, nil}, parseTime("29 Aug 1640 00"),
-		gedcom.Date{19, time.February, 1992, true, gedcom.DateConstraintExact, nil}, parseTime("19 Feb 1992 23"),
-		"Bet. 29 Aug 1640 and 19 Feb 1992",
+		gedcom.Date{Day: 29, Month: time.August, Year: 1640}, parseTime("29 Aug 1640 00"),
+		gedcom.Date{Day: 19, Month: time.February, Year: 1992, IsEndOfRange: true}, parseTime("19 Feb 1992 23"),
+		"From 29 Aug 1640 to 19 Feb 1992",
 	},
 	"FROM 29 August 1640 - 19 Feb 1992": {
-		gedcom.Date{29, time.August, 1640, false, gedcom.DateConstraintExact, nil}, parseTime("29 Aug 1640 00"),
-		gedcom.Date{19, time.February, 1992, true, gedcom.DateConstraintExact, nil}, parseTime("19 Feb 1992 23"),
-		"Bet. 29 Aug 1640 and 19 Feb 1992",
+		gedcom.Date{Day: 29, Month: time.August, Year: 1640}, parseTime("29 Aug 1640 00"),
+		gedcom.Date{Day: 19, Month: time.February, Year: 1992, IsEndOfRange: true}, parseTime("19 Feb 1992 23"),
+		"From 29 Aug 1640 to 19 Feb 1992",
 	},
 
 	// Edge cases.
 	"foo circa 21 Dec 1884": {
-		gedcom.Date{0, 0, 0, false, gedcom.DateConstraintExact, errors.New("unable to parse date: foo circa 21 Dec 1884")}, time.Time{},
-		gedcom.Date{0, 0, 0, true, gedcom.DateConstraintExact, errors.New("unable to parse date: foo circa 21 Dec 1884")}, time.Time{},
+		gedcom.Date{ParseError: errors.New("unable to parse date: foo circa 21 Dec 1884")}, time.Time{},
+		gedcom.Date{IsEndOfRange: true, ParseError: errors.New("unable to parse date: foo circa 21 Dec 1884")}, time.Time{},
 		"",
 	},
 	"About 21 Dec 1884 never": {
-		gedcom.Date{0, 0, 0, false, gedcom.DateConstraintExact, errors.New("unable to parse date: About 21 Dec 1884 never")}, time.Time{},
-		gedcom.Date{0, 0, 0, true, gedcom.DateConstraintExact, errors.New("unable to parse date: About 21 Dec 1884 never")}, time.Time{},
+		gedcom.Date{ParseError: errors.New("unable to parse date: About 21 Dec 1884 never")}, time.Time{},
+		gedcom.Date{IsEndOfRange: true, ParseError: errors.New("unable to parse date: About 21 Dec 1884 never")}, time.Time{},
 		"",
 	},
 
@@ -751,10 +761,10 @@ func TestDateNode_Equals(t *testing.T) {
 
 	// d3 and d4 represent the same enclosed ranges.
 	d3 := gedcom.NewDateNode("Bet. Oct 2000 and 3 Apr 2008")
-	d4 := gedcom.NewDateNode("From OCT 2000 to Bef. Jun 2008")
+	d4 := gedcom.NewDateNode("Between OCT 2000 and Bef. Jun 2008")
 
 	// d5 has a different Start from d3 and d4.
-	d5 := gedcom.NewDateNode("From Jun 2000 to 3 Apr 2008")
+	d5 := gedcom.NewDateNode("Between Jun 2000 and 3 Apr 2008")
 
 	// d6 to d8 are phrases.
 	d6 := gedcom.NewDateNode("(Foo)")
@@ -783,6 +793,14 @@ func TestDateNode_Equals(t *testing.T) {
 	Equals(d2, d1).Returns(true)
 	Equals(d3, d4).Returns(true)
 	Equals(d4, d5).Returns(false)
+
+	// A period is never equal to a range.
+	d12 := gedcom.NewDateNode("From Oct 2000 to 3 Apr 2008")
+	d13 := gedcom.NewDateNode("FROM OCT 2000 TO 3 APR 2008")
+	Equals(d3, d12).Returns(false)
+	Equals(d12, d3).Returns(false)
+	Equals(d12, d13).Returns(true)
+	Equals(d12, gedcom.NewDateNode("From Oct 2000")).Returns(false)
 	Equals(d1, d10).Returns(false)
 
 	// Nothing equals a phrase except anther phrase that is exactly the same.
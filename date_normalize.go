@@ -0,0 +1,186 @@
+// Normalizing Dates
+//
+// The Date parser is lenient. It accepts many forms that are not part of the
+// GEDCOM standard, such as "Abt.", "circa", "c." and "Bet. 1890 - 1900".
+// DateNode.NormalizedValue rewrites these into the strict form described by
+// the standard:
+//
+//   ABT 3 MAR 1890
+//   BET 1890 AND 1900
+//   FROM 1890 TO 1900
+//   @#DJULIAN@ 12 FEB 1699/00
+//
+// Values that cannot be rewritten without losing some of their meaning are
+// never changed. Instead an error (or UnnormalizableDateWarning when using
+// NormalizeDatesFilter) describes why.
+package gedcom
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// dateQualifierRegexp matches the keywords of the GEDCOM standard that the
+// Date parser does not understand. The remainder is always a single date.
+var dateQualifierRegexp = regexp.MustCompile(`(?i)^(est|cal|from|to)\.? (.+)$`)
+
+// NormalizedValue returns the value of the DATE in the strict form of the
+// GEDCOM standard. Keywords and months are written in upper case.
+//
+// Phrases, like "(during the war)", are returned without modification.
+//
+// An error is returned if the value cannot be understood, or it cannot be
+// written in the standard form without losing some of its meaning. For
+// example, "Bet. Abt. 1890 and 1900" cannot be normalized because the
+// standard does not allow a range to contain approximate dates. In this case
+// the original value is returned with the error.
+//
+// Dates written in any of the Document.DateLocales will also be normalized.
+func (node *DateNode) NormalizedValue() (string, error) {
+	if node == nil {
+		return "", errors.New("date is nil")
+	}
+
+	value := CleanSpace(node.Value())
+
+	var locales []*DateLocale
+	if node.document != nil {
+		locales = node.document.DateLocales
+	}
+
+	for _, locale := range locales {
+		translated := locale.translate(value)
+		if translated == value {
+			continue
+		}
+
+		if normalized, err := normalizeDateValue(translated); err == nil {
+			return normalized, nil
+		}
+	}
+
+	normalized, err := normalizeDateValue(value)
+	if err != nil {
+		return node.Value(), err
+	}
+
+	return normalized, nil
+}
+
+func normalizeDateValue(value string) (string, error) {
+	if value == "" {
+		return "", errors.New("date is empty")
+	}
+
+	if datePhraseRegexp.MatchString(value) {
+		return value, nil
+	}
+
+	if parts := dateInterpretedRegexp.FindStringSubmatch(value); len(parts) > 0 {
+		date, err := normalizeExactDate(parts[1])
+
+		return fmt.Sprintf("INT %s (%s)", date, parts[2]), err
+	}
+
+	if parts := datePeriodRegexp.FindStringSubmatch(value); len(parts) > 0 {
+		return normalizeDates("FROM %s TO %s", parts[2], parts[4])
+	}
+
+	if parts := dateRangeRegexp.FindStringSubmatch(value); len(parts) > 0 {
+		return normalizeDates("BET %s AND %s", parts[2], parts[4])
+	}
+
+	if parts := dateQualifierRegexp.FindStringSubmatch(value); len(parts) > 0 {
+		date, err := normalizeExactDate(parts[2])
+
+		return fmt.Sprintf("%s %s", strings.ToUpper(parts[1]), date), err
+	}
+
+	date, err := parseStrictDate(value)
+	if err != nil {
+		return "", err
+	}
+
+	return date.normalizedString(), nil
+}
+
+// normalizeDates formats the start and end of a range or period.
+func normalizeDates(format, start, end string) (string, error) {
+	normalizedStart, err := normalizeExactDate(start)
+	if err != nil {
+		return "", err
+	}
+
+	normalizedEnd, err := normalizeExactDate(end)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(format, normalizedStart, normalizedEnd), nil
+}
+
+// normalizeExactDate is used for the dates that are part of a range or follow
+// a keyword. These dates cannot have their own constraint.
+func normalizeExactDate(s string) (string, error) {
+	date, err := parseStrictDate(s)
+	if err != nil {
+		return "", err
+	}
+
+	if date.Constraint != DateConstraintExact {
+		return "", fmt.Errorf("%q cannot have a constraint in this position", s)
+	}
+
+	return date.normalizedString(), nil
+}
+
+// parseStrictDate parses a single date in the same way as parseDateParts.
+// However, parseDateParts will ignore a word in place of the month that it
+// does not recognise (such as the "est" in "est 1890") so this is an error
+// instead.
+func parseStrictDate(s string) (Date, error) {
+	parts := dateRegexp.FindStringSubmatch(s)
+	if len(parts) == 0 {
+		return Date{}, fmt.Errorf("unable to parse date: %s", s)
+	}
+
+	calendar := DateCalendarGregorian
+	if parts[2] != "" {
+		calendar = DateCalendarFromEscape(parts[2])
+	}
+
+	monthName := strings.TrimSpace(parts[4])
+	if monthName != "" && calendar.parseMonth(strings.ToLower(monthName)) == 0 {
+		return Date{}, fmt.Errorf("unknown month: %s", monthName)
+	}
+
+	date := parseDateParts(s, false)
+
+	if date.ParseError != nil {
+		return Date{}, date.ParseError
+	}
+
+	return date, nil
+}
+
+// normalizedString is the date in the strict form of the GEDCOM standard, such
+// as "ABT 3 MAR 1890".
+func (date Date) normalizedString() string {
+	keyword := ""
+	switch date.Constraint {
+	case DateConstraintAbout:
+		keyword = "ABT"
+
+	case DateConstraintBefore:
+		keyword = "BEF"
+
+	case DateConstraintAfter:
+		keyword = "AFT"
+	}
+
+	date.Constraint = DateConstraintExact
+
+	return CleanSpace(keyword + " " + strings.ToUpper(date.String()))
+}
@@ -0,0 +1,107 @@
+package gedcom_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateNode_NormalizedValue(t *testing.T) {
+	for _, test := range []struct {
+		value    string
+		expected string
+	}{
+		// Already normalized.
+		{"3 MAR 1890", "3 MAR 1890"},
+		{"MAR 1890", "MAR 1890"},
+		{"1890", "1890"},
+		{"ABT 1890", "ABT 1890"},
+		{"BET 1890 AND 1900", "BET 1890 AND 1900"},
+		{"FROM 1890 TO 1900", "FROM 1890 TO 1900"},
+		{"FROM 1890", "FROM 1890"},
+		{"TO 1900", "TO 1900"},
+		{"EST 1890", "EST 1890"},
+		{"CAL 1890", "CAL 1890"},
+		{"INT 3 MAR 1890 (third of March)", "INT 3 MAR 1890 (third of March)"},
+		{"(during the war)", "(during the war)"},
+
+		// Constraints.
+		{"Abt. 3 Mar 1890", "ABT 3 MAR 1890"},
+		{"abt 1890", "ABT 1890"},
+		{"circa 1890", "ABT 1890"},
+		{"c. 1890", "ABT 1890"},
+		{"ca. 1890", "ABT 1890"},
+		{"about 1890", "ABT 1890"},
+		{"Bef. Jan 1890", "BEF JAN 1890"},
+		{"before 1890", "BEF 1890"},
+		{"Aft. 1890", "AFT 1890"},
+		{"after 3 march 1890", "AFT 3 MAR 1890"},
+		{"est. 1890", "EST 1890"},
+
+		// Ranges.
+		{"Bet. Feb 1956 and Mar 1956", "BET FEB 1956 AND MAR 1956"},
+		{"between 1890 - 1900", "BET 1890 AND 1900"},
+		{"from 1890 to 1900", "FROM 1890 TO 1900"},
+		{"from 3 january 1890 - 1900", "FROM 3 JAN 1890 TO 1900"},
+		{"to 1900", "TO 1900"},
+
+		// Spacing.
+		{"  3  mar   1890 ", "3 MAR 1890"},
+
+		// Calendars.
+		{"@#DJULIAN@ 12 Feb 1699/00", "@#DJULIAN@ 12 FEB 1699/00"},
+		{"@#DGREGORIAN@ 12 Feb 1699", "12 FEB 1699"},
+		{"@#DHEBREW@ 3 tsh 5780", "@#DHEBREW@ 3 TSH 5780"},
+		{"Abt. @#DFRENCH R@ 18 brum 8", "ABT @#DFRENCH R@ 18 BRUM 8"},
+	} {
+		t.Run(test.value, func(t *testing.T) {
+			actual, err := gedcom.NewDateNode(test.value).NormalizedValue()
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestDateNode_NormalizedValueErrors(t *testing.T) {
+	for _, test := range []struct {
+		value string
+		err   string
+	}{
+		{"", "date is empty"},
+		{"foo", "unable to parse date: foo"},
+		{"est 3 foo 1890", "unknown month: foo"},
+		{"vers 1890", "unknown month: vers"},
+		{"Bet. Abt. 1890 and 1900", `"Abt. 1890" cannot have a constraint in this position`},
+		{"from 1890 to bef 1900", `"bef 1900" cannot have a constraint in this position`},
+		{"31 Feb 1890", `parsing time "31 2 1890": day out of range`},
+		{"@#DROMAN@ 1890", "dates in the Roman calendar cannot be converted"},
+	} {
+		t.Run(test.value, func(t *testing.T) {
+			actual, err := gedcom.NewDateNode(test.value).NormalizedValue()
+
+			assert.EqualError(t, err, test.err)
+			assert.Equal(t, test.value, actual)
+		})
+	}
+
+	t.Run("Nil", func(t *testing.T) {
+		_, err := (*gedcom.DateNode)(nil).NormalizedValue()
+
+		assert.Error(t, err)
+	})
+}
+
+func TestDateNode_NormalizedValueWithLocales(t *testing.T) {
+	doc, err := gedcom.NewDocumentFromString("0 @P1@ INDI\n1 BIRT\n2 DATE ca. 3 mei 1890\n")
+	assert.NoError(t, err)
+
+	doc.DateLocales = []*gedcom.DateLocale{gedcom.DateLocaleDutch}
+	birth, _ := doc.Individuals()[0].Birth()
+
+	actual, err := birth.NormalizedValue()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ABT 3 MAY 1890", actual)
+}
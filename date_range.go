@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"regexp"
+	"strings"
 	"time"
 )
 
@@ -12,12 +13,23 @@ import (
 // The minimum possible period is 1 day and ranges only have a resolution of a
 // single day.
 //
+// A DateRange may be a range ("Bet. 1900 and 1910") which is a single point in
+// time somewhere between the two dates, or a period ("From 1900 to 1910")
+// which is the whole time between the two dates. See IsRange and IsPeriod.
+//
 // DateRanges should be considered immutable and are passed by value because of
 // this. You should create a new DateRange to represent a new range rather than
 // mutating an existing DateRange.
 type DateRange struct {
 	start, end     Date
 	originalString string
+
+	// isPeriod is true for "from" and "to" values. A period may have an
+	// unknown start ("To 1910") or an unknown end ("From 1900"). In these
+	// cases the unknown boundary is the same date as the known boundary. See
+	// IsPeriod.
+	isPeriod               bool
+	isOpenStart, isOpenEnd bool
 }
 
 func NewZeroDateRange() DateRange {
@@ -31,7 +43,14 @@ func NewDateRangeWithString(s string) (dr DateRange) {
 
 	dateString := CleanSpace(s)
 
-	// Try to match a range first.
+	// Try to match a period or range first.
+	if parts := datePeriodRegexp.FindStringSubmatch(dateString); len(parts) > 0 {
+		return NewDatePeriod(
+			parseDateParts(parts[2], false),
+			parseDateParts(parts[4], true),
+		)
+	}
+
 	parts := dateRangeRegexp.FindStringSubmatch(dateString)
 	if len(parts) > 0 {
 		datePart1 := parseDateParts(parts[2], false)
@@ -44,6 +63,22 @@ func NewDateRangeWithString(s string) (dr DateRange) {
 		return dateRange
 	}
 
+	// A period with only one boundary.
+	if parts := dateOpenPeriodRegexp.FindStringSubmatch(dateString); len(parts) > 0 {
+		period := NewDatePeriod(
+			parseDateParts(parts[2], false),
+			parseDateParts(parts[2], true),
+		)
+
+		if wordInWords(strings.ToLower(parts[1]), DateWordsFrom) {
+			period.isOpenEnd = true
+		} else {
+			period.isOpenStart = true
+		}
+
+		return period
+	}
+
 	// Single date.
 	datePart1 := parseDateParts(dateString, false)
 	datePart2 := parseDateParts(dateString, true)
@@ -54,6 +89,35 @@ func NewDateRangeWithString(s string) (dr DateRange) {
 	)
 }
 
+// NewDateRangeWithLocales works the same way as NewDateRangeWithString except
+// that the words of each locale are also understood. The locales are tried in
+// order and the first locale that contains words of the date, and can
+// understand it, is used.
+//
+// The English parser ignores words that it does not recognise (such as the
+// "vers" in "vers 1890") so a locale is always tried before falling back to
+// English.
+//
+// If none of the locales can understand the date then the result is the same
+// as NewDateRangeWithString.
+func NewDateRangeWithLocales(s string, locales []*DateLocale) DateRange {
+	for _, locale := range locales {
+		translated := locale.translate(s)
+		if translated == CleanSpace(s) {
+			continue
+		}
+
+		dr := NewDateRangeWithString(translated)
+		if dr.ParseError() == nil && dr.IsValid() {
+			dr.originalString = s
+
+			return dr
+		}
+	}
+
+	return NewDateRangeWithString(s)
+}
+
 // NewDateRange creates a new date range between two provided dates. It is
 // expected that the start date be less than or equal to the end date.
 func NewDateRange(start, end Date) DateRange {
@@ -66,6 +130,17 @@ func NewDateRange(start, end Date) DateRange {
 	}
 }
 
+// NewDatePeriod creates a new period between two provided dates. It is
+// expected that the start date be less than or equal to the end date.
+//
+// See DateRange.IsPeriod for the difference between a range and a period.
+func NewDatePeriod(start, end Date) DateRange {
+	period := NewDateRange(start, end)
+	period.isPeriod = true
+
+	return period
+}
+
 // Describes the matrix of possible ranges where each letter represents Before,
 // Equal or After. A lower-case letter refers to the lower boundary. Conversely
 // an upper-case letter refers to the upper boundary.
@@ -162,8 +237,111 @@ func (dr DateRange) IsAfter(dr2 DateRange) bool {
 	return dr.end.IsAfter(dr2.end)
 }
 
-var dateRangeRegexp = regexp.MustCompile(
-	fmt.Sprintf(`(?i)^(%s) (.+) (%s) (.+)$`, DateWordsBetween, DateWordsAnd))
+var (
+	dateRangeRegexp = regexp.MustCompile(
+		fmt.Sprintf(`(?i)^(%s) (.+) (%s) (.+)$`,
+			dateWordsPattern(DateWordsBetween), dateWordsPattern(DateWordsAnd)))
+
+	datePeriodRegexp = regexp.MustCompile(
+		fmt.Sprintf(`(?i)^(%s) (.+) (%s) (.+)$`,
+			dateWordsPattern(DateWordsFrom), dateWordsPattern(DateWordsTo)))
+
+	dateOpenPeriodRegexp = regexp.MustCompile(
+		fmt.Sprintf(`(?i)^(%s) (.+)$`,
+			dateWordsPattern(DateWordsFrom, DateWordsTo)))
+)
+
+// IsPeriod returns true if the value is a period, like "From 1900 to 1910".
+//
+// A period describes something that was true for the whole time between its
+// start and end date, such as a residence or occupation. This is different
+// from a range (see IsRange) that describes a single point in time that is
+// somewhere between its start and end date.
+//
+// A period may only have one of its boundaries, like "From 1900" or
+// "To 1910". The unknown boundary will be the same as the known boundary for
+// StartDate, EndDate and all other calculations except for Overlaps which
+// treats the unknown boundary as unbounded.
+func (dr DateRange) IsPeriod() bool {
+	return dr.isPeriod
+}
+
+// IsOpenStart returns true for a period that does not have a start date, like
+// "To 1910".
+func (dr DateRange) IsOpenStart() bool {
+	return dr.isOpenStart
+}
+
+// IsOpenEnd returns true for a period that does not have an end date, like
+// "From 1900".
+func (dr DateRange) IsOpenEnd() bool {
+	return dr.isOpenEnd
+}
+
+// IsRange returns true if the value is a range with a different start and end
+// date, like "Bet. 1900 and 1910". See IsPeriod.
+//
+// A single date, like "1900", is not a range even though its start and end
+// dates are different days.
+func (dr DateRange) IsRange() bool {
+	return !dr.isPeriod && dr.IsValid() && !dr.start.Equals(dr.end)
+}
+
+// Overlaps returns true if the two values could have happened (or were true)
+// at the same time. That is, there is at least one day that is common to both.
+//
+// The unknown boundary of a period, like the end of "From 1900", is treated as
+// unbounded. So "From 1900" overlaps with "2010".
+func (dr DateRange) Overlaps(dr2 DateRange) bool {
+	return !dr.endsBefore(dr2) && !dr2.endsBefore(dr)
+}
+
+// endsBefore returns true if there are no days of dr on or after the start of
+// dr2.
+func (dr DateRange) endsBefore(dr2 DateRange) bool {
+	if dr.isOpenEnd || dr2.isOpenStart {
+		return false
+	}
+
+	return dayBefore(dr.end, dr2.start)
+}
+
+// IsDefinitelyBefore returns true if dr is known to have (at least partly)
+// happened before dr2. This is used to find events that are in an impossible
+// order.
+//
+// Since a range is a single point in time it is only known to be before a
+// date when the whole range is before it. Whereas a period is true for all of
+// its dates, so only the start of a period needs to be before it:
+//
+//   "Bet. 1900 and 1910" is definitely before "1911"
+//   "Bet. 1900 and 1910" is not definitely before "1905"
+//   "From 1900 to 1910" is definitely before "1905"
+//
+// In the same way, all of dr must be before the start of a range but only
+// needs to be before the end of a period.
+func (dr DateRange) IsDefinitelyBefore(dr2 DateRange) bool {
+	date := dr.end
+	if dr.isPeriod {
+		date = dr.start
+	}
+
+	date2 := dr2.start
+	if dr2.isPeriod {
+		date2 = dr2.end
+	}
+
+	return dayBefore(date, date2)
+}
+
+// dayBefore compares whole days so that the end of a range (that is
+// 23:59:59.999) is not after the start of the same day.
+func dayBefore(date, date2 Date) bool {
+	day := date.Time().Truncate(24 * time.Hour)
+	day2 := date2.Time().Truncate(24 * time.Hour)
+
+	return day.Before(day2)
+}
 
 // Years works in a similar way to Date.Years() but also takes into
 // consideration the StartDate() and EndDate() values of a whole date range,
@@ -210,10 +388,45 @@ func (dr DateRange) Years() float64 {
 // A sensible default value for maxYears is provided with
 // DefaultMaxYearsForSimilarity. You should use this if you are unsure. There is
 // also more explanation on the constant.
+//
+// Periods (see IsPeriod) are compared differently. Two periods are compared by
+// their start and end dates separately, and the two similarities are
+// averaged. A period compared to any other date uses the distance from the
+// other date to the closest day of the period, so a date during the period is
+// always exactly similar.
 func (dr DateRange) Similarity(dr2 DateRange, maxYears float64) float64 {
-	leftYears := dr.Years()
-	rightYears := dr2.Years()
-	yearsApart := leftYears - rightYears
+	switch {
+	case dr.isPeriod && dr2.isPeriod:
+		start := yearsSimilarity(dr.start.Years()-dr2.start.Years(), maxYears)
+		end := yearsSimilarity(dr.end.Years()-dr2.end.Years(), maxYears)
+
+		return (start + end) / 2
+
+	case dr.isPeriod:
+		return dr.periodSimilarity(dr2.Years(), maxYears)
+
+	case dr2.isPeriod:
+		return dr2.periodSimilarity(dr.Years(), maxYears)
+	}
+
+	return yearsSimilarity(dr.Years()-dr2.Years(), maxYears)
+}
+
+func (dr DateRange) periodSimilarity(years, maxYears float64) float64 {
+	start, end := dr.start.Years(), dr.end.Years()
+
+	switch {
+	case years < start:
+		return yearsSimilarity(start-years, maxYears)
+
+	case years > end:
+		return yearsSimilarity(years-end, maxYears)
+	}
+
+	return 1
+}
+
+func yearsSimilarity(yearsApart, maxYears float64) float64 {
 	similarity := math.Pow(yearsApart/maxYears, 2)
 
 	// When one date is invalid the similarity will go asymptotic.
@@ -247,6 +460,12 @@ func (dr DateRange) Equals(dr2 DateRange) bool {
 		return true
 	}
 
+	// A period is never equal to a range, even if they have the same dates.
+	if dr.isPeriod != dr2.isPeriod || dr.isOpenStart != dr2.isOpenStart ||
+		dr.isOpenEnd != dr2.isOpenEnd {
+		return false
+	}
+
 	// Compare dates by value range.
 	matchStartDate := dr.StartDate().Equals(dr2.StartDate())
 	matchEndDate := dr.EndDate().Equals(dr2.EndDate())
@@ -317,12 +536,47 @@ func (dr DateRange) ParseError() error {
 }
 
 func (dr DateRange) String() string {
+	return dr.StringInLocale(nil)
+}
+
+// StringInLocale works the same way as String but uses the words of the
+// locale. If the locale is nil then English is used.
+func (dr DateRange) StringInLocale(locale *DateLocale) string {
 	start, end := dr.StartAndEndDates()
+	if dr.isPeriod {
+		return dr.periodInLocale(locale)
+	}
+
 	if start.Equals(end) {
-		return start.String()
+		return start.StringInLocale(locale)
 	}
 
-	return fmt.Sprintf("Bet. %s and %s", start, end)
+	return betweenInLocale(locale, start, end)
+}
+
+func (dr DateRange) periodInLocale(locale *DateLocale) string {
+	locale = locale.orEnglish()
+	from := firstWord(locale.From)
+	to := firstWord(locale.To)
+
+	switch {
+	case dr.isOpenStart:
+		return fmt.Sprintf("%s %s", to, dr.end.StringInLocale(locale))
+
+	case dr.isOpenEnd:
+		return fmt.Sprintf("%s %s", from, dr.start.StringInLocale(locale))
+	}
+
+	return fmt.Sprintf("%s %s %s %s", from, dr.start.StringInLocale(locale),
+		strings.ToLower(to), dr.end.StringInLocale(locale))
+}
+
+func betweenInLocale(locale *DateLocale, start, end Date) string {
+	locale = locale.orEnglish()
+
+	return fmt.Sprintf("%s %s %s %s", firstWord(locale.Between),
+		start.StringInLocale(locale), firstWord(locale.And),
+		end.StringInLocale(locale))
 }
 
 func (dr DateRange) Sub(dr2 DateRange) DurationRange {
@@ -277,3 +277,153 @@ func TestDateRange_IsAfter(t *testing.T) {
 		})
 	}
 }
+
+func TestDateRange_IsPeriod(t *testing.T) {
+	for value, expected := range map[string]bool{
+		"":                   false,
+		"1900":               false,
+		"Abt. 1900":          false,
+		"Bet. 1900 and 1910": false,
+		"From 1900 to 1910":  true,
+		"from 1900 - 1910":   true,
+		"From 1900":          true,
+		"To 1910":            true,
+	} {
+		t.Run(value, func(t *testing.T) {
+			dr := gedcom.NewDateRangeWithString(value)
+			assert.Equal(t, expected, dr.IsPeriod())
+		})
+	}
+}
+
+func TestDateRange_IsOpenStart(t *testing.T) {
+	for value, expected := range map[string][2]bool{
+		"1900":              {false, false},
+		"From 1900 to 1910": {false, false},
+		"From 1900":         {false, true},
+		"To 1910":           {true, false},
+	} {
+		t.Run(value, func(t *testing.T) {
+			dr := gedcom.NewDateRangeWithString(value)
+			assert.Equal(t, expected[0], dr.IsOpenStart())
+			assert.Equal(t, expected[1], dr.IsOpenEnd())
+		})
+	}
+}
+
+func TestDateRange_IsRange(t *testing.T) {
+	for value, expected := range map[string]bool{
+		"":                   false,
+		"1900":               false,
+		"Abt. 1900":          false,
+		"Bet. 1900 and 1910": true,
+		"Bet. 1900 and 1900": false,
+		"From 1900 to 1910":  false,
+		"From 1900":          false,
+	} {
+		t.Run(value, func(t *testing.T) {
+			dr := gedcom.NewDateRangeWithString(value)
+			assert.Equal(t, expected, dr.IsRange())
+		})
+	}
+}
+
+func TestDateRange_PeriodString(t *testing.T) {
+	for value, expected := range map[string]string{
+		"FROM 3 MAR 1900 TO 1910": "From 3 Mar 1900 to 1910",
+		"from 1900":               "From 1900",
+		"TO 1910":                 "To 1910",
+	} {
+		t.Run(value, func(t *testing.T) {
+			dr := gedcom.NewDateRangeWithString(value)
+			assert.Equal(t, expected, dr.String())
+		})
+	}
+
+	t.Run("Locale", func(t *testing.T) {
+		dr := gedcom.NewDateRangeWithString("FROM 1900 TO 1910")
+		assert.Equal(t, "van 1900 tot 1910", dr.StringInLocale(gedcom.DateLocaleDutch))
+	})
+}
+
+func TestDateRange_Overlaps(t *testing.T) {
+	for _, test := range []struct {
+		a, b     string
+		expected bool
+	}{
+		{"1900", "1900", true},
+		{"1900", "1901", false},
+		{"Bet. 1900 and 1910", "1905", true},
+		{"Bet. 1900 and 1910", "1911", false},
+		{"From 1900 to 1910", "From 1910 to 1920", true},
+		{"From 1900 to 1909", "From 1910 to 1920", false},
+		{"From 1900", "2010", true},
+		{"From 1900", "1899", false},
+		{"To 1910", "1800", true},
+		{"To 1910", "1911", false},
+		{"From 1900", "To 1800", false},
+		{"From 1900", "To 2000", true},
+	} {
+		t.Run(test.a+"/"+test.b, func(t *testing.T) {
+			a := gedcom.NewDateRangeWithString(test.a)
+			b := gedcom.NewDateRangeWithString(test.b)
+
+			assert.Equal(t, test.expected, a.Overlaps(b))
+			assert.Equal(t, test.expected, b.Overlaps(a))
+		})
+	}
+}
+
+func TestDateRange_IsDefinitelyBefore(t *testing.T) {
+	for _, test := range []struct {
+		a, b     string
+		expected bool
+	}{
+		{"1900", "1901", true},
+		{"1900", "1900", false},
+		{"Bet. 1900 and 1910", "1911", true},
+		{"Bet. 1900 and 1910", "1905", false},
+		{"From 1900 to 1910", "1905", true},
+		{"From 1900 to 1910", "1900", false},
+		{"1905", "Bet. 1900 and 1910", false},
+		{"1905", "From 1900 to 1910", true},
+		{"1910", "From 1900 to 1910", false},
+		{"From 1900 to 1910", "From 1905 to 1915", true},
+	} {
+		t.Run(test.a+"/"+test.b, func(t *testing.T) {
+			a := gedcom.NewDateRangeWithString(test.a)
+			b := gedcom.NewDateRangeWithString(test.b)
+
+			assert.Equal(t, test.expected, a.IsDefinitelyBefore(b))
+		})
+	}
+}
+
+func TestDateRange_SimilarityOfPeriods(t *testing.T) {
+	for _, test := range []struct {
+		a, b     string
+		expected float64
+	}{
+		// A date during the period.
+		{"From 1900 to 1910", "1905", 1},
+		{"1905", "From 1900 to 1910", 1},
+
+		// A range uses the middle of the range, whereas a period does not.
+		{"Bet. 1900 and 1910", "1901", 0.84},
+		{"From 1900 to 1910", "1901", 1},
+
+		// Periods are compared by both boundaries.
+		{"From 1900 to 1910", "From 1900 to 1910", 1},
+		{"From 1900 to 1910", "From 1901 to 1910", 0.995},
+
+		// Outside of the period.
+		{"From 1900 to 1910", "1913", 0.91},
+	} {
+		t.Run(test.a+"/"+test.b, func(t *testing.T) {
+			a := gedcom.NewDateRangeWithString(test.a)
+			b := gedcom.NewDateRangeWithString(test.b)
+
+			assert.InDelta(t, test.expected, a.Similarity(b, 10), 0.01)
+		})
+	}
+}
@@ -34,16 +34,16 @@ func TestDate_Time(t *testing.T) {
 func TestDate_String(t *testing.T) {
 	tests := map[gedcom.Date]string{
 		// Exact
-		gedcom.Date{0, 0, 0, false, gedcom.DateConstraintExact, nil}:     "",
-		gedcom.Date{0, 0, 1932, false, gedcom.DateConstraintExact, nil}:  "1932",
-		gedcom.Date{0, 3, 1987, false, gedcom.DateConstraintExact, nil}:  "Mar 1987",
-		gedcom.Date{24, 4, 1774, false, gedcom.DateConstraintExact, nil}: "24 Apr 1774",
+		gedcom.Date{}:     "",
+		gedcom.Date{Year: 1932}:  "1932",
+		gedcom.Date{Month: 3, Year: 1987}:  "Mar 1987",
+		gedcom.Date{Day: 24, Month: 4, Year: 1774}: "24 Apr 1774",
 
 		// Non-exact
-		gedcom.Date{0, 0, 0, false, gedcom.DateConstraintBefore, nil}:     "Bef.",
-		gedcom.Date{0, 0, 1932, false, gedcom.DateConstraintAbout, nil}:   "Abt. 1932",
-		gedcom.Date{0, 3, 1987, false, gedcom.DateConstraintAfter, nil}:   "Aft. Mar 1987",
-		gedcom.Date{24, 4, 1774, false, gedcom.DateConstraintBefore, nil}: "Bef. 24 Apr 1774",
+		gedcom.Date{Constraint: gedcom.DateConstraintBefore}:     "Bef.",
+		gedcom.Date{Year: 1932, Constraint: gedcom.DateConstraintAbout}:   "Abt. 1932",
+		gedcom.Date{Month: 3, Year: 1987, Constraint: gedcom.DateConstraintAfter}:   "Aft. Mar 1987",
+		gedcom.Date{Day: 24, Month: 4, Year: 1774, Constraint: gedcom.DateConstraintBefore}: "Bef. 24 Apr 1774",
 	}
 
 	for date, expected := range tests {
@@ -60,55 +60,55 @@ func TestDate_Is(t *testing.T) {
 	}{
 		// Matches
 		{
-			gedcom.Date{0, 0, 0, false, gedcom.DateConstraintExact, nil},
-			gedcom.Date{0, 0, 0, false, gedcom.DateConstraintExact, nil},
+			gedcom.Date{},
+			gedcom.Date{},
 			true,
 		},
 		{
-			gedcom.Date{0, 0, 1932, false, gedcom.DateConstraintExact, nil},
-			gedcom.Date{0, 0, 1932, false, gedcom.DateConstraintExact, nil},
+			gedcom.Date{Year: 1932},
+			gedcom.Date{Year: 1932},
 			true,
 		},
 		{
-			gedcom.Date{0, 3, 1987, false, gedcom.DateConstraintExact, nil},
-			gedcom.Date{0, 3, 1987, false, gedcom.DateConstraintExact, nil},
+			gedcom.Date{Month: 3, Year: 1987},
+			gedcom.Date{Month: 3, Year: 1987},
 			true,
 		},
 		{
-			gedcom.Date{24, 4, 1774, false, gedcom.DateConstraintExact, nil},
-			gedcom.Date{24, 4, 1774, false, gedcom.DateConstraintExact, nil},
+			gedcom.Date{Day: 24, Month: 4, Year: 1774},
+			gedcom.Date{Day: 24, Month: 4, Year: 1774},
 			true,
 		},
 		{
-			gedcom.Date{24, 4, 1774, false, gedcom.DateConstraintExact, nil},
-			gedcom.Date{24, 4, 1774, true, gedcom.DateConstraintExact, nil},
+			gedcom.Date{Day: 24, Month: 4, Year: 1774},
+			gedcom.Date{Day: 24, Month: 4, Year: 1774, IsEndOfRange: true},
 			true,
 		},
 
 		// Non-matches.
 		{
-			gedcom.Date{0, 0, 0, false, gedcom.DateConstraintExact, nil},
-			gedcom.Date{0, 0, 0, false, gedcom.DateConstraintAbout, nil},
+			gedcom.Date{},
+			gedcom.Date{Constraint: gedcom.DateConstraintAbout},
 			false,
 		},
 		{
-			gedcom.Date{0, 0, 1933, false, gedcom.DateConstraintExact, nil},
-			gedcom.Date{0, 0, 1932, false, gedcom.DateConstraintExact, nil},
+			gedcom.Date{Year: 1933},
+			gedcom.Date{Year: 1932},
 			false,
 		},
 		{
-			gedcom.Date{0, 2, 1987, false, gedcom.DateConstraintExact, nil},
-			gedcom.Date{0, 3, 1987, false, gedcom.DateConstraintExact, nil},
+			gedcom.Date{Month: 2, Year: 1987},
+			gedcom.Date{Month: 3, Year: 1987},
 			false,
 		},
 		{
-			gedcom.Date{25, 4, 1774, false, gedcom.DateConstraintExact, nil},
-			gedcom.Date{24, 4, 1774, false, gedcom.DateConstraintExact, nil},
+			gedcom.Date{Day: 25, Month: 4, Year: 1774},
+			gedcom.Date{Day: 24, Month: 4, Year: 1774},
 			false,
 		},
 		{
-			gedcom.Date{24, 4, 1774, false, gedcom.DateConstraintAbout, nil},
-			gedcom.Date{24, 4, 1774, false, gedcom.DateConstraintBefore, nil},
+			gedcom.Date{Day: 24, Month: 4, Year: 1774, Constraint: gedcom.DateConstraintAbout},
+			gedcom.Date{Day: 24, Month: 4, Year: 1774, Constraint: gedcom.DateConstraintBefore},
 			false,
 		},
 	}
@@ -127,23 +127,23 @@ func TestDate_Years(t *testing.T) {
 		expected float64
 	}{
 		// Zero
-		{gedcom.Date{0, 0, 0, false, gedcom.DateConstraintExact, nil}, 0.0},
+		{gedcom.Date{}, 0.0},
 
 		// Year
-		{gedcom.Date{0, 0, 750, false, gedcom.DateConstraintExact, nil}, 750.5},
-		{gedcom.Date{0, 0, 1845, false, gedcom.DateConstraintExact, nil}, 1845.5},
+		{gedcom.Date{Year: 750}, 750.5},
+		{gedcom.Date{Year: 1845}, 1845.5},
 
 		// Months
-		{gedcom.Date{0, 1, 1845, false, gedcom.DateConstraintExact, nil}, 1845.0437158469945},
-		{gedcom.Date{0, 3, 1999, false, gedcom.DateConstraintExact, nil}, 1999.204918032787},
-		{gedcom.Date{0, 12, 1832, false, gedcom.DateConstraintExact, nil}, 1832.956403269755},
+		{gedcom.Date{Month: 1, Year: 1845}, 1845.0437158469945},
+		{gedcom.Date{Month: 3, Year: 1999}, 1999.204918032787},
+		{gedcom.Date{Month: 12, Year: 1832}, 1832.956403269755},
 
 		// Days
-		{gedcom.Date{1, 1, 1789, false, gedcom.DateConstraintExact, nil}, 1789.0027322404371},
-		{gedcom.Date{31, 1, 1435, false, gedcom.DateConstraintExact, nil}, 1435.0846994535518},
-		{gedcom.Date{1, 2, 1601, false, gedcom.DateConstraintExact, nil}, 1601.0874316939892},
-		{gedcom.Date{1, 3, 845, false, gedcom.DateConstraintExact, nil}, 845.1639344262295},
-		{gedcom.Date{31, 12, 2010, false, gedcom.DateConstraintExact, nil}, 2010.9972677595629},
+		{gedcom.Date{Day: 1, Month: 1, Year: 1789}, 1789.0027322404371},
+		{gedcom.Date{Day: 31, Month: 1, Year: 1435}, 1435.0846994535518},
+		{gedcom.Date{Day: 1, Month: 2, Year: 1601}, 1601.0874316939892},
+		{gedcom.Date{Day: 1, Month: 3, Year: 845}, 845.1639344262295},
+		{gedcom.Date{Day: 31, Month: 12, Year: 2010}, 2010.9972677595629},
 	}
 
 	for _, test := range tests {
@@ -156,15 +156,15 @@ func TestDate_Years(t *testing.T) {
 func TestDate_Equals(t *testing.T) {
 	Equals := tf.Function(t, gedcom.Date.Equals)
 
-	at14Jan1845 := gedcom.Date{14, 1, 1845, false, gedcom.DateConstraintExact, nil}
-	abt14Jan1845 := gedcom.Date{14, 1, 1845, false, gedcom.DateConstraintAbout, nil}
-	bef14Jan1845 := gedcom.Date{14, 1, 1845, false, gedcom.DateConstraintBefore, nil}
-	aft14Jan1845 := gedcom.Date{14, 1, 1845, false, gedcom.DateConstraintAfter, nil}
+	at14Jan1845 := gedcom.Date{Day: 14, Month: 1, Year: 1845}
+	abt14Jan1845 := gedcom.Date{Day: 14, Month: 1, Year: 1845, Constraint: gedcom.DateConstraintAbout}
+	bef14Jan1845 := gedcom.Date{Day: 14, Month: 1, Year: 1845, Constraint: gedcom.DateConstraintBefore}
+	aft14Jan1845 := gedcom.Date{Day: 14, Month: 1, Year: 1845, Constraint: gedcom.DateConstraintAfter}
 
-	at15Jan1845 := gedcom.Date{15, 1, 1845, false, gedcom.DateConstraintExact, nil}
-	abt15Jan1845 := gedcom.Date{15, 1, 1845, false, gedcom.DateConstraintAbout, nil}
-	bef15Jan1845 := gedcom.Date{15, 1, 1845, false, gedcom.DateConstraintBefore, nil}
-	aft15Jan1845 := gedcom.Date{15, 1, 1845, false, gedcom.DateConstraintAfter, nil}
+	at15Jan1845 := gedcom.Date{Day: 15, Month: 1, Year: 1845}
+	abt15Jan1845 := gedcom.Date{Day: 15, Month: 1, Year: 1845, Constraint: gedcom.DateConstraintAbout}
+	bef15Jan1845 := gedcom.Date{Day: 15, Month: 1, Year: 1845, Constraint: gedcom.DateConstraintBefore}
+	aft15Jan1845 := gedcom.Date{Day: 15, Month: 1, Year: 1845, Constraint: gedcom.DateConstraintAfter}
 
 	// Zero dates are equal.
 	Equals(gedcom.Date{}, gedcom.Date{}).Returns(false)
@@ -249,12 +249,12 @@ func TestDate_IsZero(t *testing.T) {
 	IsZero := tf.Function(t, gedcom.Date.IsZero)
 
 	IsZero(gedcom.Date{}).Returns(true)
-	IsZero(gedcom.Date{14, 1, 1845, false, gedcom.DateConstraintExact, nil}).Returns(false)
-	IsZero(gedcom.Date{0, 1, 1845, false, gedcom.DateConstraintExact, nil}).Returns(false)
-	IsZero(gedcom.Date{0, 0, 1845, false, gedcom.DateConstraintExact, nil}).Returns(false)
-	IsZero(gedcom.Date{0, 0, 0, false, gedcom.DateConstraintExact, nil}).Returns(true)
-	IsZero(gedcom.Date{0, 0, 0, true, gedcom.DateConstraintExact, nil}).Returns(true)
-	IsZero(gedcom.Date{0, 0, 0, false, gedcom.DateConstraintAfter, nil}).Returns(true)
+	IsZero(gedcom.Date{Day: 14, Month: 1, Year: 1845}).Returns(false)
+	IsZero(gedcom.Date{Month: 1, Year: 1845}).Returns(false)
+	IsZero(gedcom.Date{Year: 1845}).Returns(false)
+	IsZero(gedcom.Date{}).Returns(true)
+	IsZero(gedcom.Date{IsEndOfRange: true}).Returns(true)
+	IsZero(gedcom.Date{Constraint: gedcom.DateConstraintAfter}).Returns(true)
 }
 
 func TestNewDateWithTime(t *testing.T) {
@@ -265,9 +265,9 @@ func TestNewDateWithTime(t *testing.T) {
 	NewDateWithTime(time.Time{}, false).Returns(gedcom.Date{})
 	NewDateWithTime(time.Time{}, true).Returns(gedcom.Date{})
 	NewDateWithTime(tm, false).Returns(
-		gedcom.Date{2, time.January, 2006, false, gedcom.DateConstraintExact, nil})
+		gedcom.Date{Day: 2, Month: time.January, Year: 2006})
 	NewDateWithTime(tm, true).Returns(
-		gedcom.Date{2, time.January, 2006, true, gedcom.DateConstraintExact, nil})
+		gedcom.Date{Day: 2, Month: time.January, Year: 2006, IsEndOfRange: true})
 }
 
 func TestNewDateRangeWithNow(t *testing.T) {
@@ -276,8 +276,8 @@ func TestNewDateRangeWithNow(t *testing.T) {
 
 	NewDateRangeWithNow().Returns(
 		gedcom.NewDateRange(
-			gedcom.Date{now.Day(), now.Month(), now.Year(), false, gedcom.DateConstraintExact, nil},
-			gedcom.Date{now.Day(), now.Month(), now.Year(), true, gedcom.DateConstraintExact, nil},
+			gedcom.Date{Day: now.Day(), Month: now.Month(), Year: now.Year()},
+			gedcom.Date{Day: now.Day(), Month: now.Month(), Year: now.Year(), IsEndOfRange: true},
 		),
 	)
 }
@@ -0,0 +1,152 @@
+package gedcom
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// DecodeErrorReason describes why a line could not be decoded.
+type DecodeErrorReason string
+
+const (
+	// DecodeErrorUnparsableLine is a line that does not have the form of a
+	// GEDCOM line, that is an indent followed by an optional pointer, a tag
+	// and an optional value.
+	//
+	// When recovering the line is skipped.
+	DecodeErrorUnparsableLine = DecodeErrorReason("UnparsableLine")
+
+	// DecodeErrorInvalidIndent is a line that has an indent more than one
+	// greater than the line before it. See Decoder.AllowInvalidIndents.
+	//
+	// When recovering the node is attached to the deepest node that is
+	// available.
+	DecodeErrorInvalidIndent = DecodeErrorReason("InvalidIndent")
+)
+
+// DecodeError is a problem with a single line of a GEDCOM stream.
+//
+// DecodeError is also a Warning so that errors that were collected by
+// Decoder.ContinueOnError can be reported alongside the other warnings of a
+// document.
+type DecodeError struct {
+	SimpleWarning
+
+	// Line is the line number, starting at 1.
+	Line int
+
+	// Column is the position of the first character (not byte) that caused
+	// the error, starting at 1.
+	Column int
+
+	// Text is the original line, after it has been converted to UTF-8.
+	Text string
+
+	Reason DecodeErrorReason
+}
+
+func newDecodeError(line, column int, text string, reason DecodeErrorReason) *DecodeError {
+	return &DecodeError{
+		Line:   line,
+		Column: column,
+		Text:   text,
+		Reason: reason,
+	}
+}
+
+// Error includes the position, the reason and the original line, like:
+//
+//   line 5, column 1: indent is too large - missing parent? (InvalidIndent): 3 DATE
+func (e *DecodeError) Error() string {
+	description := "could not parse"
+	if e.Reason == DecodeErrorInvalidIndent {
+		description = "indent is too large - missing parent?"
+	}
+
+	return fmt.Sprintf("line %d, column %d: %s (%s): %s", e.Line, e.Column,
+		description, e.Reason, e.Text)
+}
+
+func (e *DecodeError) Name() string {
+	return "DecodeError"
+}
+
+func (e *DecodeError) String() string {
+	return e.Error()
+}
+
+// DecodeErrors are all of the errors that were collected when decoding with
+// Decoder.ContinueOnError.
+type DecodeErrors []*DecodeError
+
+func (errs DecodeErrors) Error() string {
+	if len(errs) == 1 {
+		return errs[0].Error()
+	}
+
+	var lines []string
+	for _, err := range errs {
+		lines = append(lines, err.Error())
+	}
+
+	return fmt.Sprintf("%d errors: %s", len(errs), strings.Join(lines, "; "))
+}
+
+// Warnings returns the errors as Warnings.
+func (errs DecodeErrors) Warnings() (warnings Warnings) {
+	for _, err := range errs {
+		warnings = append(warnings, err)
+	}
+
+	return
+}
+
+// unparsableColumn returns the column of the first character that prevents
+// the line from being parsed.
+func unparsableColumn(line string) int {
+	column := func(i int) int {
+		return utf8.RuneCountInString(line[:i]) + 1
+	}
+
+	// Indent.
+	if line == "" || !isDigit(line[0]) {
+		return 1
+	}
+
+	// At least one space must follow the indent.
+	i := 1
+	if i >= len(line) || line[i] != ' ' {
+		return column(i)
+	}
+
+	for i < len(line) && line[i] == ' ' {
+		i++
+	}
+
+	// Optional pointer, which must be followed by exactly one space.
+	if i < len(line) && line[i] == '@' {
+		end := strings.IndexByte(line[i+1:], '@')
+		if end < 1 || i+end+2 >= len(line) || line[i+end+2] != ' ' {
+			return column(i)
+		}
+
+		i += end + 3
+	}
+
+	// Tag. Anything after the tag is the value.
+	if i >= len(line) || !isWordCharacter(line[i]) {
+		return column(i)
+	}
+
+	return 1
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+func isWordCharacter(b byte) bool {
+	return isDigit(b) || b == '_' || (b >= 'a' && b <= 'z') ||
+		(b >= 'A' && b <= 'Z')
+}
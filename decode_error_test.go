@@ -0,0 +1,123 @@
+package gedcom_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeError_Column(t *testing.T) {
+	for line, expected := range map[string]int{
+		"foo":          1,
+		"01 NAME":      2,
+		"0NAME":        2,
+		"0 @I1 INDI":   3,
+		"0 @@ INDI":    3,
+		"0 @I1@INDI":   3,
+		"0 @I1@  INDI": 8,
+		"0 ":           3,
+		"0 @I1@ ":      8,
+		"0 ÄNAME Bob":  3,
+		"0 @Ä1@ -INDI": 8,
+	} {
+		t.Run(line, func(t *testing.T) {
+			decoder := gedcom.NewDecoder(strings.NewReader(line))
+			_, err := decoder.Decode()
+
+			if assert.IsType(t, (*gedcom.DecodeError)(nil), err) {
+				decodeError := err.(*gedcom.DecodeError)
+				assert.Equal(t, expected, decodeError.Column)
+				assert.Equal(t, line, decodeError.Text)
+				assert.Equal(t, 1, decodeError.Line)
+				assert.Equal(t, gedcom.DecodeErrorUnparsableLine, decodeError.Reason)
+			}
+		})
+	}
+}
+
+func TestDecodeErrors_Error(t *testing.T) {
+	errs := gedcom.DecodeErrors{
+		{Line: 3, Column: 1, Text: "foo", Reason: gedcom.DecodeErrorUnparsableLine},
+	}
+	assert.EqualError(t, errs,
+		"line 3, column 1: could not parse (UnparsableLine): foo")
+
+	errs = append(errs, &gedcom.DecodeError{
+		Line: 5, Column: 1, Text: "3 DATE",
+		Reason: gedcom.DecodeErrorInvalidIndent,
+	})
+	assert.EqualError(t, errs, "2 errors: "+
+		"line 3, column 1: could not parse (UnparsableLine): foo; "+
+		"line 5, column 1: indent is too large - missing parent? (InvalidIndent): 3 DATE")
+
+	assert.Equal(t, []string{
+		"line 3, column 1: could not parse (UnparsableLine): foo",
+		"line 5, column 1: indent is too large - missing parent? (InvalidIndent): 3 DATE",
+	}, errs.Warnings().Strings())
+}
+
+func TestDecoder_ContinueOnError(t *testing.T) {
+	ged := "0 HEAD\nbad line\n0 @I1@ INDI\n1 NAME Bob\n3 DATE 1900\n1 SEX M\n"
+
+	t.Run("Decode", func(t *testing.T) {
+		decoder := gedcom.NewDecoder(strings.NewReader(ged))
+		decoder.ContinueOnError = true
+
+		doc, err := decoder.Decode()
+
+		expectedErrors := gedcom.DecodeErrors{
+			{
+				Line:   2,
+				Column: 1,
+				Text:   "bad line",
+				Reason: gedcom.DecodeErrorUnparsableLine,
+			},
+			{
+				Line:   5,
+				Column: 1,
+				Text:   "3 DATE 1900",
+				Reason: gedcom.DecodeErrorInvalidIndent,
+			},
+		}
+
+		assert.Equal(t, expectedErrors, err)
+		assert.Equal(t, expectedErrors, decoder.Errors())
+		assert.Equal(t, "0 HEAD\n0 @I1@ INDI\n1 NAME Bob\n2 DATE 1900\n1 SEX M\n",
+			doc.String())
+	})
+
+	t.Run("NoErrors", func(t *testing.T) {
+		decoder := gedcom.NewDecoder(strings.NewReader("0 HEAD\n"))
+		decoder.ContinueOnError = true
+
+		doc, err := decoder.Decode()
+
+		assert.NoError(t, err)
+		assert.Nil(t, decoder.Errors())
+		assert.Len(t, doc.Nodes(), 1)
+	})
+
+	t.Run("IndentBeforeFirstRecord", func(t *testing.T) {
+		decoder := gedcom.NewDecoder(strings.NewReader("1 NOTE foo\n2 CONT bar"))
+		decoder.ContinueOnError = true
+
+		doc, err := decoder.Decode()
+
+		// The second line is also invalid because it is relative to the
+		// original indent of the first line.
+		assert.Len(t, err, 2)
+		assert.Equal(t, "0 NOTE foo\n1 CONT bar\n", doc.String())
+	})
+
+	t.Run("AllowInvalidIndents", func(t *testing.T) {
+		decoder := gedcom.NewDecoder(strings.NewReader(ged))
+		decoder.ContinueOnError = true
+		decoder.AllowInvalidIndents = true
+
+		_, err := decoder.Decode()
+
+		assert.Len(t, err, 1)
+	})
+}
@@ -27,15 +27,50 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
 )
 
 // See Decoder.consumeOptionalBOM().
 var byteOrderMark = []byte{0xef, 0xbb, 0xbf}
 
+// The Byte Order Marks for UTF-16. See Decoder.detectCharacterSet().
+var (
+	byteOrderMarkUTF16LE = []byte{0xff, 0xfe}
+	byteOrderMarkUTF16BE = []byte{0xfe, 0xff}
+)
+
+// headerPeekSize is the maximum number of bytes that will be read ahead to
+// find the CHAR in the HEAD.
+const headerPeekSize = 64 * 1024
+
+var (
+	headerCharacterSetRegexp = regexp.MustCompile(`^1 +CHAR +(.*?)$`)
+	headerGEDCRegexp         = regexp.MustCompile(`^1 +GEDC$`)
+	headerVersionRegexp      = regexp.MustCompile(`^2 +VERS +(.*?)$`)
+)
+
 // Decoder represents a GEDCOM decoder.
 type Decoder struct {
 	r *bufio.Reader
 
+	// CharacterSet will force the character set to be used when decoding.
+	//
+	// If CharacterSet is empty (the default) the character set is detected from
+	// the Byte Order Mark or the CHAR in the HEAD. If neither of those exist
+	// (or the CHAR is not understood) then the values are assumed to be UTF-8
+	// and Document.CharacterSet will be empty.
+	//
+	// A UTF-16 Byte Order Mark will always take precedence over CharacterSet.
+	CharacterSet CharacterSet
+
+	// Version will force the GEDCOM version of the stream. If Version is empty
+	// (the default) it is detected from the VERS in the GEDC of the HEAD.
+	//
+	// The version is recorded in Document.Version. See GEDCOMVersion.
+	Version GEDCOMVersion
+
 	// It is not valid for GEDCOM values to contain new lines or carriage
 	// returns. However, some application dump data without correctly using the
 	// CONT tags.
@@ -61,36 +96,197 @@ type Decoder struct {
 	// Another important thing to note is that the incorrect indent level will
 	// not be retained when writing the Document back to a GEDCOM.
 	AllowInvalidIndents bool
+
+	// KeepContinuationNodes will retain CONC and CONT nodes as ordinary child
+	// nodes rather than folding them into the value of the parent node.
+	//
+	// By default the value of a node that is split over several lines will be
+	// joined back together. CONT lines are joined with a new line and CONC
+	// lines are appended directly.
+	KeepContinuationNodes bool
+
+	// PreserveFormatting will retain the original bytes of every line so that
+	// the Encoder can reproduce any nodes that have not been modified exactly
+	// as they were, including spacing and line endings. It also records the
+	// Document.LineEnding. See nodeSource.
+	//
+	// This uses considerably more memory.
+	PreserveFormatting bool
+
+	// ContinueOnError will skip or repair lines that are not valid rather
+	// than stopping at the first one. Each problem is recorded as a
+	// DecodeError and is available from Errors().
+	//
+	// Decode will return the partial Document along with the DecodeErrors
+	// (if there were any).
+	ContinueOnError bool
+
+	// ResolvePointers allows the records returned by NextRecord to resolve
+	// pointers to other records. It has no effect on Decode.
+	//
+	// Records that contain a pointer are retained after they are returned so
+	// that they can be found later. A pointer to a record that has not been
+	// read yet will cause the Decoder to read ahead until the record is found
+	// (or to the end of the stream if it does not exist). This means that
+	// memory usage is no longer constant, although it is still much less than
+	// decoding the whole Document.
+	ResolvePointers bool
+
+	// DateLocales are used to understand DATE values that are not written in
+	// English. Each of the DateLocales is tried in order before falling back
+	// to English. See DateLocale and NewDateRangeWithLocales.
+	//
+	// The locales are retained by the Document (Document.DateLocales) so that
+	// the dates can be parsed when they are needed.
+	DateLocales []*DateLocale
+
+	// The state of the stream between calls to NextRecord.
+	document     *Document
+	lineNumber   int
+	finished     bool
+	err          error
+	indents      Nodes
+	family       *FamilyNode
+	record       Node
+	previousNode Node
+	blankLines   int
+	buffered     Nodes
+	errors       DecodeErrors
+
+	// Only used when PreserveFormatting is enabled. raw contains all of the
+	// bytes that have been read since the start of the line for sourceNode.
+	raw        []byte
+	sourceNode Node
 }
 
 // Create a new decoder to parse a reader that contain GEDCOM data.
 func NewDecoder(r io.Reader) *Decoder {
 	return &Decoder{
-		r: bufio.NewReader(r),
+		r: bufio.NewReaderSize(r, headerPeekSize),
 	}
 }
 
 // Decode will parse the entire GEDCOM stream (until EOF is reached) and return
 // a Document. If the GEDCOM stream is not valid then the document node will
-// be nil and the error is returned.
+// be nil and the error is returned. A line that cannot be parsed will return a
+// *DecodeError.
+//
+// If ContinueOnError is enabled the Document will always be returned, and the
+// error will be DecodeErrors if any of the lines were not valid.
 //
 // A blank GEDCOM or a GEDCOM that only contains empty lines is valid and a
 // Document will be returned with zero nodes.
+//
+// Decode must not be mixed with NextRecord. See NextRecord for reading very
+// large files.
 func (dec *Decoder) Decode() (*Document, error) {
-	document := NewDocument()
-	indents := Nodes{}
-	var family *FamilyNode
+	document := dec.Document()
+
+	for {
+		node, err := dec.NextRecord()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		document.AddNode(node)
+	}
+
+	// Build the cache once.
+	document.buildPointerCache()
+
+	if len(dec.errors) > 0 {
+		return document, dec.errors
+	}
+
+	return document, nil
+}
+
+// Errors returns the errors that have been collected so far when
+// ContinueOnError is enabled.
+func (dec *Decoder) Errors() DecodeErrors {
+	return dec.errors
+}
 
-	document.HasBOM = dec.consumeOptionalBOM()
+// NextRecord returns the next root (level 0) node of the stream, including all
+// of its children. io.EOF is returned when there are no more records.
+//
+// Unlike Decode, the records are not retained by the Decoder so very large
+// files can be processed in constant memory:
+//
+//   decoder := gedcom.NewDecoder(file)
+//   for {
+//     record, err := decoder.NextRecord()
+//     if err == io.EOF {
+//       break
+//     }
+//     if err != nil {
+//       panic(err)
+//     }
+//
+//     fmt.Println(record)
+//   }
+//
+// The records belong to Document(). Since the document does not contain any
+// nodes any pointers (such as the HUSB of a family) will not be resolved unless
+// ResolvePointers is enabled.
+func (dec *Decoder) NextRecord() (Node, error) {
+	dec.start()
+
+	if len(dec.buffered) > 0 {
+		record := dec.buffered[0]
+		dec.buffered = dec.buffered[1:]
+
+		return record, nil
+	}
+
+	if dec.err != nil {
+		return nil, dec.err
+	}
+
+	return dec.readRecord()
+}
+
+// Document returns the Document that the records from NextRecord belong to.
+// The HasBOM, CharacterSet and Version will be available before the first
+// record is read.
+//
+// The document will not contain any nodes.
+func (dec *Decoder) Document() *Document {
+	dec.start()
+
+	return dec.document
+}
+
+// start detects the properties of the stream before any lines are read. It is
+// safe to call start more than once.
+func (dec *Decoder) start() {
+	if dec.document != nil {
+		return
+	}
+
+	dec.document = NewDocument()
+	dec.document.HasBOM = dec.consumeOptionalBOM()
+	dec.document.CharacterSet = dec.detectCharacterSet(dec.document.HasBOM)
+	dec.document.Version = dec.detectVersion()
+	dec.document.DateLocales = dec.DateLocales
 
-	finished := false
-	lineNumber := 0
+	if dec.ResolvePointers {
+		dec.document.resolvePointer = dec.resolvePointer
+	}
+}
 
-	// Only used when AllowMultiLine is enabled.
-	var previousNode Node
+// readRecord reads lines until the start of the next record (or the end of the
+// stream) and returns the record that was completed.
+func (dec *Decoder) readRecord() (Node, error) {
+	document := dec.document
 
-	for !finished {
-		lineNumber++
+	for !dec.finished {
+		dec.lineNumber++
+		start := len(dec.raw)
 
 		line, err := dec.readLine()
 		if err != nil {
@@ -98,75 +294,117 @@ func (dec *Decoder) Decode() (*Document, error) {
 				return nil, err
 			}
 
-			finished = true
+			dec.finished = true
 		}
 
-		// Skip blank lines.
+		line = document.CharacterSet.decodeLine(line)
+
+		// Skip blank lines. With AllowMultiLine they are paragraph separators
+		// if more text follows.
 		if line == "" {
-			if dec.AllowMultiLine && previousNode != nil {
-				previousNode.RawSimpleNode().value += "\n"
+			if dec.AllowMultiLine && dec.previousNode != nil {
+				dec.blankLines++
 			}
 
 			continue
 		}
 
-		node, indent, err := parseLine(line, document, family)
+		node, indent, err := parseLine(line, document, dec.family)
 		if err != nil {
-			if dec.AllowMultiLine && previousNode != nil {
-				previousNode.RawSimpleNode().value += "\n" + line
+			if dec.AllowMultiLine && dec.previousNode != nil {
+				dec.appendMultiLine(line)
+				continue
+			}
+
+			err := newDecodeError(dec.lineNumber, unparsableColumn(line), line,
+				DecodeErrorUnparsableLine)
+			if dec.ContinueOnError {
+				dec.errors = append(dec.errors, err)
 				continue
 			}
 
-			return nil, fmt.Errorf("line %d: %s", lineNumber, err)
+			return nil, err
+		}
+
+		dec.blankLines = 0
+
+		if indent > len(dec.indents) {
+			// This means the file is not valid. I have seen it in very rare
+			// cases. See full explanation in AllowInvalidIndents.
+			switch {
+			case dec.AllowInvalidIndents:
+				indent = len(dec.indents)
+
+			case dec.ContinueOnError:
+				dec.errors = append(dec.errors, newDecodeError(dec.lineNumber,
+					1, line, DecodeErrorInvalidIndent))
+				indent = len(dec.indents)
+
+			default:
+				return nil, newDecodeError(dec.lineNumber, 1, line,
+					DecodeErrorInvalidIndent)
+			}
+		}
+
+		// CONC and CONT are folded into the value of the parent rather than
+		// being added as children.
+		if !dec.KeepContinuationNodes && indent > 0 &&
+			isContinuationTag(node.Tag()) && indent-1 < len(dec.indents) {
+			parent := dec.indents[indent-1]
+			if parent != dec.previousNode {
+				dec.trimNodeValue(dec.previousNode)
+			}
+
+			dec.recordContinuationSource(parent, start)
+			appendContinuation(parent, node.Tag(), node.Value())
+			dec.indents = dec.indents[:indent]
+			dec.previousNode = parent
+
+			continue
 		}
 
 		// Families cannot be nested so any children that appear after this node
 		// will be attached to the most recently seen family. We do not need to
 		// set this back to nil after we exit the family node.
 		if f, ok := node.(*FamilyNode); ok {
-			family = f
+			dec.family = f
 		}
 
-		// Add a root node to the document.
+		// A root node is the start of a new record, which means the previous
+		// record is complete.
 		if indent == 0 {
-			dec.trimNodeValue(previousNode)
-			document.AddNode(node)
-			previousNode = node
+			dec.trimNodeValue(dec.previousNode)
+			dec.recordSource(node, start)
+			record := dec.record
+			dec.record = node
+			dec.previousNode = node
 
 			// There can be multiple root nodes so make sure we always reset all
 			// indent pointers.
-			indents = Nodes{node}
-
-			continue
-		}
+			dec.indents = Nodes{node}
 
-		if indent-1 >= len(indents) {
-			// This means the file is not valid. I have seen it in very rare
-			// cases. See full explanation in AllowInvalidIndents.
-			if dec.AllowInvalidIndents {
-				indent = len(indents)
-			} else {
-				panic(fmt.Sprintf(
-					"indent is too large - missing parent? at line %d: %s",
-					lineNumber, line))
+			if record != nil {
+				return dec.completeRecord(record), nil
 			}
+
+			continue
 		}
 
-		i := indents[indent-1]
+		i := dec.indents[indent-1]
 
 		switch {
-		case indent >= len(indents):
+		case indent >= len(dec.indents):
 			// Descending one level. It is not valid for a child to have an
 			// indent that is more than one greater than the parent. This would
 			// be a corrupt GEDCOM and lead to a panic.
-			indents = append(indents, node)
+			dec.indents = append(dec.indents, node)
 
-		case indent < len(indents)-1:
+		case indent < len(dec.indents)-1:
 			// Moving back to a parent. It is possible for this leap to be
 			// greater than one so trim the indent levels back as many times as
 			// needed to represent the new indent level.
-			indents = indents[:indent+1]
-			indents[indent] = node
+			dec.indents = dec.indents[:indent+1]
+			dec.indents[indent] = node
 
 		default:
 			// This case would be "indent == len(indents)-1" (the indent does
@@ -176,33 +414,99 @@ func (dec *Decoder) Decode() (*Document, error) {
 			//
 			// Make sure we update the current indent with the new node so that
 			// children get place on this node and not the previous one.
-			indents[indent] = node
+			dec.indents[indent] = node
 		}
 
-		dec.trimNodeValue(previousNode)
+		dec.trimNodeValue(dec.previousNode)
+		dec.recordSource(node, start)
 		i.AddNode(node)
 
-		previousNode = node
+		dec.previousNode = node
 	}
 
-	dec.trimNodeValue(previousNode)
+	dec.trimNodeValue(dec.previousNode)
+	dec.recordSource(nil, len(dec.raw))
 
-	// Build the cache once.
-	document.buildPointerCache()
+	if record := dec.record; record != nil {
+		dec.record = nil
 
-	return document, nil
+		return dec.completeRecord(record), nil
+	}
+
+	return nil, io.EOF
+}
+
+// completeRecord is called once all of the lines for a record have been read.
+// It keeps the record in the pointer cache of the document when
+// ResolvePointers is enabled.
+func (dec *Decoder) completeRecord(record Node) Node {
+	if dec.PreserveFormatting {
+		completeSource(record, 0)
+	}
+
+	if dec.ResolvePointers && record.Pointer() != "" {
+		dec.document.pointerCache.Store(record.Pointer(), record)
+	}
+
+	return record
+}
+
+// resolvePointer is used by the Document when a pointer is not found in the
+// cache. Records are read ahead until the pointer is found. The records that
+// were read ahead will be returned by NextRecord in the original order.
+func (dec *Decoder) resolvePointer(pointer string) Node {
+	for dec.err == nil {
+		record, err := dec.readRecord()
+		if err != nil {
+			// The error is returned from NextRecord after the buffered
+			// records.
+			dec.err = err
+
+			break
+		}
+
+		dec.buffered = append(dec.buffered, record)
+
+		if record.Pointer() == pointer {
+			return record
+		}
+	}
+
+	return nil
 }
 
+// trimNodeValue removes the spaces around the text on the line of the node
+// itself. It is called once all of the lines for the node have been read.
+//
+// The CONC, CONT and multiline text lines are never trimmed because the spaces
+// (and empty lines) are part of the value. For the same reason the end of the
+// first line is only trimmed if no other lines follow it.
 func (dec *Decoder) trimNodeValue(previousNode Node) {
-	// When AllowMultiLine is enabled we have to be careful to trim the
-	// surrounding spaces off the value so it can be interpreted correct.
-	//
-	// Another solution would be to ignore blank lines entirely, but then we
-	// would miss the paragraph separators in multiline text.
-	if !IsNil(previousNode) {
-		newValue := strings.TrimSpace(previousNode.RawSimpleNode().value)
-		previousNode.RawSimpleNode().value = newValue
+	if IsNil(previousNode) {
+		return
+	}
+
+	simpleNode := previousNode.RawSimpleNode()
+	c := simpleNode.continuation
+
+	if c == nil {
+		simpleNode.value = strings.TrimSpace(simpleNode.value)
+		return
+	}
+
+	c.lines[0].value = strings.TrimLeft(c.lines[0].value, " \t")
+	simpleNode.value = c.value()
+}
+
+// appendMultiLine adds a line that could not be parsed to the value of the
+// previous node when AllowMultiLine is enabled. Any blank lines before it are
+// kept as empty lines in the value.
+func (dec *Decoder) appendMultiLine(line string) {
+	for ; dec.blankLines > 0; dec.blankLines-- {
+		appendContinuation(dec.previousNode, TagContinued, "")
 	}
+
+	appendContinuation(dec.previousNode, TagContinued, line)
 }
 
 func (dec *Decoder) readLine() (string, error) {
@@ -214,6 +518,10 @@ func (dec *Decoder) readLine() (string, error) {
 			return string(buf.Bytes()), err
 		}
 
+		if dec.PreserveFormatting {
+			dec.raw = append(dec.raw, b)
+		}
+
 		// The line endings in the GEDCOM files can be different. A newline and
 		// carriage return are both considered to be the end of the line and
 		// empty lines are ignored so we can treat both of these characters as
@@ -273,6 +581,9 @@ func newNodeWithChildren(document *Document, family *FamilyNode, tag Tag, value,
 	var node Node
 
 	switch tag {
+	case TagAnnulment:
+		node = NewAnnulmentNode(value, children...)
+
 	case TagBaptism:
 		node = NewBaptismNode(value, children...)
 
@@ -288,11 +599,17 @@ func newNodeWithChildren(document *Document, family *FamilyNode, tag Tag, value,
 		node = newChildNode(family, value, children...)
 
 	case TagDate:
-		node = NewDateNode(value, children...)
+		node = newDateNode(document, value, children...)
 
 	case TagDeath:
 		node = NewDeathNode(value, children...)
 
+	case TagDivorce:
+		node = NewDivorceNode(value, children...)
+
+	case TagEngagement:
+		node = NewEngagementNode(value, children...)
+
 	case TagEvent:
 		node = NewEventNode(value, children...)
 
@@ -307,6 +624,9 @@ func newNodeWithChildren(document *Document, family *FamilyNode, tag Tag, value,
 	case TagFormat:
 		node = NewFormatNode(value, children...)
 
+	case TagHeader:
+		node = newHeaderNode(value, pointer, children...)
+
 	case TagHusband:
 		needsFamily(family, tag)
 
@@ -326,6 +646,9 @@ func newNodeWithChildren(document *Document, family *FamilyNode, tag Tag, value,
 	case TagMap:
 		node = NewMapNode(value, children...)
 
+	case TagMarriage:
+		node = NewMarriageNode(value, children...)
+
 	case TagName:
 		node = NewNameNode(value, children...)
 
@@ -335,12 +658,21 @@ func newNodeWithChildren(document *Document, family *FamilyNode, tag Tag, value,
 	case TagNote:
 		node = NewNoteNode(value, children...)
 
+	case TagObject:
+		node = newMultimediaNode(document, value, pointer, children...)
+
 	case TagPhonetic:
 		node = NewPhoneticVariationNode(value, children...)
 
 	case TagPlace:
 		node = NewPlaceNode(value, children...)
 
+	case TagSharedNote:
+		node = newSharedNoteNode(document, value, pointer, children...)
+
+	case TagRepository:
+		node = newRepositoryNode(document, value, pointer, children...)
+
 	case TagResidence:
 		node = NewResidenceNode(value, children...)
 
@@ -351,7 +683,10 @@ func newNodeWithChildren(document *Document, family *FamilyNode, tag Tag, value,
 		node = NewSexNode(value)
 
 	case TagSource:
-		node = NewSourceNode(value, pointer, children...)
+		node = newSourceNode(document, value, pointer, children...)
+
+	case TagSubmitter:
+		node = newSubmitterNode(document, value, pointer, children...)
 
 	case TagType:
 		node = NewTypeNode(value, children...)
@@ -408,3 +743,105 @@ func (dec *Decoder) consumeOptionalBOM() bool {
 
 	return hasBOM
 }
+
+// detectCharacterSet determines the character set from the Byte Order Mark or
+// the CHAR in the HEAD. If the stream is UTF-16 the reader will be replaced so
+// that it produces UTF-8.
+func (dec *Decoder) detectCharacterSet(hasUTF8BOM bool) CharacterSet {
+	possibleBOM, _ := dec.r.Peek(2)
+
+	switch {
+	case hasUTF8BOM:
+		return CharacterSetUTF8
+
+	case bytes.Equal(possibleBOM, byteOrderMarkUTF16LE),
+		bytes.Equal(possibleBOM, byteOrderMarkUTF16BE):
+		dec.useUTF16(unicode.ExpectBOM, unicode.BigEndian)
+		return CharacterSetUnicode
+
+	// Without a BOM we can still detect UTF-16 because every GEDCOM starts
+	// with a "0".
+	case bytes.Equal(possibleBOM, []byte{'0', 0}):
+		dec.useUTF16(unicode.IgnoreBOM, unicode.LittleEndian)
+		return CharacterSetUnicode
+
+	case bytes.Equal(possibleBOM, []byte{0, '0'}):
+		dec.useUTF16(unicode.IgnoreBOM, unicode.BigEndian)
+		return CharacterSetUnicode
+
+	case dec.CharacterSet == CharacterSetUnicode:
+		dec.useUTF16(unicode.IgnoreBOM, unicode.LittleEndian)
+		return CharacterSetUnicode
+
+	case dec.CharacterSet != "":
+		return dec.CharacterSet
+	}
+
+	return dec.headerCharacterSet()
+}
+
+func (dec *Decoder) useUTF16(bom unicode.BOMPolicy, endianness unicode.Endianness) {
+	decoder := unicode.UTF16(endianness, bom).NewDecoder()
+	dec.r = bufio.NewReaderSize(transform.NewReader(dec.r, decoder),
+		headerPeekSize)
+}
+
+// peekHeader returns the lines of the HEAD record without consuming any of the
+// stream.
+func (dec *Decoder) peekHeader() (lines []string) {
+	header, _ := dec.r.Peek(headerPeekSize)
+
+	for i, line := range strings.FieldsFunc(string(header), func(r rune) bool {
+		return r == '\n' || r == '\r'
+	}) {
+		// Stop at the end of the HEAD record.
+		if i > 0 && strings.HasPrefix(strings.TrimSpace(line), "0 ") {
+			break
+		}
+
+		lines = append(lines, strings.TrimSpace(line))
+	}
+
+	return
+}
+
+// headerCharacterSet looks ahead for the CHAR in the HEAD. An empty
+// CharacterSet is returned if the CHAR cannot be found or is not understood.
+func (dec *Decoder) headerCharacterSet() CharacterSet {
+	for _, line := range dec.peekHeader() {
+		if parts := headerCharacterSetRegexp.FindStringSubmatch(line); parts != nil {
+			if charset := CharacterSetFromString(parts[1]); charset != "" {
+				return charset
+			}
+		}
+	}
+
+	return ""
+}
+
+// detectVersion looks ahead for the VERS in the GEDC of the HEAD. An empty
+// GEDCOMVersion is returned if it cannot be found or is not understood.
+func (dec *Decoder) detectVersion() GEDCOMVersion {
+	if dec.Version != "" {
+		return dec.Version
+	}
+
+	inGEDC := false
+
+	for _, line := range dec.peekHeader() {
+		switch {
+		case headerGEDCRegexp.MatchString(line):
+			inGEDC = true
+
+		case strings.HasPrefix(line, "1 "):
+			inGEDC = false
+
+		case inGEDC:
+			if parts := headerVersionRegexp.FindStringSubmatch(line); parts != nil {
+				return GEDCOMVersionFromString(parts[1])
+			}
+		}
+	}
+
+	return ""
+}
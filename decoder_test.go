@@ -1,6 +1,7 @@
 package gedcom_test
 
 import (
+	"io"
 	"strings"
 	"testing"
 
@@ -380,12 +381,137 @@ func TestDecoder_Decode(t *testing.T) {
 		}
 	})
 
+	t.Run("ContinuationNodesAreFolded", func(t *testing.T) {
+		ged := "0 NOTE This is a long note\n1 CONC  that continues\n1 CONT on another line.\n1 CONT\n1 SOUR @S1@"
+		decoder := gedcom.NewDecoder(strings.NewReader(ged))
+
+		actual, err := decoder.Decode()
+		if assert.NoError(t, err) {
+			note := actual.Nodes()[0]
+
+			assert.Equal(t, "This is a long note that continues\non another line.\n", note.Value())
+			assertEqual(t, gedcom.Nodes{gedcom.NewSourceNode("@S1@", "")}, note.Nodes())
+
+			// The original lines are retained.
+			assert.Equal(t, ged+"\n", actual.String())
+		}
+	})
+
+	t.Run("ContinuationLinesAreNotTrimmed", func(t *testing.T) {
+		for ged, expected := range map[string]string{
+			"0 @N1@ NOTE\n1 CONT line2":                 "\nline2",
+			"0 @N1@ NOTE line1\n1 CONT":                 "line1\n",
+			"0 @N1@ NOTE line1\n1 CONT\n1 CONT line3":   "line1\n\nline3",
+			"0 @N1@ NOTE  line1 \n1 CONC  line2 ":       "line1  line2 ",
+			"0 @N1@ NOTE line1 \n1 CONT":                "line1 \n",
+			"0 @N1@ NOTE\n1 CONT\n1 CONT line3\n1 CONT": "\n\nline3\n",
+		} {
+			decoder := gedcom.NewDecoder(strings.NewReader(ged))
+
+			actual, err := decoder.Decode()
+			if assert.NoError(t, err, ged) {
+				assert.Equal(t, expected, actual.Nodes()[0].Value(), ged)
+			}
+		}
+	})
+
+	t.Run("ContinuationNodesAfterChildren", func(t *testing.T) {
+		ged := "0 @I1@ INDI\n1 NOTE foo\n2 SOUR @S1@\n3 PAGE 1\n2 CONC bar\n1 SEX M"
+		decoder := gedcom.NewDecoder(strings.NewReader(ged))
+
+		actual, err := decoder.Decode()
+		if assert.NoError(t, err) {
+			note := actual.Individuals()[0].Nodes()[0]
+
+			assert.Equal(t, "foobar", note.Value())
+			assert.Len(t, note.Nodes(), 1)
+			assert.Len(t, actual.Individuals()[0].Nodes(), 2)
+		}
+	})
+
+	t.Run("KeepContinuationNodes", func(t *testing.T) {
+		ged := "0 NOTE foo\n1 CONC bar\n1 CONT baz"
+		decoder := gedcom.NewDecoder(strings.NewReader(ged))
+		decoder.KeepContinuationNodes = true
+
+		actual, err := decoder.Decode()
+		if assert.NoError(t, err) {
+			doc := gedcom.NewDocument()
+			doc.AddNode(gedcom.NewNoteNode("foo",
+				gedcom.NewNode(gedcom.TagConcatenation, "bar", ""),
+				gedcom.NewNode(gedcom.TagContinued, "baz", ""),
+			))
+
+			assertDocumentEqual(t, doc, actual)
+		}
+	})
+
+	t.Run("ANSEL", func(t *testing.T) {
+		ged := "0 HEAD\n1 CHAR ANSEL\n0 NAME Ren\xe2e /M\xe8uller/\n0 NAME \xa5sa \xb1\xf0c"
+		decoder := gedcom.NewDecoder(strings.NewReader(ged))
+
+		actual, err := decoder.Decode()
+		if assert.NoError(t, err) {
+			assert.Equal(t, gedcom.CharacterSetANSEL, actual.CharacterSet)
+			assert.Equal(t, "René /Müller/", actual.Nodes()[1].Value())
+			assert.Equal(t, "Æsa łç", actual.Nodes()[2].Value())
+		}
+	})
+
+	t.Run("ForcedCharacterSet", func(t *testing.T) {
+		ged := "0 HEAD\n1 CHAR UTF-8\n0 NAME Ren\xe2e"
+		decoder := gedcom.NewDecoder(strings.NewReader(ged))
+		decoder.CharacterSet = gedcom.CharacterSetANSEL
+
+		actual, err := decoder.Decode()
+		if assert.NoError(t, err) {
+			assert.Equal(t, gedcom.CharacterSetANSEL, actual.CharacterSet)
+			assert.Equal(t, "René", actual.Nodes()[1].Value())
+		}
+	})
+
+	t.Run("UnknownCharacterSet", func(t *testing.T) {
+		ged := "0 HEAD\n1 CHAR IBMPC\n0 NAME κόσμε"
+		decoder := gedcom.NewDecoder(strings.NewReader(ged))
+
+		actual, err := decoder.Decode()
+		if assert.NoError(t, err) {
+			assert.Equal(t, gedcom.CharacterSet(""), actual.CharacterSet)
+			assert.Equal(t, "κόσμε", actual.Nodes()[1].Value())
+		}
+	})
+
+	for testName, ged := range map[string]string{
+		"UTF-16LE":      "\xff\xfe0\x00 \x00N\x00A\x00M\x00E\x00 \x00\xba\x03\xb5\x03\n\x00",
+		"UTF-16BE":      "\xfe\xff\x000\x00 \x00N\x00A\x00M\x00E\x00 \x03\xba\x03\xb5\x00\n",
+		"UTF-16LENoBOM": "0\x00 \x00N\x00A\x00M\x00E\x00 \x00\xba\x03\xb5\x03\n\x00",
+	} {
+		t.Run(testName, func(t *testing.T) {
+			decoder := gedcom.NewDecoder(strings.NewReader(ged))
+
+			actual, err := decoder.Decode()
+			if assert.NoError(t, err) {
+				assert.Equal(t, gedcom.CharacterSetUnicode, actual.CharacterSet)
+				assert.False(t, actual.HasBOM)
+				assert.Equal(t, "κε", actual.Nodes()[0].Value())
+			}
+		})
+	}
+
 	t.Run("IndentTooBig", func(t *testing.T) {
 		decoder := gedcom.NewDecoder(strings.NewReader("0 @I59238932@ INDI\n2 NPFX Mrs William Cornens\n1 SEX F"))
 
-		assert.PanicsWithValue(t, "indent is too large - missing parent? at line 2: 2 NPFX Mrs William Cornens", func() {
-			_, _ = decoder.Decode()
-		})
+		actual, err := decoder.Decode()
+
+		assert.Nil(t, actual)
+		assert.Equal(t, &gedcom.DecodeError{
+			Line:   2,
+			Column: 1,
+			Text:   "2 NPFX Mrs William Cornens",
+			Reason: gedcom.DecodeErrorInvalidIndent,
+		}, err)
+		assert.EqualError(t, err, "line 2, column 1: indent is too large - "+
+			"missing parent? (InvalidIndent): 2 NPFX Mrs William Cornens")
 	})
 
 	t.Run("IndentTooBigAllowed", func(t *testing.T) {
@@ -404,6 +530,97 @@ func TestDecoder_Decode(t *testing.T) {
 	})
 }
 
+func TestDecoder_NextRecord(t *testing.T) {
+	ged := "0 HEAD\n1 CHAR ANSEL\n0 @I1@ INDI\n1 NAME Jos\xe2e /Smith/\n" +
+		"0 @F1@ FAM\n1 HUSB @I1@\n1 WIFE @I2@\n0 @I2@ INDI\n1 NAME Jane\n\n"
+
+	readAll := func(decoder *gedcom.Decoder) (records []string) {
+		for {
+			record, err := decoder.NextRecord()
+			if err == io.EOF {
+				return
+			}
+
+			assert.NoError(t, err)
+			records = append(records, record.GEDCOMString(0))
+		}
+	}
+
+	t.Run("Records", func(t *testing.T) {
+		decoder := gedcom.NewDecoder(strings.NewReader(ged))
+
+		assert.Equal(t, gedcom.CharacterSetANSEL, decoder.Document().CharacterSet)
+		assert.Equal(t, []string{
+			"0 HEAD\n1 CHAR ANSEL\n",
+			"0 @I1@ INDI\n1 NAME José /Smith/\n",
+			"0 @F1@ FAM\n1 HUSB @I1@\n1 WIFE @I2@\n",
+			"0 @I2@ INDI\n1 NAME Jane\n",
+		}, readAll(decoder))
+		assert.Empty(t, decoder.Document().Nodes())
+
+		_, err := decoder.NextRecord()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		decoder := gedcom.NewDecoder(strings.NewReader("\n"))
+
+		_, err := decoder.NextRecord()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		decoder := gedcom.NewDecoder(strings.NewReader("0 HEAD\nfoo\n"))
+
+		_, err := decoder.NextRecord()
+		assert.EqualError(t, err, "line 2, column 1: could not parse (UnparsableLine): foo")
+	})
+
+	t.Run("InvalidIndent", func(t *testing.T) {
+		decoder := gedcom.NewDecoder(strings.NewReader("0 HEAD\n2 NOTE foo\n"))
+
+		_, err := decoder.NextRecord()
+		if assert.IsType(t, (*gedcom.DecodeError)(nil), err) {
+			assert.Equal(t, gedcom.DecodeErrorInvalidIndent,
+				err.(*gedcom.DecodeError).Reason)
+		}
+	})
+
+	t.Run("PointersAreNotResolved", func(t *testing.T) {
+		decoder := gedcom.NewDecoder(strings.NewReader(ged))
+
+		for i := 0; i < 3; i++ {
+			_, err := decoder.NextRecord()
+			assert.NoError(t, err)
+		}
+
+		assert.Nil(t, decoder.Document().NodeByPointer("I1"))
+	})
+
+	t.Run("ResolvePointers", func(t *testing.T) {
+		decoder := gedcom.NewDecoder(strings.NewReader(ged))
+		decoder.ResolvePointers = true
+
+		for i := 0; i < 2; i++ {
+			_, err := decoder.NextRecord()
+			assert.NoError(t, err)
+		}
+
+		record, err := decoder.NextRecord()
+		assert.NoError(t, err)
+
+		family := record.(*gedcom.FamilyNode)
+		assert.Equal(t, "José Smith", family.Husband().Individual().Name().String())
+
+		// The wife has not been read yet.
+		assert.Equal(t, "Jane", family.Wife().Individual().Name().String())
+		assert.Nil(t, decoder.Document().NodeByPointer("I3"))
+
+		// The records that were read ahead are still returned.
+		assert.Equal(t, []string{"0 @I2@ INDI\n1 NAME Jane\n"}, readAll(decoder))
+	})
+}
+
 func trimSpaces(s string) string {
 	s = strings.TrimSpace(s)
 	s = strings.Replace(s, "\r", "\n", -1)
@@ -444,11 +661,14 @@ func TestNewNode(t *testing.T) {
 		tag      gedcom.Tag
 		expected gedcom.Node
 	}{
+		{gedcom.TagAnnulment, gedcom.NewAnnulmentNode(v)},
 		{gedcom.TagBaptism, gedcom.NewBaptismNode(v)},
 		{gedcom.TagBirth, gedcom.NewBirthNode(v)},
 		{gedcom.TagBurial, gedcom.NewBurialNode(v)},
 		{gedcom.TagDate, gedcom.NewDateNode(v)},
 		{gedcom.TagDeath, gedcom.NewDeathNode(v)},
+		{gedcom.TagDivorce, gedcom.NewDivorceNode(v)},
+		{gedcom.TagEngagement, gedcom.NewEngagementNode(v)},
 		{gedcom.TagEvent, gedcom.NewEventNode(v)},
 		{gedcom.UnofficialTagFamilySearchID1, gedcom.NewFamilySearchIDNode(gedcom.UnofficialTagFamilySearchID1, v)},
 		{gedcom.UnofficialTagFamilySearchID2, gedcom.NewFamilySearchIDNode(gedcom.UnofficialTagFamilySearchID2, v)},
@@ -456,14 +676,18 @@ func TestNewNode(t *testing.T) {
 		{gedcom.TagLatitude, gedcom.NewLatitudeNode(v)},
 		{gedcom.TagLongitude, gedcom.NewLongitudeNode(v)},
 		{gedcom.TagMap, gedcom.NewMapNode(v)},
+		{gedcom.TagMarriage, gedcom.NewMarriageNode(v)},
 		{gedcom.TagName, gedcom.NewNameNode(v)},
 		{gedcom.TagNote, gedcom.NewNoteNode(v)},
 		{gedcom.TagNickname, gedcom.NewNicknameNode(v)},
+		{gedcom.TagObject, gedcom.NewMultimediaNode(v, p)},
 		{gedcom.TagPhonetic, gedcom.NewPhoneticVariationNode(v)},
 		{gedcom.TagPlace, gedcom.NewPlaceNode(v)},
+		{gedcom.TagRepository, gedcom.NewRepositoryNode(v, p)},
 		{gedcom.TagResidence, gedcom.NewResidenceNode(v)},
 		{gedcom.TagRomanized, gedcom.NewRomanizedVariationNode(v)},
 		{gedcom.TagSource, gedcom.NewSourceNode(v, p)},
+		{gedcom.TagSubmitter, gedcom.NewSubmitterNode(v, p)},
 		{gedcom.TagType, gedcom.NewTypeNode(v)},
 		{gedcom.TagVersion, gedcom.NewNode(gedcom.TagVersion, v, p)},
 		{gedcom.UnofficialTagUniqueID, gedcom.NewUniqueIDNode(v)},
@@ -0,0 +1,58 @@
+package gedcom
+
+// DivorceNode is the event of dissolving a marriage through civil action.
+type DivorceNode struct {
+	*SimpleNode
+}
+
+// NewDivorceNode creates a new DIV node.
+func NewDivorceNode(value string, children ...Node) *DivorceNode {
+	return &DivorceNode{
+		newSimpleNode(TagDivorce, value, "", children...),
+	}
+}
+
+// Dates returns zero or more dates associated with the divorce.
+//
+// When more than one date is returned you should not assume that the order has
+// any significance for the importance of the dates.
+//
+// If the node is nil the result will also be nil.
+func (node *DivorceNode) Dates() DateNodes {
+	return Dates(node)
+}
+
+// Places returns zero or more places associated with the divorce.
+//
+// If the node is nil the result will also be nil.
+func (node *DivorceNode) Places() []*PlaceNode {
+	return Places(node)
+}
+
+// Equal will always return true if both nodes are not nil.
+//
+// If either node is nil (including both) or if the right side is not a
+// DivorceNode then false is always returned. Otherwise Equals will always return
+// true.
+//
+// The reason Equals always returns true is because Equals is a shallow test and
+// a family is expected to only have one divorce event. Therefore it is safe to
+// assume that divorce events themselves are equal, even if the children they
+// contain are not.
+//
+// This logic is especially important for CompareNodes.
+func (node *DivorceNode) Equals(node2 Node) bool {
+	if IsNil(node) {
+		return false
+	}
+
+	if IsNil(node2) {
+		return false
+	}
+
+	if _, ok := node2.(*DivorceNode); !ok {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,68 @@
+package gedcom_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDivorceNode(t *testing.T) {
+	child := gedcom.NewDateNode("")
+	node := gedcom.NewDivorceNode("foo", child)
+
+	assert.Equal(t, gedcom.TagDivorce, node.Tag())
+	assert.Equal(t, gedcom.Nodes{child}, node.Nodes())
+	assert.Equal(t, "foo", node.Value())
+	assert.Equal(t, "", node.Pointer())
+}
+
+func TestDivorceNode_Dates(t *testing.T) {
+	Dates := tf.NamedFunction(t, "DivorceNode_Dates", (*gedcom.DivorceNode).Dates)
+
+	Dates((*gedcom.DivorceNode)(nil)).Returns([]*gedcom.DateNode(nil))
+
+	Dates(gedcom.NewDivorceNode("")).Returns([]*gedcom.DateNode(nil))
+
+	Dates(gedcom.NewDivorceNode("",
+		gedcom.NewDateNode("3 Sep 2001"),
+		gedcom.NewPlaceNode("Sydney"),
+	)).Returns([]*gedcom.DateNode{
+		gedcom.NewDateNode("3 Sep 2001"),
+	})
+}
+
+func TestDivorceNode_Places(t *testing.T) {
+	Places := tf.NamedFunction(t, "DivorceNode_Places", (*gedcom.DivorceNode).Places)
+
+	Places((*gedcom.DivorceNode)(nil)).Returns([]*gedcom.PlaceNode(nil))
+
+	Places(gedcom.NewDivorceNode("")).Returns([]*gedcom.PlaceNode(nil))
+
+	Places(gedcom.NewDivorceNode("",
+		gedcom.NewDateNode("3 Sep 2001"),
+		gedcom.NewPlaceNode("Sydney"),
+	)).Returns([]*gedcom.PlaceNode{
+		gedcom.NewPlaceNode("Sydney"),
+	})
+}
+
+func TestDivorceNode_Equals(t *testing.T) {
+	Equals := tf.Function(t, (*gedcom.DivorceNode).Equals)
+
+	n1 := gedcom.NewDivorceNode("foo")
+	n2 := gedcom.NewDivorceNode("bar")
+
+	// nils
+	Equals((*gedcom.DivorceNode)(nil), (*gedcom.DivorceNode)(nil)).Returns(false)
+	Equals(n1, (*gedcom.DivorceNode)(nil)).Returns(false)
+	Equals((*gedcom.DivorceNode)(nil), n1).Returns(false)
+
+	// Wrong node type.
+	Equals(n1, gedcom.NewNameNode("foo")).Returns(false)
+
+	// All other cases are success.
+	Equals(n1, n1).Returns(true)
+	Equals(n1, n2).Returns(true)
+}
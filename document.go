@@ -51,6 +51,34 @@ type Document struct {
 	// Also see Decoder.consumeOptionalBOM().
 	HasBOM bool
 
+	// CharacterSet is the character set that the document was decoded from.
+	// It will be empty for documents that were not created by a Decoder or
+	// when the character set could not be determined.
+	//
+	// The values of all nodes are always UTF-8, regardless of the
+	// CharacterSet. The Encoder will use the CharacterSet when writing the
+	// document unless Encoder.CharacterSet is set.
+	//
+	// Also see Decoder.detectCharacterSet().
+	CharacterSet CharacterSet
+
+	// Version is the GEDCOM version that the document was decoded from. It
+	// will be empty if the version is unknown, which should be treated as
+	// GEDCOM 5.5.1.
+	//
+	// The Encoder can convert the document into another version. See
+	// GEDCOMVersion.
+	Version GEDCOMVersion
+
+	// LineEnding is used by the Encoder to end each line. It is detected by
+	// the Decoder when PreserveFormatting is enabled. Otherwise it is empty
+	// and "\n" is used.
+	LineEnding string
+
+	// DateLocales are used to understand DATE values that are not written in
+	// English. See Decoder.DateLocales.
+	DateLocales []*DateLocale
+
 	// MaxLivingAge is used by Individual.IsLiving to determine if an individual
 	// without a DeathNode should be considered living.
 	//
@@ -65,6 +93,10 @@ type Document struct {
 	pointerCache sync.Map // map[string]Node
 
 	families FamilyNodes
+
+	// resolvePointer is used by NodeByPointer when the pointer is not in the
+	// cache. It is set by Decoder.ResolvePointers.
+	resolvePointer func(pointer string) Node
 }
 
 // String will render the entire GEDCOM document.
@@ -125,6 +157,10 @@ func (doc *Document) NodeByPointer(ptr string) Node {
 	node, ok := doc.pointerCache.Load(ptr)
 
 	if !ok {
+		if doc.resolvePointer != nil {
+			return doc.resolvePointer(ptr)
+		}
+
 		return nil
 	}
 
@@ -175,6 +211,19 @@ func extractPlaces(n Node, dest map[*PlaceNode]Node) {
 	}
 }
 
+// Header returns the HEAD record of the document.
+//
+// If the document does not have a HEAD the result will be nil.
+func (doc *Document) Header() *HeaderNode {
+	for _, node := range doc.Nodes() {
+		if n, ok := node.(*HeaderNode); ok {
+			return n
+		}
+	}
+
+	return nil
+}
+
 // TODO: Needs tests
 func (doc *Document) Sources() []*SourceNode {
 	sources := []*SourceNode{}
@@ -188,6 +237,72 @@ func (doc *Document) Sources() []*SourceNode {
 	return sources
 }
 
+// Repositories returns the REPO records in the document.
+func (doc *Document) Repositories() []*RepositoryNode {
+	repositories := []*RepositoryNode{}
+
+	for _, node := range doc.Nodes() {
+		if n, ok := node.(*RepositoryNode); ok {
+			repositories = append(repositories, n)
+		}
+	}
+
+	return repositories
+}
+
+// Submitters returns the SUBM records in the document.
+func (doc *Document) Submitters() []*SubmitterNode {
+	submitters := []*SubmitterNode{}
+
+	for _, node := range doc.Nodes() {
+		if n, ok := node.(*SubmitterNode); ok {
+			submitters = append(submitters, n)
+		}
+	}
+
+	return submitters
+}
+
+// Multimedia returns the OBJE records in the document.
+func (doc *Document) Multimedia() []*MultimediaNode {
+	multimedia := []*MultimediaNode{}
+
+	for _, node := range doc.Nodes() {
+		if n, ok := node.(*MultimediaNode); ok {
+			multimedia = append(multimedia, n)
+		}
+	}
+
+	return multimedia
+}
+
+// Notes returns the shared NOTE records in the document. These are the notes
+// that can be referenced by a pointer from other records.
+func (doc *Document) Notes() []*NoteNode {
+	notes := []*NoteNode{}
+
+	for _, node := range doc.Nodes() {
+		if n, ok := node.(*NoteNode); ok {
+			notes = append(notes, n)
+		}
+	}
+
+	return notes
+}
+
+// SharedNotes returns the GEDCOM 7.0 SNOTE records in the document.
+func (doc *Document) SharedNotes() []*SharedNoteNode {
+	notes := []*SharedNoteNode{}
+
+	for _, node := range doc.Nodes() {
+		if n, ok := node.(*SharedNoteNode); ok {
+			notes = append(notes, n)
+		}
+	}
+
+	return notes
+}
+
 // AddNode appends a node to the document.
 //
 // If the node is nil this function has no effect.
@@ -339,30 +454,40 @@ func (doc *Document) DeleteNode(node Node) (didDelete bool) {
 }
 
 func (doc *Document) Warnings() (warnings Warnings) {
+	for _, node := range doc.nodes {
+		warnings = append(warnings, doc.RecordWarnings(node)...)
+	}
+
+	// Cycles cannot be found by looking at a single record.
+	warnings = append(warnings, doc.cyclicAncestryWarnings()...)
+
+	return
+}
+
+// RecordWarnings returns the warnings for a single root node (record) and all
+// of its children. It can be used with Decoder.NextRecord to produce warnings
+// without decoding the whole document.
+func (doc *Document) RecordWarnings(record Node) (warnings Warnings) {
 	context := WarningContext{}
 
-	for _, node := range doc.nodes {
-		if individual, ok := node.(*IndividualNode); ok {
-			context.Individual = individual
-			context.Family = nil
-		}
+	switch n := record.(type) {
+	case *IndividualNode:
+		context.Individual = n
 
-		if family, ok := node.(*FamilyNode); ok {
-			context.Individual = nil
-			context.Family = family
-		}
+	case *FamilyNode:
+		context.Family = n
+	}
 
-		Filter(node, doc, func(node Node) (newNode Node, traverseChildren bool) {
-			if warner, ok := node.(Warner); ok {
-				for _, warning := range warner.Warnings() {
-					warning.SetContext(context)
-					warnings = append(warnings, warning)
-				}
+	Filter(record, doc, func(node Node) (newNode Node, traverseChildren bool) {
+		if warner, ok := node.(Warner); ok {
+			for _, warning := range warner.Warnings() {
+				warning.SetContext(context)
+				warnings = append(warnings, warning)
 			}
+		}
 
-			return node, true
-		})
-	}
+		return node, true
+	})
 
 	return
 }
@@ -0,0 +1,101 @@
+package gedcom
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DocumentBuilder creates a Document one node at a time, in the same order
+// that the lines would appear in a GEDCOM file. It is used to import other
+// formats (see the gramps and gedcomx packages) so that the nodes are the same
+// types, and linked in the same way, as a decoded GEDCOM file.
+//
+// Unlike writing GEDCOM text and decoding it, the values are never parsed
+// again so they do not need to be escaped or split by the caller.
+//
+// Add and AddPointer do not return errors. Instead, the first error is
+// returned by Document and any nodes after the error are ignored.
+type DocumentBuilder struct {
+	document *Document
+	indents  Nodes
+	family   *FamilyNode
+	err      error
+}
+
+// NewDocumentBuilder adds the nodes to document. The document should be empty.
+func NewDocumentBuilder(document *Document) *DocumentBuilder {
+	return &DocumentBuilder{
+		document: document,
+	}
+}
+
+// Add adds a node that is a child of the last node that was added with an
+// indent of one less. An indent of 0 is a new record.
+//
+// The value is text. Each "@" is escaped as "@@" (except for calendar escapes
+// in dates) and any line ending, including a lone "\r", becomes a new line.
+// Use AddPointer for a value that points to another record.
+func (builder *DocumentBuilder) Add(indent int, pointer string, tag Tag, value string) {
+	value = strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(value)
+
+	builder.add(indent, pointer, tag, escapeValue(value))
+}
+
+// AddPointer adds a node with a value that points to another record, like the
+// HUSB of a family. The pointer does not include the "@", like "I1".
+func (builder *DocumentBuilder) AddPointer(indent int, tag Tag, pointer string) {
+	if !IsValidPointer(pointer) {
+		builder.fail("invalid pointer for %s: %q", tag.Tag(), pointer)
+		return
+	}
+
+	builder.add(indent, "", tag, "@"+pointer+"@")
+}
+
+func (builder *DocumentBuilder) add(indent int, pointer string, tag Tag, value string) {
+	switch {
+	case builder.err != nil:
+		return
+
+	case pointer != "" && !IsValidPointer(pointer):
+		builder.fail("invalid pointer for %s: %q", tag.Tag(), pointer)
+		return
+
+	case indent < 0 || indent > len(builder.indents):
+		builder.fail("invalid indent for %s: %d", tag.Tag(), indent)
+		return
+	}
+
+	node := newNode(builder.document, builder.family, tag, value, pointer)
+
+	// Families cannot be nested so any children that appear after this node
+	// belong to the most recently seen family. This is the same as the
+	// Decoder.
+	if family, ok := node.(*FamilyNode); ok {
+		builder.family = family
+	}
+
+	if indent == 0 {
+		builder.document.AddNode(node)
+	} else {
+		builder.indents[indent-1].AddNode(node)
+	}
+
+	builder.indents = append(builder.indents[:indent], node)
+}
+
+// fail records the error unless there is already an error.
+func (builder *DocumentBuilder) fail(format string, args ...interface{}) {
+	if builder.err == nil {
+		builder.err = fmt.Errorf(format, args...)
+	}
+}
+
+// Document returns the document and the first error, if any.
+func (builder *DocumentBuilder) Document() (*Document, error) {
+	if builder.err != nil {
+		return nil, builder.err
+	}
+
+	return builder.document, nil
+}
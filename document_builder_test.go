@@ -0,0 +1,63 @@
+package gedcom_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentBuilder_Document(t *testing.T) {
+	builder := gedcom.NewDocumentBuilder(gedcom.NewDocument())
+	builder.Add(0, "I1", gedcom.TagIndividual, "")
+	builder.Add(1, "", gedcom.TagName, "@John@ /Smith/")
+	builder.Add(1, "", gedcom.TagBirth, "")
+	builder.Add(2, "", gedcom.TagDate, "@#DJULIAN@ 1 JAN 1700")
+	builder.AddPointer(1, gedcom.TagFamilySpouse, "F1")
+	builder.Add(1, "", gedcom.TagNote, "first\rsecond\r\nthird")
+	builder.Add(0, "F1", gedcom.TagFamily, "")
+	builder.AddPointer(1, gedcom.TagHusband, "I1")
+
+	document, err := builder.Document()
+	require.NoError(t, err)
+
+	assert.Equal(t, `0 @I1@ INDI
+1 NAME @@John@@ /Smith/
+1 BIRT
+2 DATE @#DJULIAN@ 1 JAN 1700
+1 FAMS @F1@
+1 NOTE first
+2 CONT second
+2 CONT third
+0 @F1@ FAM
+1 HUSB @I1@
+`, document.String())
+
+	john := document.Individuals().ByPointer("I1")
+	assert.Equal(t, john, document.Families()[0].Husband().Individual())
+}
+
+func TestDocumentBuilder_DocumentErrors(t *testing.T) {
+	for name, add := range map[string]func(builder *gedcom.DocumentBuilder){
+		"InvalidPointer": func(builder *gedcom.DocumentBuilder) {
+			builder.Add(0, "I2 x", gedcom.TagIndividual, "")
+		},
+		"InvalidPointerValue": func(builder *gedcom.DocumentBuilder) {
+			builder.Add(0, "I1", gedcom.TagIndividual, "")
+			builder.AddPointer(1, gedcom.TagFamilySpouse, "F@1")
+		},
+		"InvalidIndent": func(builder *gedcom.DocumentBuilder) {
+			builder.Add(0, "I1", gedcom.TagIndividual, "")
+			builder.Add(2, "", gedcom.TagName, "John")
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			builder := gedcom.NewDocumentBuilder(gedcom.NewDocument())
+			add(builder)
+
+			_, err := builder.Document()
+			assert.Error(t, err)
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package gedcom_test
 
 import (
-	"errors"
 	"testing"
 
 	"fmt"
@@ -120,6 +119,39 @@ func TestDocument_Families(t *testing.T) {
 	}
 }
 
+func TestDocument_Records(t *testing.T) {
+	doc, err := gedcom.NewDocumentFromString(`0 HEAD
+1 SUBM @U1@
+0 @R1@ REPO
+0 @U1@ SUBM
+0 @M1@ OBJE
+0 @N1@ NOTE Shared
+0 @I1@ INDI
+1 NOTE Not shared
+1 OBJE @M1@
+0 @R2@ REPO`)
+	assert.NoError(t, err)
+
+	nodes := doc.Nodes()
+
+	assert.Equal(t, []*gedcom.RepositoryNode{
+		nodes[1].(*gedcom.RepositoryNode),
+		nodes[6].(*gedcom.RepositoryNode),
+	}, doc.Repositories())
+	assert.Equal(t, []*gedcom.SubmitterNode{nodes[2].(*gedcom.SubmitterNode)},
+		doc.Submitters())
+	assert.Equal(t, []*gedcom.MultimediaNode{nodes[3].(*gedcom.MultimediaNode)},
+		doc.Multimedia())
+	assert.Equal(t, []*gedcom.NoteNode{nodes[4].(*gedcom.NoteNode)},
+		doc.Notes())
+
+	empty := gedcom.NewDocument()
+	assert.Empty(t, empty.Repositories())
+	assert.Empty(t, empty.Submitters())
+	assert.Empty(t, empty.Multimedia())
+	assert.Empty(t, empty.Notes())
+}
+
 func TestNewDocumentFromString(t *testing.T) {
 	for _, test := range []struct {
 		ged      string
@@ -134,17 +166,32 @@ func TestNewDocumentFromString(t *testing.T) {
 		{
 			"AAA",
 			nil,
-			errors.New("line 1: could not parse: AAA"),
+			&gedcom.DecodeError{
+				Line:   1,
+				Column: 1,
+				Text:   "AAA",
+				Reason: gedcom.DecodeErrorUnparsableLine,
+			},
 		},
 		{
 			"0 INDI\nAAB",
 			nil,
-			errors.New("line 2: could not parse: AAB"),
+			&gedcom.DecodeError{
+				Line:   2,
+				Column: 1,
+				Text:   "AAB",
+				Reason: gedcom.DecodeErrorUnparsableLine,
+			},
 		},
 		{
 			"0 INDI\n\nAAA",
 			nil,
-			errors.New("line 3: could not parse: AAA"),
+			&gedcom.DecodeError{
+				Line:   3,
+				Column: 1,
+				Text:   "AAA",
+				Reason: gedcom.DecodeErrorUnparsableLine,
+			},
 		},
 	} {
 		t.Run(test.ged, func(t *testing.T) {
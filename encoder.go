@@ -16,6 +16,9 @@ package gedcom
 
 import (
 	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 // Encoder represents a GEDCOM encoder.
@@ -23,30 +26,95 @@ type Encoder struct {
 	w           io.Writer
 	document    *Document
 	startIndent int
+
+	// output is the document that is being written. It will be different from
+	// document when the document needs to be converted to another Version.
+	output *Document
+
+	// MaxLineLength is the maximum number of characters for each line,
+	// including the indent, pointer and tag. Values that are longer will be
+	// split with CONC nodes. Values that contain new lines are always split
+	// with CONT nodes.
+	//
+	// NewEncoder will use DefaultMaxLineLength. If MaxLineLength is zero or
+	// less then values will only be split on new lines.
+	MaxLineLength int
+
+	// CharacterSet is the character set to write. If it is empty the
+	// Document.CharacterSet is used instead. If that is also empty then the
+	// values are written as they are (UTF-8).
+	//
+	// The CHAR in the HEAD will be rewritten (or added) to match the character
+	// set. The Document itself is not modified.
+	//
+	// GEDCOM 7.0 is always written as UTF-8 without a CHAR.
+	CharacterSet CharacterSet
+
+	// Version is the GEDCOM version to write. If it is empty, or the same as
+	// the Document.Version, the document is written as it is. Otherwise the
+	// document is converted with ConvertDocument first.
+	Version GEDCOMVersion
+
+	// RegenerateHeader will replace the SOUR, DATE and TIME of the HEAD with
+	// the SourceSystem and the Timestamp. The other nodes of the HEAD are
+	// retained. A HEAD will be added if the document does not have one.
+	//
+	// The Document itself is not modified.
+	RegenerateHeader bool
+
+	// SourceSystem is written to the HEAD when RegenerateHeader is enabled. If
+	// it is empty then DefaultSourceSystem is used.
+	SourceSystem SourceSystem
+
+	// Timestamp is written to the HEAD when RegenerateHeader is enabled. If
+	// it is zero then the current time is used.
+	Timestamp time.Time
+
+	// missingLineEnding is set when the original bytes of the previous node
+	// did not end with a line ending. See Encoder.write.
+	missingLineEnding bool
+
+	// ConversionWarnings will contain any information that could not be
+	// converted without loss when the Version is different from the
+	// Document.Version. It is set by Encode.
+	ConversionWarnings Warnings
 }
 
 // Create a new encoder to generate GEDCOM data.
 func NewEncoder(w io.Writer, document *Document) *Encoder {
 	return &Encoder{
-		w:        w,
-		document: document,
+		w:             w,
+		document:      document,
+		MaxLineLength: DefaultMaxLineLength,
 	}
 }
 
 func (enc *Encoder) renderNode(indent int, node Node) error {
-	gedcomLine := node.GEDCOMLine(indent) + "\n"
-	_, err := enc.w.Write([]byte(gedcomLine))
-	if err != nil {
-		return err
+	nextIndent := indent + 1
+	if indent == NoIndent {
+		nextIndent = NoIndent
 	}
 
-	for _, child := range node.Nodes() {
-		nextIndent := indent + 1
-		if indent == NoIndent {
-			nextIndent = NoIndent
+	if source := enc.source(indent, node); source != nil {
+		err := enc.write(source)
+		if err != nil {
+			return err
+		}
+
+		raw := node.RawSimpleNode().source.raw
+		last := raw[len(raw)-1]
+		enc.missingLineEnding = last != '\n' && last != '\r'
+	} else {
+		for _, line := range enc.lines(indent, nextIndent, node) {
+			err := enc.write(enc.characterSet().encodeLine(line + enc.lineEnding()))
+			if err != nil {
+				return err
+			}
 		}
+	}
 
-		err = enc.renderNode(nextIndent, child)
+	for _, child := range node.Nodes() {
+		err := enc.renderNode(nextIndent, child)
 		if err != nil {
 			return err
 		}
@@ -55,11 +123,84 @@ func (enc *Encoder) renderNode(indent int, node Node) error {
 	return nil
 }
 
+// lines returns the GEDCOM line for the node, followed by any CONC or CONT
+// lines needed to represent the value.
+func (enc *Encoder) lines(indent, nextIndent int, node Node) []string {
+	value := node.Value()
+
+	// GEDCOM 7.0 does not have CONC or a maximum line length.
+	maxLineLength := enc.MaxLineLength
+	isGEDCOM70 := enc.output.Version == GEDCOMVersion70
+	if isGEDCOM70 {
+		maxLineLength = 0
+	}
+
+	// Retain the original split points if the value has not been modified.
+	// This must be checked first because a short value may have been split
+	// with CONC.
+	c := node.RawSimpleNode().continuation
+	if c != nil && c.value() == value && !(isGEDCOM70 && c.hasConcatenation()) {
+		return enc.continuationLines(indent, nextIndent, node, c.lines)
+	}
+
+	// This is by far the most common case.
+	gedcomLine := node.GEDCOMLine(indent)
+	if !strings.Contains(value, "\n") && (maxLineLength <= 0 ||
+		utf8.RuneCountInString(gedcomLine) <= maxLineLength) {
+		return []string{gedcomLine}
+	}
+
+	firstPrefix := strings.TrimSuffix(gedcomLine, value)
+	contPrefix := newSimpleNode(TagConcatenation, "", "").GEDCOMLine(nextIndent) + " "
+
+	continuationLines := splitValue(value,
+		utf8.RuneCountInString(firstPrefix),
+		utf8.RuneCountInString(contPrefix), maxLineLength)
+
+	return enc.continuationLines(indent, nextIndent, node, continuationLines)
+}
+
+// continuationLines formats the first line of the node followed by the CONC
+// and CONT lines.
+func (enc *Encoder) continuationLines(indent, nextIndent int, node Node, continuationLines []continuationLine) []string {
+	var lines []string
+
+	for _, line := range continuationLines {
+		if line.tag == (Tag{}) {
+			lines = append(lines, newSimpleNode(node.Tag(), line.value,
+				node.Pointer()).GEDCOMLine(indent))
+			continue
+		}
+
+		lines = append(lines, newSimpleNode(line.tag, line.value, "").
+			GEDCOMLine(nextIndent))
+	}
+
+	return lines
+}
+
 // Encode will write the GEDCOM document to the Writer.
 func (enc *Encoder) Encode() (err error) {
+	enc.output = enc.document
+	enc.ConversionWarnings = nil
+	enc.missingLineEnding = false
+
+	if enc.Version != "" &&
+		enc.Version.orDefault() != enc.document.Version.orDefault() {
+		enc.output, enc.ConversionWarnings = ConvertDocument(enc.document,
+			enc.Version)
+	}
+
 	err = enc.restoreOptionalBOM()
+	if err != nil {
+		return
+	}
+
+	for _, node := range enc.nodes() {
+		if node.Tag() == TagHeader {
+			node = enc.headerWithCharacterSet(node)
+		}
 
-	for _, node := range enc.document.Nodes() {
 		err = enc.renderNode(enc.startIndent, node)
 		if err != nil {
 			return
@@ -69,11 +210,108 @@ func (enc *Encoder) Encode() (err error) {
 	return
 }
 
+// nodes returns the root nodes to be written. The HEAD is replaced when
+// RegenerateHeader is enabled.
+func (enc *Encoder) nodes() Nodes {
+	nodes := enc.output.Nodes()
+	if !enc.RegenerateHeader {
+		return nodes
+	}
+
+	source := enc.SourceSystem
+	if source == (SourceSystem{}) {
+		source = DefaultSourceSystem
+	}
+
+	timestamp := enc.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	header := enc.output.Header()
+	newHeader := header.regenerated(source, timestamp, enc.output.Version)
+
+	if header == nil {
+		return append(Nodes{newHeader}, nodes...)
+	}
+
+	newNodes := make(Nodes, len(nodes))
+	for i, node := range nodes {
+		if node == header {
+			node = newHeader
+		}
+
+		newNodes[i] = node
+	}
+
+	return newNodes
+}
+
 // See Decoder.consumeOptionalBOM for more information.
+//
+// UTF-16 will always have a BOM. ANSEL and ASCII will never have a BOM.
 func (enc *Encoder) restoreOptionalBOM() (err error) {
-	if enc.document.HasBOM {
-		_, err = enc.w.Write(byteOrderMark)
+	switch enc.characterSet() {
+	case CharacterSetUnicode:
+		_, err = enc.w.Write(byteOrderMarkUTF16LE)
+
+	case "", CharacterSetUTF8:
+		if enc.output.HasBOM {
+			_, err = enc.w.Write(byteOrderMark)
+		}
 	}
 
 	return
 }
+
+func (enc *Encoder) characterSet() CharacterSet {
+	switch {
+	case enc.output.Version == GEDCOMVersion70:
+		return CharacterSetUTF8
+
+	case enc.CharacterSet != "":
+		return enc.CharacterSet
+	}
+
+	return enc.output.CharacterSet
+}
+
+// headerWithCharacterSet returns a copy of the HEAD node where the CHAR
+// matches the character set being written. The original node is returned if
+// no change is needed.
+func (enc *Encoder) headerWithCharacterSet(header Node) Node {
+	charset := enc.characterSet()
+	if charset == "" || enc.output.Version == GEDCOMVersion70 {
+		return header
+	}
+
+	children := Nodes{}
+	found, changed := false, false
+
+	for _, child := range header.Nodes() {
+		if child.Tag() == TagCharacterSet {
+			found = true
+
+			if CharacterSetFromString(child.Value()) != charset {
+				child = NewNode(TagCharacterSet, charset.String(), "",
+					child.Nodes()...)
+				changed = true
+			}
+		}
+
+		children = append(children, child)
+	}
+
+	if found && !changed {
+		return header
+	}
+
+	if !found {
+		children = append(children, NewNode(TagCharacterSet, charset.String(), ""))
+	}
+
+	newHeader := header.ShallowCopy()
+	newHeader.SetNodes(children)
+
+	return newHeader
+}
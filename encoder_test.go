@@ -0,0 +1,202 @@
+package gedcom_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncoder_Encode(t *testing.T) {
+	for testName, test := range map[string]struct {
+		node          gedcom.Node
+		maxLineLength int
+		expected      string
+	}{
+		"ShortValue": {
+			gedcom.NewNoteNode("foo bar"),
+			gedcom.DefaultMaxLineLength,
+			"0 NOTE foo bar\n",
+		},
+		"NewLines": {
+			gedcom.NewNoteNode("foo\nbar\n\nbaz"),
+			gedcom.DefaultMaxLineLength,
+			"0 NOTE foo\n1 CONT bar\n1 CONT\n1 CONT baz\n",
+		},
+		"LeadingNewLine": {
+			gedcom.NewNoteNode("\nfoo"),
+			gedcom.DefaultMaxLineLength,
+			"0 NOTE\n1 CONT foo\n",
+		},
+		"LongValue": {
+			gedcom.NewNoteNode("abcdefghijklmnop"),
+			12,
+			"0 NOTE abcde\n1 CONC fghij\n1 CONC klmno\n1 CONC p\n",
+		},
+		"DoNotSplitOnSpaces": {
+			gedcom.NewNoteNode("abc defghi jk"),
+			12,
+			"0 NOTE abc d\n1 CONC efgh\n1 CONC i jk\n",
+		},
+		"LongValueAndNewLines": {
+			gedcom.NewNoteNode("abcdefghij\nklmnopqrs"),
+			12,
+			"0 NOTE abcde\n1 CONC fghij\n1 CONT klmno\n1 CONC pqrs\n",
+		},
+		"Pointer": {
+			gedcom.NewNode(gedcom.TagNote, "abcdefghij", "N1"),
+			15,
+			"0 @N1@ NOTE abc\n1 CONC defghij\n",
+		},
+		"MultiByteCharacters": {
+			gedcom.NewNoteNode("κόσμεκόσμε"),
+			12,
+			"0 NOTE κόσμε\n1 CONC κόσμε\n",
+		},
+		"NoLimit": {
+			gedcom.NewNoteNode(strings.Repeat("a", 300) + "\nb"),
+			0,
+			"0 NOTE " + strings.Repeat("a", 300) + "\n1 CONT b\n",
+		},
+		"Children": {
+			gedcom.NewNoteNode("foo\nbar", gedcom.NewNoteNode("baz\nqux")),
+			gedcom.DefaultMaxLineLength,
+			"0 NOTE foo\n1 CONT bar\n1 NOTE baz\n2 CONT qux\n",
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			buf := bytes.NewBufferString("")
+			doc := gedcom.NewDocumentWithNodes(gedcom.Nodes{test.node})
+
+			encoder := gedcom.NewEncoder(buf, doc)
+			encoder.MaxLineLength = test.maxLineLength
+
+			assert.NoError(t, encoder.Encode())
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
+
+func TestEncoder_EncodeRoundTrip(t *testing.T) {
+	t.Run("OriginalSplitPointsAreRetained", func(t *testing.T) {
+		ged := "0 NOTE This\n1 CONC  is a\n1 CONC  note\n1 CONT that is\n1 CONC  split\n"
+		doc, err := gedcom.NewDocumentFromString(ged)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "This is a note\nthat is split", doc.Nodes()[0].Value())
+		assert.Equal(t, ged, doc.String())
+	})
+
+	t.Run("ShortConcatenatedValue", func(t *testing.T) {
+		ged := "0 NOTE abc\n1 CONC def\n"
+		doc, err := gedcom.NewDocumentFromString(ged)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "abcdef", doc.Nodes()[0].Value())
+		assert.Equal(t, ged, doc.String())
+	})
+
+	t.Run("EmptyContinuationLines", func(t *testing.T) {
+		for _, ged := range []string{
+			"0 @N1@ NOTE\n1 CONT line2\n",
+			"0 @N1@ NOTE line1\n1 CONT\n",
+			"0 @N1@ NOTE line1\n1 CONT\n1 CONT line3\n",
+		} {
+			doc, err := gedcom.NewDocumentFromString(ged)
+
+			assert.NoError(t, err, ged)
+			assert.Equal(t, ged, doc.String())
+
+			// The value must also survive being written without the original
+			// split points.
+			value := doc.Nodes()[0].Value()
+			doc = gedcom.NewDocumentWithNodes(gedcom.Nodes{
+				gedcom.NewNoteNode(value),
+			})
+			doc, err = gedcom.NewDocumentFromString(doc.String())
+
+			assert.NoError(t, err, ged)
+			assert.Equal(t, value, doc.Nodes()[0].Value(), ged)
+		}
+	})
+
+	t.Run("ModifiedValuesAreSplitAgain", func(t *testing.T) {
+		ged := "0 NOTE This\n1 CONC  is a\n1 CONC  note\n1 CONT that is\n1 CONC  split\n"
+		doc, err := gedcom.NewDocumentFromString(ged)
+		assert.NoError(t, err)
+
+		note := doc.Nodes()[0]
+		note.RawSimpleNode().SetNodes(nil)
+		doc = gedcom.NewDocumentWithNodes(gedcom.Nodes{
+			gedcom.NewNoteNode(note.Value() + "!"),
+		})
+
+		assert.Equal(t, "0 NOTE This is a note\n1 CONT that is split!\n", doc.String())
+	})
+}
+
+func TestEncoder_EncodeCharacterSet(t *testing.T) {
+	for testName, test := range map[string]struct {
+		ged      string
+		charset  gedcom.CharacterSet
+		expected string
+	}{
+		"ANSEL": {
+			"0 HEAD\n1 CHAR UTF-8\n0 NAME René /Müller/\n0 NAME Æsa łç\n",
+			gedcom.CharacterSetANSEL,
+			"0 HEAD\n1 CHAR ANSEL\n0 NAME Ren\xe2e /M\xe8uller/\n0 NAME \xa5sa \xb1\xf0c\n",
+		},
+		"ANSELMultipleDiacritics": {
+			"0 NAME ệ\n",
+			gedcom.CharacterSetANSEL,
+			"0 NAME \xf2\xe3e\n",
+		},
+		"ANSELUnknownCharacter": {
+			"0 NAME κ\n",
+			gedcom.CharacterSetANSEL,
+			"0 NAME ?\n",
+		},
+		"ASCII": {
+			"0 HEAD\n0 NAME René κ\n",
+			gedcom.CharacterSetASCII,
+			"0 HEAD\n1 CHAR ASCII\n0 NAME Rene ?\n",
+		},
+		"UTF-16": {
+			"0 NAME κ\n",
+			gedcom.CharacterSetUnicode,
+			"\xff\xfe0\x00 \x00N\x00A\x00M\x00E\x00 \x00\xba\x03\n\x00",
+		},
+		"UTF-8": {
+			"0 HEAD\n1 CHAR ANSEL\n2 VERS 1\n",
+			gedcom.CharacterSetUTF8,
+			"0 HEAD\n1 CHAR UTF-8\n2 VERS 1\n",
+		},
+		"UTF8IsNotRewritten": {
+			"0 HEAD\n1 CHAR UTF8\n",
+			gedcom.CharacterSetUTF8,
+			"0 HEAD\n1 CHAR UTF8\n",
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			doc, err := gedcom.NewDocumentFromString(test.ged)
+			assert.NoError(t, err)
+
+			buf := bytes.NewBufferString("")
+			encoder := gedcom.NewEncoder(buf, doc)
+			encoder.CharacterSet = test.charset
+
+			assert.NoError(t, encoder.Encode())
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+
+	t.Run("RoundTripANSEL", func(t *testing.T) {
+		ged := "0 HEAD\n1 CHAR ANSEL\n0 NAME Ren\xe2e /M\xe8uller/\n"
+		doc, err := gedcom.NewDocumentFromString(ged)
+
+		assert.NoError(t, err)
+		assert.Equal(t, ged, doc.String())
+	})
+}
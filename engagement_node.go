@@ -0,0 +1,59 @@
+package gedcom
+
+// EngagementNode is the event of recording or announcing an agreement between two
+// people to become married.
+type EngagementNode struct {
+	*SimpleNode
+}
+
+// NewEngagementNode creates a new ENGA node.
+func NewEngagementNode(value string, children ...Node) *EngagementNode {
+	return &EngagementNode{
+		newSimpleNode(TagEngagement, value, "", children...),
+	}
+}
+
+// Dates returns zero or more dates associated with the engagement.
+//
+// When more than one date is returned you should not assume that the order has
+// any significance for the importance of the dates.
+//
+// If the node is nil the result will also be nil.
+func (node *EngagementNode) Dates() DateNodes {
+	return Dates(node)
+}
+
+// Places returns zero or more places associated with the engagement.
+//
+// If the node is nil the result will also be nil.
+func (node *EngagementNode) Places() []*PlaceNode {
+	return Places(node)
+}
+
+// Equal will always return true if both nodes are not nil.
+//
+// If either node is nil (including both) or if the right side is not a
+// EngagementNode then false is always returned. Otherwise Equals will always return
+// true.
+//
+// The reason Equals always returns true is because Equals is a shallow test and
+// a family is expected to only have one engagement event. Therefore it is safe to
+// assume that engagement events themselves are equal, even if the children they
+// contain are not.
+//
+// This logic is especially important for CompareNodes.
+func (node *EngagementNode) Equals(node2 Node) bool {
+	if IsNil(node) {
+		return false
+	}
+
+	if IsNil(node2) {
+		return false
+	}
+
+	if _, ok := node2.(*EngagementNode); !ok {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,68 @@
+package gedcom_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEngagementNode(t *testing.T) {
+	child := gedcom.NewDateNode("")
+	node := gedcom.NewEngagementNode("foo", child)
+
+	assert.Equal(t, gedcom.TagEngagement, node.Tag())
+	assert.Equal(t, gedcom.Nodes{child}, node.Nodes())
+	assert.Equal(t, "foo", node.Value())
+	assert.Equal(t, "", node.Pointer())
+}
+
+func TestEngagementNode_Dates(t *testing.T) {
+	Dates := tf.NamedFunction(t, "EngagementNode_Dates", (*gedcom.EngagementNode).Dates)
+
+	Dates((*gedcom.EngagementNode)(nil)).Returns([]*gedcom.DateNode(nil))
+
+	Dates(gedcom.NewEngagementNode("")).Returns([]*gedcom.DateNode(nil))
+
+	Dates(gedcom.NewEngagementNode("",
+		gedcom.NewDateNode("3 Sep 2001"),
+		gedcom.NewPlaceNode("Sydney"),
+	)).Returns([]*gedcom.DateNode{
+		gedcom.NewDateNode("3 Sep 2001"),
+	})
+}
+
+func TestEngagementNode_Places(t *testing.T) {
+	Places := tf.NamedFunction(t, "EngagementNode_Places", (*gedcom.EngagementNode).Places)
+
+	Places((*gedcom.EngagementNode)(nil)).Returns([]*gedcom.PlaceNode(nil))
+
+	Places(gedcom.NewEngagementNode("")).Returns([]*gedcom.PlaceNode(nil))
+
+	Places(gedcom.NewEngagementNode("",
+		gedcom.NewDateNode("3 Sep 2001"),
+		gedcom.NewPlaceNode("Sydney"),
+	)).Returns([]*gedcom.PlaceNode{
+		gedcom.NewPlaceNode("Sydney"),
+	})
+}
+
+func TestEngagementNode_Equals(t *testing.T) {
+	Equals := tf.Function(t, (*gedcom.EngagementNode).Equals)
+
+	n1 := gedcom.NewEngagementNode("foo")
+	n2 := gedcom.NewEngagementNode("bar")
+
+	// nils
+	Equals((*gedcom.EngagementNode)(nil), (*gedcom.EngagementNode)(nil)).Returns(false)
+	Equals(n1, (*gedcom.EngagementNode)(nil)).Returns(false)
+	Equals((*gedcom.EngagementNode)(nil), n1).Returns(false)
+
+	// Wrong node type.
+	Equals(n1, gedcom.NewNameNode("foo")).Returns(false)
+
+	// All other cases are success.
+	Equals(n1, n1).Returns(true)
+	Equals(n1, n2).Returns(true)
+}
@@ -342,9 +342,7 @@ func (node *FamilyNode) appendMarriedOutOfRange(warnings Warnings, age Age, spou
 }
 
 func (node *FamilyNode) marriedOutOfRange() (warnings Warnings) {
-	marriages := NodesWithTag(node, TagMarriage)
-
-	for _, marriage := range marriages {
+	for _, marriage := range node.Marriages() {
 		if husband := node.Husband().Individual(); husband != nil {
 			_, maxAge := husband.AgeAt(marriage)
 			warnings = node.appendMarriedOutOfRange(warnings, maxAge, husband)
@@ -384,14 +382,74 @@ func (node *FamilyNode) Warnings() (warnings Warnings) {
 	return
 }
 
+// Marriages returns zero or more marriage events for the family.
+//
+// If the node is nil the result will be empty.
+func (node *FamilyNode) Marriages() []*MarriageNode {
+	nodes := NodesWithTag(node, TagMarriage)
+
+	return nodes.CastTo((*MarriageNode)(nil)).([]*MarriageNode)
+}
+
+// Divorces returns zero or more divorce events for the family.
+//
+// If the node is nil the result will be empty.
+func (node *FamilyNode) Divorces() []*DivorceNode {
+	nodes := NodesWithTag(node, TagDivorce)
+
+	return nodes.CastTo((*DivorceNode)(nil)).([]*DivorceNode)
+}
+
+// Engagements returns zero or more engagement events for the family.
+//
+// If the node is nil the result will be empty.
+func (node *FamilyNode) Engagements() []*EngagementNode {
+	nodes := NodesWithTag(node, TagEngagement)
+
+	return nodes.CastTo((*EngagementNode)(nil)).([]*EngagementNode)
+}
+
+// Annulments returns zero or more annulment events for the family.
+//
+// If the node is nil the result will be empty.
+func (node *FamilyNode) Annulments() []*AnnulmentNode {
+	nodes := NodesWithTag(node, TagAnnulment)
+
+	return nodes.CastTo((*AnnulmentNode)(nil)).([]*AnnulmentNode)
+}
+
+// Marriage returns the first values for the date and place of the marriage
+// events.
+func (node *FamilyNode) Marriage() (*DateNode, *PlaceNode) {
+	return DateAndPlace(Compound(node.Marriages())...)
+}
+
+// Divorce returns the first values for the date and place of the divorce
+// events.
+func (node *FamilyNode) Divorce() (*DateNode, *PlaceNode) {
+	return DateAndPlace(Compound(node.Divorces())...)
+}
+
+// Engagement returns the first values for the date and place of the
+// engagement events.
+func (node *FamilyNode) Engagement() (*DateNode, *PlaceNode) {
+	return DateAndPlace(Compound(node.Engagements())...)
+}
+
+// Annulment returns the first values for the date and place of the annulment
+// events.
+func (node *FamilyNode) Annulment() (*DateNode, *PlaceNode) {
+	return DateAndPlace(Compound(node.Annulments())...)
+}
+
 func (node *FamilyNode) String() string {
 	symbol := "—"
 
 	switch {
-	case len(NodesWithTag(node, TagDivorce)) > 0:
+	case len(node.Divorces()) > 0:
 		symbol = "⚮"
 
-	case len(NodesWithTag(node, TagMarriage)) > 0:
+	case len(node.Marriages()) > 0:
 		symbol = "⚭"
 	}
 
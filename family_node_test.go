@@ -664,4 +664,47 @@ func TestFamilyNode_Warnings(t *testing.T) {
 			assertEqual(t, f1.Warnings().Strings(), test.expected)
 		})
 	}
-}
\ No newline at end of file
+}
+func TestFamilyNode_Marriage(t *testing.T) {
+	doc, err := gedcom.NewDocumentFromString(`0 @F1@ FAM
+1 ENGA
+2 DATE 1899
+1 MARR
+2 PLAC Sydney
+1 MARR
+2 DATE 3 Sep 1900
+1 DIV
+2 DATE 1920
+2 PLAC Melbourne
+0 @F2@ FAM`)
+	assert.NoError(t, err)
+
+	families := doc.Families()
+	f1, f2 := families[0], families[1]
+
+	assert.Len(t, f1.Marriages(), 2)
+	assert.Len(t, f1.Divorces(), 1)
+	assert.Len(t, f1.Engagements(), 1)
+	assert.Len(t, f1.Annulments(), 0)
+	assert.Empty(t, (*gedcom.FamilyNode)(nil).Marriages())
+
+	date, place := f1.Marriage()
+	assert.Equal(t, "3 Sep 1900", gedcom.String(date))
+	assert.Equal(t, "Sydney", gedcom.String(place))
+
+	date, place = f1.Divorce()
+	assert.Equal(t, "1920", gedcom.String(date))
+	assert.Equal(t, "Melbourne", gedcom.String(place))
+
+	date, place = f1.Engagement()
+	assert.Equal(t, "1899", gedcom.String(date))
+	assert.Nil(t, place)
+
+	date, place = f1.Annulment()
+	assert.Nil(t, date)
+	assert.Nil(t, place)
+
+	date, place = f2.Marriage()
+	assert.Nil(t, date)
+	assert.Nil(t, place)
+}
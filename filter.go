@@ -17,7 +17,8 @@
 //   newNodes := gedcom.Filter(node, gedcom.OfficialTagFilter())
 //
 // Some examples of Filter functions include BlacklistTagFilter,
-// OfficialTagFilter, SimpleNameFilter and WhitelistTagFilter.
+// NormalizeDatesFilter, OfficialTagFilter, SimpleNameFilter and
+// WhitelistTagFilter.
 package gedcom
 
 // FilterFunction is used with the Filter function.
@@ -231,3 +232,49 @@ func RemoveDuplicateNamesFilter() FilterFunction {
 		return node, true
 	}
 }
+
+// NormalizeDatesFilter rewrites the value of every DATE node to the strict
+// form of the GEDCOM standard. See DateNode.NormalizedValue.
+//
+// Dates that cannot be normalized without losing some of their meaning are
+// not changed. Instead, an UnnormalizableDateWarning is appended to warnings
+// (if it is not nil). The context of the warning is the individual or family
+// that contains the date.
+func NormalizeDatesFilter(warnings *Warnings) FilterFunction {
+	var context WarningContext
+
+	return func(node Node) (Node, bool) {
+		switch n := node.(type) {
+		case *IndividualNode:
+			context = WarningContext{Individual: n}
+
+		case *FamilyNode:
+			context = WarningContext{Family: n}
+
+		case *DateNode:
+			value, err := n.NormalizedValue()
+			if err != nil {
+				if warnings != nil {
+					warning := NewUnnormalizableDateWarning(n, err)
+					warning.SetContext(context)
+					*warnings = append(*warnings, warning)
+				}
+
+				return node, true
+			}
+
+			if value != n.Value() {
+				return newDateNode(n.document, value), true
+			}
+
+		default:
+			// Only records have a pointer. Dates in other records, such as a
+			// SOUR, do not belong to an individual or family.
+			if node.Pointer() != "" {
+				context = WarningContext{}
+			}
+		}
+
+		return node, true
+	}
+}
@@ -559,3 +559,37 @@ func TestRemoveEmptyDeathTagFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeDatesFilter(t *testing.T) {
+	individual := gedcom.NewDocument().AddIndividual("P1",
+		gedcom.NewNameNode("Elliot /Chance/"),
+		gedcom.NewBirthNode("",
+			gedcom.NewDateNode("Abt. 6 May 1989",
+				gedcom.NewNode(gedcom.TagTime, "12:00", ""),
+			),
+		),
+		gedcom.NewDeathNode("",
+			gedcom.NewDateNode("Est. Abt. 2050"),
+		),
+	)
+
+	var warnings gedcom.Warnings
+	filter := gedcom.NormalizeDatesFilter(&warnings)
+	doc := gedcom.NewDocument()
+	result := gedcom.GEDCOMString(gedcom.Filter(individual, doc, filter), 0)
+
+	assert.Equal(t, `0 @P1@ INDI
+1 NAME Elliot /Chance/
+1 BIRT
+2 DATE ABT 6 MAY 1989
+3 TIME 12:00
+1 DEAT
+2 DATE Est. Abt. 2050
+`, result)
+
+	assert.Len(t, warnings, 1)
+	assert.Equal(t, "UnnormalizableDate", warnings[0].Name())
+	assert.Equal(t, individual, warnings[0].Context().Individual)
+	assert.Equal(t, `Cannot normalize date "Est. Abt. 2050": "Abt. 2050" cannot have a constraint in this position`,
+		warnings[0].String())
+}
@@ -0,0 +1,544 @@
+// GEDCOM Versions
+//
+// Both GEDCOM 5.5.1 and GEDCOM 7.0 can be decoded. The version is detected
+// from the VERS in the GEDC of the HEAD:
+//
+//   0 HEAD
+//   1 GEDC
+//   2 VERS 7.0
+//
+// The lines of both versions are decoded in the same way, with these
+// differences for GEDCOM 7.0:
+//
+// 1. SNOTE records, and the SNOTE pointers to them, are a SharedNoteNode. See
+// Document.SharedNotes.
+//
+// 2. A "@VOID@" pointer (such as "1 HUSB @VOID@") never resolves to a record.
+//
+// The SCHMA in the HEAD, and the extension TAGs inside it, are decoded as
+// ordinary nodes. The URIs are not used when decoding.
+//
+// Values are always retained as they appear in the file. This includes the
+// "@@" escapes, which are different in each version: GEDCOM 5.5.1 escapes
+// every "@" whereas GEDCOM 7.0 only escapes a leading "@". Likewise, a DATE
+// from a GEDCOM 7.0 file may use the "JULIAN" calendar keyword rather than the
+// "@#DJULIAN@" escape of GEDCOM 5.5.1, and "BCE" rather than "B.C.". Both forms
+// are understood when the date is parsed.
+//
+// A Document can be converted from one version to the other with
+// ConvertDocument, or by setting Encoder.Version. Anything that cannot be
+// converted without losing information is reported as a
+// LossyConversionWarning.
+package gedcom
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// GEDCOMVersion is the version of the GEDCOM standard that a document follows.
+type GEDCOMVersion string
+
+const (
+	// GEDCOMVersion551 is GEDCOM 5.5.1. It is also used for GEDCOM 5.5 and
+	// documents that do not specify a version.
+	GEDCOMVersion551 = GEDCOMVersion("5.5.1")
+
+	// GEDCOMVersion70 is GEDCOM 7.0 (including any 7.0.x release).
+	GEDCOMVersion70 = GEDCOMVersion("7.0")
+)
+
+// GEDCOMVersionFromString returns the GEDCOMVersion for a VERS value, like
+// "5.5.1" or "7.0.13".
+//
+// An empty GEDCOMVersion is returned if the value is not recognised.
+func GEDCOMVersionFromString(s string) GEDCOMVersion {
+	s = strings.TrimSpace(s)
+
+	switch {
+	case s == "7" || strings.HasPrefix(s, "7."):
+		return GEDCOMVersion70
+
+	case strings.HasPrefix(s, "5.5"):
+		return GEDCOMVersion551
+	}
+
+	return ""
+}
+
+// String returns the value used by the VERS node.
+func (version GEDCOMVersion) String() string {
+	return string(version)
+}
+
+// UnescapeValue returns the text of a value by removing the "@@" escapes. In
+// GEDCOM 5.5.1 (or an unknown version) every "@@" is an escaped "@", whereas
+// in GEDCOM 7.0 only a leading "@@" is.
+//
+// This is needed when a value is written to a format other than GEDCOM.
+func (version GEDCOMVersion) UnescapeValue(value string) string {
+	if version == GEDCOMVersion70 {
+		if strings.HasPrefix(value, "@@") {
+			return value[1:]
+		}
+
+		return value
+	}
+
+	return strings.Replace(value, "@@", "@", -1)
+}
+
+// orDefault returns GEDCOMVersion551 when the version is unknown.
+func (version GEDCOMVersion) orDefault() GEDCOMVersion {
+	if version == "" {
+		return GEDCOMVersion551
+	}
+
+	return version
+}
+
+var (
+	// tagsRemovedInGEDCOM70 are 5.5.1 tags that do not exist in 7.0. They are
+	// converted into extension tags.
+	tagsRemovedInGEDCOM70 = []Tag{
+		TagAncestralFileNumber, TagAncestorsInterest, TagBinaryObject,
+		TagDescendantsInterest, TagPhonetic, TagRecordFileNumber,
+		TagRomanized,
+	}
+
+	// tagsAddedInGEDCOM70 are 7.0 tags that do not exist in 5.5.1. They are
+	// converted into extension tags.
+	tagsAddedInGEDCOM70 = []Tag{
+		TagCreation, TagExternalIdentifier, TagMediaType, TagPhrase,
+		TagTranslation, TagFromString("CROP"), TagFromString("INIL"),
+		TagFromString("NO"), TagFromString("SDATE"),
+	}
+)
+
+// pointerRegexp matches a value that is a pointer, like "@P1@". Escapes in
+// GEDCOM 5.5.1 dates (like "@#DJULIAN@") are not pointers.
+var pointerRegexp = regexp.MustCompile(`^@[^@#][^@]*@$`)
+
+// voidPointer is used in GEDCOM 7.0 to represent a pointer to nothing.
+const voidPointer = "@VOID@"
+
+var (
+	dateCalendarEscapeRegexp  = regexp.MustCompile(`@#D([A-Z ]+)@ ?`)
+	dateCalendarKeywordRegexp = regexp.MustCompile(`\b(GREGORIAN|JULIAN|HEBREW|FRENCH_R) `)
+	dateDualYearRegexp        = regexp.MustCompile(`\b(\d+)/(\d+)\b`)
+	dateBCRegexp              = regexp.MustCompile(`(?i) ?\(?B\.C\.\)?`)
+	dateBCERegexp             = regexp.MustCompile(` ?\bBCE\b`)
+	dateInterpretedRegexp     = regexp.MustCompile(`(?i)^INT (.*?) ?\((.*)\)$`)
+	datePhraseRegexp          = regexp.MustCompile(`^\((.*)\)$`)
+	dateQualifiedRegexp       = regexp.MustCompile(`^(BET|FROM|TO|ABT|CAL|EST|AFT|BEF)\b`)
+)
+
+// calendars maps the GEDCOM 5.5.1 calendar escapes to the GEDCOM 7.0 calendar
+// keywords. GREGORIAN is the default so it is not needed in either version.
+var calendars = map[string]string{
+	"GREGORIAN": "",
+	"JULIAN":    "JULIAN ",
+	"HEBREW":    "HEBREW ",
+	"FRENCH R":  "FRENCH_R ",
+}
+
+// LossyConversionWarning is produced when a document is converted between
+// GEDCOM versions and a node cannot be represented without losing
+// information.
+type LossyConversionWarning struct {
+	SimpleWarning
+	Node   Node
+	Reason string
+}
+
+func NewLossyConversionWarning(node Node, reason string) *LossyConversionWarning {
+	return &LossyConversionWarning{
+		Node:   node,
+		Reason: reason,
+	}
+}
+
+func (w *LossyConversionWarning) Name() string {
+	return "LossyConversion"
+}
+
+func (w *LossyConversionWarning) String() string {
+	return w.Reason
+}
+
+// ConvertDocument returns a new Document that is a copy of document converted
+// to the GEDCOM version. The original document is not modified.
+//
+// Any information that could not be converted without loss is returned as
+// warnings. The conversion always succeeds, even if there are warnings.
+//
+// If the document is already the requested version a copy is still returned.
+func ConvertDocument(document *Document, version GEDCOMVersion) (*Document, Warnings) {
+	converter := &versionConverter{
+		document: NewDocument(),
+		from:     document.Version.orDefault(),
+		to:       version.orDefault(),
+	}
+
+	converter.document.HasBOM = document.HasBOM
+	converter.document.CharacterSet = document.CharacterSet
+	converter.document.MaxLivingAge = document.MaxLivingAge
+	converter.document.Version = converter.to
+
+	if converter.to == GEDCOMVersion70 {
+		converter.document.CharacterSet = CharacterSetUTF8
+	}
+
+	for _, node := range document.Nodes() {
+		converter.context = WarningContext{}
+
+		switch n := node.(type) {
+		case *IndividualNode:
+			converter.context.Individual = n
+
+		case *FamilyNode:
+			converter.context.Family = n
+		}
+
+		newNode := converter.convertNode(node, nil)
+		if newNode != nil && newNode.Tag() == TagHeader {
+			converter.completeHeader(newNode)
+		}
+
+		converter.document.AddNode(newNode)
+	}
+
+	converter.document.buildPointerCache()
+
+	return converter.document, converter.warnings
+}
+
+type versionConverter struct {
+	document *Document
+	from, to GEDCOMVersion
+	warnings Warnings
+	context  WarningContext
+	family   *FamilyNode
+}
+
+func (converter *versionConverter) warn(node Node, format string, args ...interface{}) {
+	warning := NewLossyConversionWarning(node, fmt.Sprintf(format, args...))
+	warning.SetContext(converter.context)
+	converter.warnings = append(converter.warnings, warning)
+}
+
+// convertNode returns the converted node (and all of its children), or nil if
+// the node should be removed.
+func (converter *versionConverter) convertNode(node, parent Node) Node {
+	tag, value, pointer := node.Tag(), node.Value(), node.Pointer()
+	isRoot := IsNil(parent)
+	var extraChildren Nodes
+
+	// CONC and CONT nodes only exist if the Decoder was asked to keep them.
+	// GEDCOM 7.0 does not support CONC so they are folded into the value.
+	var children Nodes
+	for _, child := range node.Nodes() {
+		if converter.to == GEDCOMVersion70 && isContinuationTag(child.Tag()) {
+			if child.Tag() == TagContinued {
+				value += "\n"
+			}
+
+			value += child.Value()
+			continue
+		}
+
+		children = append(children, child)
+	}
+
+	if converter.from == converter.to {
+		return converter.newNode(tag, value, pointer, node, children, nil)
+	}
+
+	switch {
+	case isRoot && tag == TagSubmission && converter.to == GEDCOMVersion70,
+		!isRoot && parent.Tag() == TagHeader && tag == TagSubmission &&
+			converter.to == GEDCOMVersion70:
+		converter.warn(node, "%s is not supported in GEDCOM 7.0", tag.Tag())
+		return nil
+
+	case !isRoot && parent.Tag() == TagHeader && tag == TagCharacterSet &&
+		converter.to == GEDCOMVersion70:
+		// GEDCOM 7.0 is always UTF-8.
+		return nil
+
+	case !isRoot && parent.Tag() == TagHeader && tag == TagSchema &&
+		converter.to == GEDCOMVersion551:
+		converter.warn(node, "%s is not supported in GEDCOM 5.5.1", tag.Tag())
+		return nil
+
+	case value == voidPointer && converter.to == GEDCOMVersion551:
+		if len(children) > 0 {
+			converter.warn(node, "%s pointing to %s has children that cannot be represented in GEDCOM 5.5.1", tag.Tag(), voidPointer)
+		}
+
+		return nil
+
+	case !isRoot && parent.Tag() == TagGedcomInformation:
+		return converter.convertGEDCOMInformation(node, tag, value, pointer, children)
+
+	case tag == TagDate:
+		value, extraChildren, children = converter.convertDate(node, value, children)
+
+	case tag == TagNote && converter.to == GEDCOMVersion70 &&
+		(isRoot && pointer != "" || pointerRegexp.MatchString(value)):
+		tag = TagSharedNote
+
+	case tag == TagSharedNote && converter.to == GEDCOMVersion551:
+		tag = TagNote
+
+	case tag == UnofficialTagUniqueID && converter.to == GEDCOMVersion70:
+		tag = TagUniqueIdentifier
+
+	case tag == TagUniqueIdentifier && converter.to == GEDCOMVersion551:
+		tag = UnofficialTagUniqueID
+
+	case converter.to == GEDCOMVersion70 && tagIsOneOf(tag, tagsRemovedInGEDCOM70):
+		tag = converter.extensionTag(node, tag)
+
+	case converter.to == GEDCOMVersion551 && tagIsOneOf(tag, tagsAddedInGEDCOM70):
+		tag = converter.extensionTag(node, tag)
+	}
+
+	if !pointerRegexp.MatchString(value) {
+		value = converter.convertEscapes(value)
+	}
+
+	return converter.newNode(tag, value, pointer, node, children, extraChildren)
+}
+
+func (converter *versionConverter) newNode(tag Tag, value, pointer string, original Node, children, extraChildren Nodes) Node {
+	node := newNode(converter.document, converter.family, tag, value, pointer)
+
+	if family, ok := node.(*FamilyNode); ok {
+		converter.family = family
+	}
+
+	// Retain the original split points if the value did not change.
+	if c := original.RawSimpleNode().continuation; c != nil &&
+		value == original.Value() && converter.to != GEDCOMVersion70 {
+		node.RawSimpleNode().continuation = c
+	}
+
+	for _, child := range children {
+		if newChild := converter.convertNode(child, original); newChild != nil {
+			node.AddNode(newChild)
+		}
+	}
+
+	for _, child := range extraChildren {
+		node.AddNode(child)
+	}
+
+	return node
+}
+
+// completeHeader adds the VERS to the HEAD so that the version can be detected
+// when it is read back, and the nodes that are required by GEDCOM 5.5.1 but do
+// not exist in GEDCOM 7.0.
+func (converter *versionConverter) completeHeader(header Node) {
+	if converter.from == converter.to {
+		return
+	}
+
+	is551 := converter.to == GEDCOMVersion551
+
+	if is551 && First(NodesWithTag(header, TagCharacterSet)) == nil {
+		header.AddNode(NewNode(TagCharacterSet, CharacterSetUTF8.String(), ""))
+	}
+
+	gedc := First(NodesWithTag(header, TagGedcomInformation))
+	if gedc == nil {
+		gedc = NewNode(TagGedcomInformation, "", "")
+		header.AddNode(gedc)
+	}
+
+	if First(NodesWithTag(gedc, TagVersion)) == nil {
+		gedc.AddNode(NewNode(TagVersion, converter.to.String(), ""))
+	}
+
+	if is551 && First(NodesWithTag(gedc, TagFormat)) == nil {
+		gedc.AddNode(NewFormatNode("LINEAGE-LINKED"))
+	}
+}
+
+func (converter *versionConverter) extensionTag(node Node, tag Tag) Tag {
+	newTag := TagFromString("_" + tag.Tag())
+
+	converter.warn(node, "%s is not supported in GEDCOM %s and has been converted to %s",
+		tag.Tag(), converter.to, newTag.Tag())
+
+	return newTag
+}
+
+// convertEscapes handles the "@" character that must be escaped as "@@". In
+// GEDCOM 5.5.1 all "@" must be escaped, whereas in GEDCOM 7.0 only a leading
+// "@" is escaped.
+func (converter *versionConverter) convertEscapes(value string) string {
+	if converter.to == GEDCOMVersion70 {
+		value = strings.Replace(value, "@@", "@", -1)
+
+		if strings.HasPrefix(value, "@") && !strings.HasPrefix(value, "@#") {
+			value = "@" + value
+		}
+
+		return value
+	}
+
+	if strings.HasPrefix(value, "@@") {
+		value = value[1:]
+	}
+
+	return escapeValue(value)
+}
+
+// escapeValue escapes every "@" as "@@", as required by GEDCOM 5.5.1. Calendar
+// escapes in dates, like "@#DJULIAN@", must not be escaped.
+func escapeValue(value string) string {
+	parts := dateCalendarEscapeRegexp.Split(value, -1)
+	escapes := dateCalendarEscapeRegexp.FindAllString(value, -1)
+	for i := range parts {
+		parts[i] = strings.Replace(parts[i], "@", "@@", -1)
+	}
+
+	value = parts[0]
+	for i, escape := range escapes {
+		value += escape + parts[i+1]
+	}
+
+	return value
+}
+
+// convertGEDCOMInformation updates the VERS and FORM in the GEDC of the HEAD.
+func (converter *versionConverter) convertGEDCOMInformation(node Node, tag Tag, value, pointer string, children Nodes) Node {
+	switch {
+	case tag == TagVersion:
+		value = converter.to.String()
+
+	case tag == TagFormat && converter.to == GEDCOMVersion70:
+		if !strings.EqualFold(value, "LINEAGE-LINKED") {
+			converter.warn(node, "GEDCOM 7.0 does not support the %s form", value)
+		}
+
+		return nil
+	}
+
+	return converter.newNode(tag, value, pointer, node, children, nil)
+}
+
+// convertDate converts the calendar, era, dual years and phrases of a DATE.
+//
+// The DATE may need to have a PHRASE added (GEDCOM 7.0), or a PHRASE removed
+// (GEDCOM 5.5.1).
+func (converter *versionConverter) convertDate(node Node, value string, children Nodes) (string, Nodes, Nodes) {
+	if converter.to == GEDCOMVersion70 {
+		phrase := ""
+
+		if parts := dateInterpretedRegexp.FindStringSubmatch(value); parts != nil {
+			value, phrase = parts[1], parts[2]
+		} else if parts := datePhraseRegexp.FindStringSubmatch(value); parts != nil {
+			value, phrase = "", parts[1]
+		}
+
+		for _, parts := range dateCalendarEscapeRegexp.FindAllStringSubmatch(value, -1) {
+			if _, ok := calendars[parts[1]]; !ok {
+				converter.warn(node, "the %s calendar is not supported in GEDCOM 7.0", parts[1])
+
+				return "", Nodes{NewNode(TagPhrase, value, "")}, children
+			}
+		}
+
+		value = dateCalendarEscapeRegexp.ReplaceAllStringFunc(value, func(s string) string {
+			return calendars[dateCalendarEscapeRegexp.FindStringSubmatch(s)[1]]
+		})
+
+		value = dateBCRegexp.ReplaceAllString(value, " BCE")
+
+		// Dual years are not supported. Use the later year and retain the
+		// original value as the phrase.
+		if dateDualYearRegexp.MatchString(value) {
+			if phrase == "" {
+				phrase = node.Value()
+			}
+
+			value = dateDualYearRegexp.ReplaceAllStringFunc(value, func(s string) string {
+				parts := dateDualYearRegexp.FindStringSubmatch(s)
+				return strconv.Itoa(dualYear(Atoi(parts[1]), parts[2]))
+			})
+		}
+
+		if phrase != "" {
+			return value, Nodes{NewNode(TagPhrase, phrase, "")}, children
+		}
+
+		return value, nil, children
+	}
+
+	value = dateCalendarKeywordRegexp.ReplaceAllStringFunc(value, func(s string) string {
+		keyword := strings.TrimSpace(s)
+
+		for escape, k := range calendars {
+			if strings.TrimSpace(k) == keyword && k != "" {
+				return fmt.Sprintf("@#D%s@ ", escape)
+			}
+		}
+
+		return ""
+	})
+
+	value = dateBCERegexp.ReplaceAllString(value, " B.C.")
+
+	var remaining Nodes
+	for _, child := range children {
+		if child.Tag() != TagPhrase {
+			remaining = append(remaining, child)
+			continue
+		}
+
+		switch {
+		case value == "":
+			value = fmt.Sprintf("(%s)", child.Value())
+
+		case !dateQualifiedRegexp.MatchString(value):
+			value = fmt.Sprintf("INT %s (%s)", value, child.Value())
+
+		default:
+			// This will be converted into an extension tag.
+			remaining = append(remaining, child)
+		}
+	}
+
+	return value, nil, remaining
+}
+
+// dualYear returns the later year of a dual year, like "1699/00" or
+// "1699/1700".
+func dualYear(year int, suffix string) int {
+	modulus := 1
+	for range suffix {
+		modulus *= 10
+	}
+
+	result := year/modulus*modulus + Atoi(suffix)
+	if result <= year {
+		result += modulus
+	}
+
+	return result
+}
+
+func tagIsOneOf(tag Tag, tags []Tag) bool {
+	for _, t := range tags {
+		if t.Is(tag) {
+			return true
+		}
+	}
+
+	return false
+}
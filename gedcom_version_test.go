@@ -0,0 +1,302 @@
+package gedcom_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGEDCOMVersionFromString(t *testing.T) {
+	GEDCOMVersionFromString := tf.Function(t, gedcom.GEDCOMVersionFromString)
+
+	GEDCOMVersionFromString("5.5").Returns(gedcom.GEDCOMVersion551)
+	GEDCOMVersionFromString("5.5.1").Returns(gedcom.GEDCOMVersion551)
+	GEDCOMVersionFromString("5.5.5").Returns(gedcom.GEDCOMVersion551)
+	GEDCOMVersionFromString("7.0").Returns(gedcom.GEDCOMVersion70)
+	GEDCOMVersionFromString(" 7.0.13 ").Returns(gedcom.GEDCOMVersion70)
+	GEDCOMVersionFromString("(2010.3)").Returns(gedcom.GEDCOMVersion(""))
+	GEDCOMVersionFromString("").Returns(gedcom.GEDCOMVersion(""))
+}
+
+func TestGEDCOMVersion_UnescapeValue(t *testing.T) {
+	UnescapeValue := tf.Function(t, gedcom.GEDCOMVersion.UnescapeValue)
+
+	UnescapeValue(gedcom.GEDCOMVersion551, "foo").Returns("foo")
+	UnescapeValue(gedcom.GEDCOMVersion551, "@@foo and foo@@bar.com").Returns("@foo and foo@bar.com")
+	UnescapeValue(gedcom.GEDCOMVersion(""), "foo@@bar.com").Returns("foo@bar.com")
+	UnescapeValue(gedcom.GEDCOMVersion551, "@#DJULIAN@ 1700").Returns("@#DJULIAN@ 1700")
+	UnescapeValue(gedcom.GEDCOMVersion70, "@@foo and foo@bar.com").Returns("@foo and foo@bar.com")
+	UnescapeValue(gedcom.GEDCOMVersion70, "foo@@bar.com").Returns("foo@@bar.com")
+}
+
+var convertDocumentTests = map[string]struct {
+	ged551, ged70 string
+	warnings      []string
+}{
+	"Header": {
+		ged551: "0 HEAD\n1 GEDC\n2 VERS 5.5.1\n2 FORM LINEAGE-LINKED\n1 CHAR UTF-8\n",
+		ged70:  "0 HEAD\n1 GEDC\n2 VERS 7.0\n",
+	},
+	"SharedNote": {
+		ged551: "0 @N1@ NOTE foo\n1 CONT bar\n0 @I1@ INDI\n1 NOTE @N1@\n1 NOTE baz\n",
+		ged70:  "0 @N1@ SNOTE foo\n1 CONT bar\n0 @I1@ INDI\n1 SNOTE @N1@\n1 NOTE baz\n",
+	},
+	"UniqueID": {
+		ged551: "0 @I1@ INDI\n1 _UID 1234\n",
+		ged70:  "0 @I1@ INDI\n1 UID 1234\n",
+	},
+	"Calendars": {
+		ged551: "0 @I1@ INDI\n1 BIRT\n2 DATE @#DJULIAN@ 1 JAN 1700\n1 DEAT\n2 DATE BET @#DHEBREW@ 5600 AND @#DFRENCH R@ 10\n",
+		ged70:  "0 @I1@ INDI\n1 BIRT\n2 DATE JULIAN 1 JAN 1700\n1 DEAT\n2 DATE BET HEBREW 5600 AND FRENCH_R 10\n",
+	},
+	"Era": {
+		ged551: "0 DATE 44 B.C.\n",
+		ged70:  "0 DATE 44 BCE\n",
+	},
+	"InterpretedDate": {
+		ged551: "0 DATE INT 1900 (about the turn of the century)\n",
+		ged70:  "0 DATE 1900\n1 PHRASE about the turn of the century\n",
+	},
+	"DatePhrase": {
+		ged551: "0 DATE (after the war)\n",
+		ged70:  "0 DATE\n1 PHRASE after the war\n",
+	},
+	"Escapes": {
+		ged551: "0 NOTE @@foo and foo@@bar.com\n",
+		ged70:  "0 NOTE @@foo and foo@bar.com\n",
+	},
+}
+
+func TestConvertDocument(t *testing.T) {
+	for testName, test := range convertDocumentTests {
+		t.Run(testName+"To70", func(t *testing.T) {
+			doc, err := gedcom.NewDocumentFromString(test.ged551)
+			assert.NoError(t, err)
+
+			actual, warnings := gedcom.ConvertDocument(doc, gedcom.GEDCOMVersion70)
+
+			assert.Equal(t, test.ged70, actual.String())
+			assert.Equal(t, gedcom.GEDCOMVersion70, actual.Version)
+			assert.Empty(t, warnings)
+		})
+
+		t.Run(testName+"To551", func(t *testing.T) {
+			doc, err := gedcom.NewDocumentFromString(test.ged70)
+			assert.NoError(t, err)
+			doc.Version = gedcom.GEDCOMVersion70
+
+			actual, warnings := gedcom.ConvertDocument(doc, gedcom.GEDCOMVersion551)
+
+			assert.Equal(t, test.ged551, actual.String())
+			assert.Equal(t, gedcom.GEDCOMVersion551, actual.Version)
+			assert.Empty(t, warnings)
+		})
+	}
+
+	for testName, test := range map[string]struct {
+		ged      string
+		from, to gedcom.GEDCOMVersion
+		expected string
+		warnings []string
+	}{
+		"DualYear": {
+			"0 DATE 1 MAR 1699/00\n",
+			gedcom.GEDCOMVersion551, gedcom.GEDCOMVersion70,
+			"0 DATE 1 MAR 1700\n1 PHRASE 1 MAR 1699/00\n",
+			nil,
+		},
+		"UnsupportedCalendar": {
+			"0 DATE @#DROMAN@ 12\n",
+			gedcom.GEDCOMVersion551, gedcom.GEDCOMVersion70,
+			"0 DATE\n1 PHRASE @#DROMAN@ 12\n",
+			[]string{"the ROMAN calendar is not supported in GEDCOM 7.0"},
+		},
+		"RemovedTags": {
+			"0 HEAD\n1 SUBN @S1@\n0 @S1@ SUBN\n0 @I1@ INDI\n1 AFN 123\n1 NAME Bob\n2 ROMN Bob\n",
+			gedcom.GEDCOMVersion551, gedcom.GEDCOMVersion70,
+			"0 HEAD\n1 GEDC\n2 VERS 7.0\n0 @I1@ INDI\n1 _AFN 123\n1 NAME Bob\n2 _ROMN Bob\n",
+			[]string{
+				"SUBN is not supported in GEDCOM 7.0",
+				"SUBN is not supported in GEDCOM 7.0",
+				"AFN is not supported in GEDCOM 7.0 and has been converted to _AFN",
+				"ROMN is not supported in GEDCOM 7.0 and has been converted to _ROMN",
+			},
+		},
+		"ContinuationNodes": {
+			"0 NOTE foo\n1 CONC bar\n1 CONT baz\n",
+			gedcom.GEDCOMVersion551, gedcom.GEDCOMVersion70,
+			"0 NOTE foobar\n1 CONT baz\n",
+			nil,
+		},
+		"VoidPointers": {
+			"0 @F1@ FAM\n1 HUSB @VOID@\n1 WIFE @I2@\n1 CHIL @VOID@\n2 PHRASE Unknown child\n",
+			gedcom.GEDCOMVersion70, gedcom.GEDCOMVersion551,
+			"0 @F1@ FAM\n1 WIFE @I2@\n",
+			[]string{"CHIL pointing to @VOID@ has children that cannot be represented in GEDCOM 5.5.1"},
+		},
+		"Schema": {
+			"0 HEAD\n1 GEDC\n2 VERS 7.0\n1 SCHMA\n2 TAG _FOO https://example.com/foo\n",
+			gedcom.GEDCOMVersion70, gedcom.GEDCOMVersion551,
+			"0 HEAD\n1 GEDC\n2 VERS 5.5.1\n2 FORM LINEAGE-LINKED\n1 CHAR UTF-8\n",
+			[]string{"SCHMA is not supported in GEDCOM 5.5.1"},
+		},
+		"HeaderVersion": {
+			"0 HEAD\n1 SOUR Foo\n0 @I1@ INDI\n",
+			gedcom.GEDCOMVersion551, gedcom.GEDCOMVersion70,
+			"0 HEAD\n1 SOUR Foo\n1 GEDC\n2 VERS 7.0\n0 @I1@ INDI\n",
+			nil,
+		},
+		"NewTags": {
+			"0 @I1@ INDI\n1 EXID 123\n1 BIRT\n2 DATE BET 1900 AND 1910\n3 PHRASE Early 1900s\n",
+			gedcom.GEDCOMVersion70, gedcom.GEDCOMVersion551,
+			"0 @I1@ INDI\n1 _EXID 123\n1 BIRT\n2 DATE BET 1900 AND 1910\n3 _PHRASE Early 1900s\n",
+			[]string{
+				"EXID is not supported in GEDCOM 5.5.1 and has been converted to _EXID",
+				"PHRASE is not supported in GEDCOM 5.5.1 and has been converted to _PHRASE",
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			decoder := gedcom.NewDecoder(strings.NewReader(test.ged))
+			decoder.KeepContinuationNodes = true
+			decoder.Version = test.from
+
+			doc, err := decoder.Decode()
+			assert.NoError(t, err)
+
+			actual, warnings := gedcom.ConvertDocument(doc, test.to)
+
+			assert.Equal(t, test.expected, actual.String())
+			assert.Equal(t, test.warnings, warnings.Strings())
+		})
+	}
+}
+
+func TestDecoder_DecodeVersion(t *testing.T) {
+	for ged, expected := range map[string]gedcom.GEDCOMVersion{
+		"":                               "",
+		"0 HEAD\n1 GEDC\n2 VERS 7.0.1":   gedcom.GEDCOMVersion70,
+		"0 HEAD\n1 GEDC\n2 VERS 5.5.1":   gedcom.GEDCOMVersion551,
+		"0 HEAD\n1 SOUR X\n2 VERS 7.0":   "",
+		"0 HEAD\n0 GEDC\n1 VERS 7.0":     "",
+		"0 HEAD\r\n1 GEDC\r\n2 VERS 7.0": gedcom.GEDCOMVersion70,
+	} {
+		t.Run(ged, func(t *testing.T) {
+			doc, err := gedcom.NewDocumentFromString(ged)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, doc.Version)
+		})
+	}
+}
+
+func TestDecoder_DecodeGEDCOM70(t *testing.T) {
+	ged := `0 HEAD
+1 GEDC
+2 VERS 7.0
+0 @N1@ SNOTE @@me and you@example.com
+1 CONT second line
+0 @I1@ INDI
+1 SNOTE @N1@
+1 SNOTE @VOID@
+0 @I2@ INDI
+1 BIRT
+2 DATE JULIAN 1 JAN 1700
+1 DEAT
+2 DATE BET HEBREW 1 TSH 5760 AND FRENCH_R 1 VEND 3
+1 EVEN
+2 DATE 1 JAN 100 BCE
+0 @F1@ FAM
+1 HUSB @VOID@
+2 PHRASE Unknown father
+1 WIFE @I2@
+1 CHIL @VOID@
+0 @VOID@ INDI
+`
+	doc, err := gedcom.NewDocumentFromString(ged)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	t.Run("SharedNote", func(t *testing.T) {
+		notes := doc.SharedNotes()
+		if assert.Len(t, notes, 1) {
+			assert.Equal(t, "N1", notes[0].Pointer())
+		}
+
+		references := gedcom.NodesWithTag(doc.Individuals().ByPointer("I1"),
+			gedcom.TagSharedNote)
+		if assert.Len(t, references, 2) {
+			assert.Equal(t, notes[0], references[0].(*gedcom.SharedNoteNode).Record())
+			assert.Equal(t, "@@me and you@example.com\nsecond line",
+				references[0].(*gedcom.SharedNoteNode).Text())
+		}
+	})
+
+	t.Run("VoidPointers", func(t *testing.T) {
+		// A record with the pointer VOID is not valid in GEDCOM 7.0. It is
+		// included to make sure that "@VOID@" is never resolved.
+		references := gedcom.NodesWithTag(doc.Individuals().ByPointer("I1"),
+			gedcom.TagSharedNote)
+		if assert.Len(t, references, 2) {
+			assert.Nil(t, references[1].(*gedcom.SharedNoteNode).Record())
+			assert.Equal(t, "", references[1].(*gedcom.SharedNoteNode).Text())
+		}
+
+		family := doc.Families().ByPointer("F1")
+		assert.Nil(t, family.Husband().Individual())
+		assert.Equal(t, doc.Individuals().ByPointer("I2"), family.Wife().Individual())
+		assert.Nil(t, family.Children()[0].Individual())
+		assert.Empty(t, family.Children().Individuals())
+	})
+
+	t.Run("Dates", func(t *testing.T) {
+		individual := doc.Individuals().ByPointer("I2")
+		for tag, expected := range map[gedcom.Tag]string{
+			gedcom.TagBirth: "@#DJULIAN@ 1 Jan 1700",
+			gedcom.TagDeath: "Bet. @#DHEBREW@ 1 TSH 5760 and @#DFRENCH R@ 1 VEND 3",
+			gedcom.TagEvent: "1 Jan 100 B.C.",
+		} {
+			date := gedcom.NodesWithTagPath(individual, tag, gedcom.TagDate)[0].(*gedcom.DateNode)
+
+			assert.NoError(t, date.DateRange().ParseError(), tag.String())
+			assert.Empty(t, date.Warnings(), tag.String())
+			assert.Equal(t, expected, date.DateRange().String())
+		}
+	})
+
+	t.Run("Escapes", func(t *testing.T) {
+		// Values are kept as they are written. The escapes are only changed
+		// when converting to another version.
+		assert.Equal(t, ged, doc.String())
+
+		converted, _ := gedcom.ConvertDocument(doc, gedcom.GEDCOMVersion551)
+		assert.Equal(t, "@@me and you@@example.com\nsecond line",
+			converted.Nodes()[1].Value())
+	})
+}
+
+func TestEncoder_EncodeVersion(t *testing.T) {
+	ged := "0 HEAD\n1 GEDC\n2 VERS 5.5.1\n1 CHAR ANSEL\n1 SUBN @S1@\n0 @N1@ NOTE " +
+		strings.Repeat("a", 300) + "\n"
+	doc, err := gedcom.NewDocumentFromString(ged)
+	assert.NoError(t, err)
+
+	buf := bytes.NewBufferString("")
+	encoder := gedcom.NewEncoder(buf, doc)
+	encoder.Version = gedcom.GEDCOMVersion70
+
+	assert.NoError(t, encoder.Encode())
+	assert.Equal(t, "0 HEAD\n1 GEDC\n2 VERS 7.0\n0 @N1@ SNOTE "+
+		strings.Repeat("a", 300)+"\n", buf.String())
+	assert.Equal(t, []string{"SUBN is not supported in GEDCOM 7.0"},
+		encoder.ConversionWarnings.Strings())
+
+	// The original document is not modified.
+	assert.Equal(t, gedcom.GEDCOMVersion551, doc.Version)
+	assert.Equal(t, "SUBN", doc.Nodes()[0].Nodes()[2].Tag().Tag())
+}
@@ -0,0 +1,150 @@
+package gedcomx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elliotchance/gedcom/v39"
+)
+
+// formalDate returns the GEDCOM X formal date, like "A+1890-03" for
+// "ABT MAR 1890". The result will be empty if the date cannot be written as a
+// formal date, such as a phrase or a date in a calendar other than Gregorian.
+func formalDate(node *gedcom.DateNode, locales []*gedcom.DateLocale) string {
+	value := gedcom.CleanSpace(node.Value())
+
+	approximate := false
+	for _, prefix := range []string{"EST ", "CAL "} {
+		if strings.HasPrefix(strings.ToUpper(value), prefix) {
+			approximate = true
+			value = value[len(prefix):]
+		}
+	}
+
+	dr := gedcom.NewDateRangeWithLocales(value, locales)
+	if dr.IsPhrase() || !dr.IsValid() || dr.ParseError() != nil {
+		return ""
+	}
+
+	start, end := dr.StartAndEndDates()
+	if start.Calendar != gedcom.DateCalendarGregorian ||
+		end.Calendar != gedcom.DateCalendarGregorian {
+		return ""
+	}
+
+	switch {
+	case dr.IsOpenEnd():
+		return formalSimpleDate(start) + "/"
+
+	case dr.IsOpenStart():
+		return "/" + formalSimpleDate(end)
+
+	case dr.IsPeriod():
+		return formalSimpleDate(start) + "/" + formalSimpleDate(end)
+
+	case dr.IsRange():
+		return "A" + formalSimpleDate(start) + "/" + formalSimpleDate(end)
+	}
+
+	switch start.Constraint {
+	case gedcom.DateConstraintAbout:
+		approximate = true
+
+	case gedcom.DateConstraintBefore:
+		return "/" + formalSimpleDate(start)
+
+	case gedcom.DateConstraintAfter:
+		return formalSimpleDate(start) + "/"
+	}
+
+	if approximate {
+		return "A" + formalSimpleDate(start)
+	}
+
+	return formalSimpleDate(start)
+}
+
+func formalSimpleDate(date gedcom.Date) string {
+	switch {
+	case date.Day != 0:
+		return fmt.Sprintf("+%04d-%02d-%02d", date.Year, date.Month, date.Day)
+
+	case date.Month != 0:
+		return fmt.Sprintf("+%04d-%02d", date.Year, date.Month)
+	}
+
+	return fmt.Sprintf("+%04d", date.Year)
+}
+
+// gedcomDate converts a formal date into the GEDCOM form. It is only used when
+// the date does not have an original value.
+//
+// A formal date cannot always be converted exactly. "/+1890" could be
+// "BEF 1890" or "TO 1890", the former is used. An empty string is returned if
+// the formal date cannot be understood.
+func gedcomDate(formal string) string {
+	approximate := strings.HasPrefix(formal, "A")
+	formal = strings.TrimPrefix(formal, "A")
+
+	if !strings.Contains(formal, "/") {
+		date := gedcomSimpleDate(formal)
+		if date != "" && approximate {
+			return "ABT " + date
+		}
+
+		return date
+	}
+
+	parts := strings.SplitN(formal, "/", 2)
+	start, end := gedcomSimpleDate(parts[0]), gedcomSimpleDate(parts[1])
+
+	switch {
+	case start == "" && end == "":
+		return ""
+
+	case start == "":
+		return "BEF " + end
+
+	case end == "":
+		return "AFT " + start
+
+	case approximate:
+		return fmt.Sprintf("BET %s AND %s", start, end)
+	}
+
+	return fmt.Sprintf("FROM %s TO %s", start, end)
+}
+
+// gedcomSimpleDate converts a single formal date, like "+1890-03-12", into
+// "12 MAR 1890". Any time is ignored.
+func gedcomSimpleDate(formal string) string {
+	formal = strings.SplitN(formal, "T", 2)[0]
+	if !strings.HasPrefix(formal, "+") {
+		return ""
+	}
+
+	parts := strings.Split(formal[1:], "-")
+	if len(parts) > 3 {
+		return ""
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return ""
+		}
+
+		numbers[i] = number
+	}
+
+	date := gedcom.Date{
+		Year:  numbers[0],
+		Month: time.Month(numbers[1]),
+		Day:   numbers[2],
+	}
+
+	return strings.ToUpper(date.String())
+}
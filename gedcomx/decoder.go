@@ -0,0 +1,461 @@
+package gedcomx
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/elliotchance/gedcom/v39"
+)
+
+// Decoder reads GEDCOM X JSON.
+type Decoder struct {
+	r io.Reader
+}
+
+// NewDecoder creates a decoder for a GEDCOM X JSON stream.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{
+		r: r,
+	}
+}
+
+// Decode reads the entire stream and returns the equivalent Document.
+//
+// The Document is created with a gedcom.DocumentBuilder so all of the nodes are
+// the same types, and linked in the same way, as a decoded GEDCOM file.
+func (dec *Decoder) Decode() (*gedcom.Document, error) {
+	r := &root{}
+	if err := json.NewDecoder(dec.r).Decode(r); err != nil {
+		return nil, fmt.Errorf("cannot decode gedcomx: %s", err)
+	}
+
+	return newImporter(r).document()
+}
+
+// IsGEDCOMX returns true if the stream is a JSON object that has "persons" or
+// "relationships" at the top level. It can be used to tell GEDCOM X apart from
+// other JSON files (or GEDCOM files) without decoding the whole stream.
+func IsGEDCOMX(r io.Reader) bool {
+	decoder := json.NewDecoder(r)
+
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return false
+	}
+
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return false
+		}
+
+		if key == "persons" || key == "relationships" {
+			return true
+		}
+
+		// Skip the value.
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return false
+		}
+	}
+
+	return false
+}
+
+// family is created from a Couple relationship and the ParentChild
+// relationships of the same parents.
+type family struct {
+	pointer       string
+	husband, wife string
+	children      []string
+	couple        *relationship
+}
+
+// importer converts the GEDCOM X model into GEDCOM nodes.
+type importer struct {
+	root     *root
+	builder  *gedcom.DocumentBuilder
+	genders  map[string]string
+	persons  map[string]string
+	sources  map[string]string
+	families []*family
+	famc     map[string][]string
+	fams     map[string][]string
+}
+
+func newImporter(r *root) *importer {
+	imp := &importer{
+		root:    r,
+		builder: gedcom.NewDocumentBuilder(newDocument()),
+		genders: map[string]string{},
+		persons: map[string]string{},
+		sources: map[string]string{},
+		famc:    map[string][]string{},
+		fams:    map[string][]string{},
+	}
+
+	// The id of each person and source description is used as the pointer.
+	// Records without an id, or with an id that cannot be a pointer (such as
+	// "I2 x"), are given a new pointer. pointers maps the id onto the pointer.
+	used := map[string]bool{}
+	addPointer := func(pointers map[string]string, id, prefix string, i int) string {
+		pointer := id
+		for used[pointer] || !gedcom.IsValidPointer(pointer) {
+			i++
+			pointer = fmt.Sprintf("%s%d", prefix, i)
+		}
+
+		used[pointer] = true
+
+		// A record without an id is referred to by its new pointer.
+		if id == "" {
+			id = pointer
+		}
+
+		pointers[id] = pointer
+
+		return id
+	}
+
+	for i, p := range r.Persons {
+		r.Persons[i].ID = addPointer(imp.persons, p.ID, "I", i)
+
+		if p.Gender != nil {
+			imp.genders[r.Persons[i].ID] = genders[typeName(p.Gender.Type)]
+		}
+	}
+
+	for i, s := range r.SourceDescriptions {
+		r.SourceDescriptions[i].ID = addPointer(imp.sources, s.ID, "S", i)
+	}
+
+	imp.buildFamilies()
+
+	return imp
+}
+
+// newDocument is an empty UTF-8 GEDCOM 5.5.1 document, which is the same as
+// the HEAD that is created by the importer.
+func newDocument() *gedcom.Document {
+	document := gedcom.NewDocument()
+	document.CharacterSet = gedcom.CharacterSetUTF8
+	document.Version = gedcom.GEDCOMVersion551
+
+	return document
+}
+
+// resourceID returns the id of the resource, like "I1" for "#I1". Resources
+// that are a URL, like "https://example.com/persons/I1", use the last part of
+// the path.
+func resourceID(ref resourceReference) string {
+	resource := ref.Resource
+	if resource == "" {
+		return ref.ResourceID
+	}
+
+	if i := strings.LastIndexAny(resource, "#/"); i >= 0 {
+		return resource[i+1:]
+	}
+
+	return resource
+}
+
+func (imp *importer) buildFamilies() {
+	for i, rel := range imp.root.Relationships {
+		if rel.Type != typeCouple {
+			continue
+		}
+
+		f := &family{
+			pointer: rel.ID,
+			couple:  &imp.root.Relationships[i],
+		}
+		f.setParents(imp.genders, resourceID(rel.Person1), resourceID(rel.Person2))
+		imp.families = append(imp.families, f)
+	}
+
+	// The parents of each child, in the order that the children were first
+	// seen.
+	children := []string{}
+	parents := map[string][]string{}
+	for _, rel := range imp.root.Relationships {
+		if rel.Type != typeParentChild {
+			continue
+		}
+
+		child := resourceID(rel.Person2)
+		if _, ok := parents[child]; !ok {
+			children = append(children, child)
+		}
+
+		parents[child] = append(parents[child], resourceID(rel.Person1))
+	}
+
+	for _, child := range children {
+		f := imp.familyWithParents(parents[child])
+		f.children = append(f.children, child)
+	}
+
+	// Families must have a pointer. The id of the relationship is used when
+	// there is one.
+	used := map[string]bool{}
+	for _, f := range imp.families {
+		used[f.pointer] = true
+	}
+
+	next := 1
+	for _, f := range imp.families {
+		for !gedcom.IsValidPointer(f.pointer) || (used[f.pointer] && f.couple == nil) {
+			f.pointer = fmt.Sprintf("F%d", next)
+			next++
+		}
+
+		used[f.pointer] = true
+
+		for _, parent := range []string{f.husband, f.wife} {
+			if parent != "" {
+				imp.fams[parent] = append(imp.fams[parent], f.pointer)
+			}
+		}
+
+		for _, child := range f.children {
+			imp.famc[child] = append(imp.famc[child], f.pointer)
+		}
+	}
+}
+
+// setParents uses the gender of each parent to decide which is the husband
+// and which is the wife. If the genders are not known the first parent is the
+// husband.
+func (f *family) setParents(genders map[string]string, parent1, parent2 string) {
+	if genders[parent1] == gedcom.SexFemale || genders[parent2] == gedcom.SexMale {
+		parent1, parent2 = parent2, parent1
+	}
+
+	f.husband, f.wife = parent1, parent2
+}
+
+// familyWithParents finds the family with exactly the same parents, or creates
+// a new family.
+func (imp *importer) familyWithParents(parents []string) *family {
+	key := sortedParents(parents...)
+	for _, f := range imp.families {
+		if sortedParents(f.husband, f.wife) == key {
+			return f
+		}
+	}
+
+	f := &family{}
+	switch len(parents) {
+	case 1:
+		f.setParents(imp.genders, parents[0], "")
+
+	default:
+		f.setParents(imp.genders, parents[0], parents[1])
+	}
+
+	imp.families = append(imp.families, f)
+
+	return f
+}
+
+func sortedParents(parents ...string) string {
+	sorted := []string{}
+	for _, parent := range parents {
+		if parent != "" {
+			sorted = append(sorted, parent)
+		}
+	}
+
+	sort.Strings(sorted)
+
+	return strings.Join(sorted, " ")
+}
+
+// document returns the entire model as a Document.
+func (imp *importer) document() (*gedcom.Document, error) {
+	imp.line(0, "", gedcom.TagHeader, "")
+	imp.line(1, "", gedcom.TagGedcomInformation, "")
+	imp.line(2, "", gedcom.TagVersion, gedcom.GEDCOMVersion551.String())
+	imp.line(2, "", gedcom.TagFormat, "LINEAGE-LINKED")
+	imp.line(1, "", gedcom.TagCharacterSet, "UTF-8")
+
+	for _, p := range imp.root.Persons {
+		imp.person(p)
+	}
+
+	for _, f := range imp.families {
+		imp.family(f)
+	}
+
+	for _, s := range imp.root.SourceDescriptions {
+		imp.line(0, imp.sources[s.ID], gedcom.TagSource, "")
+
+		switch {
+		case len(s.Titles) > 0:
+			imp.line(1, "", gedcom.TagTitle, s.Titles[0].Value)
+
+		case len(s.Citations) > 0:
+			imp.line(1, "", gedcom.TagTitle, s.Citations[0].Value)
+		}
+
+		imp.notes(1, s.Notes)
+	}
+
+	imp.line(0, "", gedcom.TagTrailer, "")
+
+	return imp.builder.Document()
+}
+
+// line adds a single node. See gedcom.DocumentBuilder.
+func (imp *importer) line(level int, pointer string, tag gedcom.Tag, value string) {
+	imp.builder.Add(level, pointer, tag, value)
+}
+
+// personLine adds a node that points to a person. Nothing is added if the
+// person does not exist.
+func (imp *importer) personLine(level int, tag gedcom.Tag, id string) {
+	if pointer, ok := imp.persons[id]; ok {
+		imp.builder.AddPointer(level, tag, pointer)
+	}
+}
+
+// optionalLine only writes the line if the value is not empty.
+func (imp *importer) optionalLine(level int, tag gedcom.Tag, value string) {
+	if value != "" {
+		imp.line(level, "", tag, value)
+	}
+}
+
+func (imp *importer) person(p person) {
+	imp.line(0, imp.persons[p.ID], gedcom.TagIndividual, "")
+
+	for _, n := range p.Names {
+		imp.name(n)
+	}
+
+	imp.optionalLine(1, gedcom.TagSex, imp.genders[p.ID])
+
+	for _, f := range p.Facts {
+		imp.fact(f)
+	}
+
+	for _, pointer := range imp.famc[p.ID] {
+		imp.builder.AddPointer(1, gedcom.TagFamilyChild, pointer)
+	}
+
+	for _, pointer := range imp.fams[p.ID] {
+		imp.builder.AddPointer(1, gedcom.TagFamilySpouse, pointer)
+	}
+
+	imp.notes(1, p.Notes)
+	imp.sourceReferences(1, p.Sources)
+}
+
+func (imp *importer) name(n name) {
+	if len(n.NameForms) == 0 {
+		return
+	}
+
+	form := n.NameForms[0]
+	parts := map[string]string{}
+	for _, part := range form.Parts {
+		parts[part.Type] = part.Value
+	}
+
+	value := form.FullText
+	if len(form.Parts) > 0 {
+		value = parts[partGiven]
+		if surname := parts[partSurname]; surname != "" {
+			value += " /" + surname + "/"
+		}
+
+		value = gedcom.CleanSpace(value + " " + parts[partSuffix])
+	}
+
+	imp.line(1, "", gedcom.TagName, value)
+
+	if nameType, ok := nameTypes[typeName(n.Type)]; ok {
+		imp.line(2, "", gedcom.TagType, string(nameType))
+	}
+
+	imp.optionalLine(2, gedcom.TagNamePrefix, parts[partPrefix])
+	imp.optionalLine(2, gedcom.TagGivenName, parts[partGiven])
+	imp.optionalLine(2, gedcom.TagSurname, parts[partSurname])
+	imp.optionalLine(2, gedcom.TagNameSuffix, parts[partSuffix])
+}
+
+func (imp *importer) fact(f fact) {
+	tag, known := factTypes[strings.TrimPrefix(f.Type, typePrefix)]
+	if !strings.HasPrefix(f.Type, typePrefix) {
+		known = false
+	}
+
+	switch {
+	case !known:
+		imp.line(1, "", gedcom.TagEvent, f.Value)
+		imp.optionalLine(2, gedcom.TagType, typeName(f.Type))
+
+	case isValueTag(tag):
+		imp.line(1, "", tag, f.Value)
+
+	default:
+		imp.line(1, "", tag, "")
+	}
+
+	if f.Date != nil {
+		value := f.Date.Original
+		if value == "" {
+			value = gedcomDate(f.Date.Formal)
+		}
+
+		imp.optionalLine(2, gedcom.TagDate, value)
+	}
+
+	if f.Place != nil {
+		imp.optionalLine(2, gedcom.TagPlace, f.Place.Original)
+	}
+
+	imp.notes(2, f.Notes)
+	imp.sourceReferences(2, f.Sources)
+}
+
+func (imp *importer) notes(level int, notes []note) {
+	for _, n := range notes {
+		imp.line(level, "", gedcom.TagNote, n.Text)
+	}
+}
+
+// sourceReferences adds a SOUR for each reference to a source description.
+// References to sources that do not exist are ignored.
+func (imp *importer) sourceReferences(level int, refs []sourceReference) {
+	for _, ref := range refs {
+		id := resourceID(resourceReference{Resource: ref.Description})
+		if pointer, ok := imp.sources[id]; ok {
+			imp.builder.AddPointer(level, gedcom.TagSource, pointer)
+		}
+	}
+}
+
+func (imp *importer) family(f *family) {
+	imp.line(0, f.pointer, gedcom.TagFamily, "")
+
+	imp.personLine(1, gedcom.TagHusband, f.husband)
+	imp.personLine(1, gedcom.TagWife, f.wife)
+
+	for _, child := range f.children {
+		imp.personLine(1, gedcom.TagChild, child)
+	}
+
+	if f.couple != nil {
+		for _, fact := range f.couple.Facts {
+			imp.fact(fact)
+		}
+
+		imp.notes(1, f.couple.Notes)
+		imp.sourceReferences(1, f.couple.Sources)
+	}
+}
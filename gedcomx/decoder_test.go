@@ -0,0 +1,247 @@
+package gedcomx_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/gedcomx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const gedcomxJSON = `{
+  "persons": [
+    {
+      "id": "I1",
+      "gender": {"type": "http://gedcomx.org/Male"},
+      "names": [{
+        "nameForms": [{
+          "fullText": "John Smith",
+          "parts": [
+            {"type": "http://gedcomx.org/Given", "value": "John"},
+            {"type": "http://gedcomx.org/Surname", "value": "Smith"}
+          ]
+        }]
+      }],
+      "facts": [
+        {
+          "type": "http://gedcomx.org/Birth",
+          "date": {"original": "3 Mar 1890", "formal": "+1890-03-03"},
+          "place": {"original": "Springfield, Illinois"},
+          "sources": [{"description": "#S1"}]
+        },
+        {"type": "http://gedcomx.org/Occupation", "value": "Farmer"},
+        {"type": "data:,Funeral", "date": {"formal": "A+1950"}}
+      ],
+      "notes": [{"text": "First line\nSecond line"}]
+    },
+    {
+      "id": "I2",
+      "gender": {"type": "http://gedcomx.org/Female"},
+      "names": [{
+        "type": "http://gedcomx.org/MarriedName",
+        "nameForms": [{"fullText": "Jane Smith"}]
+      }]
+    },
+    {
+      "id": "I3",
+      "names": [{"nameForms": [{"fullText": "Baby"}]}]
+    },
+    {
+      "id": "I4",
+      "names": [{"nameForms": [{"fullText": "Other"}]}]
+    }
+  ],
+  "relationships": [
+    {
+      "id": "F1",
+      "type": "http://gedcomx.org/Couple",
+      "person1": {"resource": "#I2"},
+      "person2": {"resource": "#I1"},
+      "facts": [{"type": "http://gedcomx.org/Marriage", "date": {"formal": "+1910/+1920"}}]
+    },
+    {
+      "type": "http://gedcomx.org/ParentChild",
+      "person1": {"resource": "#I1"},
+      "person2": {"resource": "#I3"}
+    },
+    {
+      "type": "http://gedcomx.org/ParentChild",
+      "person1": {"resourceId": "I2"},
+      "person2": {"resource": "#I3"}
+    },
+    {
+      "type": "http://gedcomx.org/ParentChild",
+      "person1": {"resource": "https://example.com/persons/I2"},
+      "person2": {"resource": "#I4"}
+    }
+  ],
+  "sourceDescriptions": [
+    {
+      "id": "S1",
+      "titles": [{"value": "Parish register"}],
+      "citations": [{"value": "Parish register."}]
+    }
+  ]
+}`
+
+const expectedGEDCOM = `0 HEAD
+1 GEDC
+2 VERS 5.5.1
+2 FORM LINEAGE-LINKED
+1 CHAR UTF-8
+0 @I1@ INDI
+1 NAME John /Smith/
+2 GIVN John
+2 SURN Smith
+1 SEX M
+1 BIRT
+2 DATE 3 Mar 1890
+2 PLAC Springfield, Illinois
+2 SOUR @S1@
+1 OCCU Farmer
+1 EVEN
+2 TYPE Funeral
+2 DATE ABT 1950
+1 FAMS @F1@
+1 NOTE First line
+2 CONT Second line
+0 @I2@ INDI
+1 NAME Jane Smith
+2 TYPE married
+1 SEX F
+1 FAMS @F1@
+1 FAMS @F2@
+0 @I3@ INDI
+1 NAME Baby
+1 FAMC @F1@
+0 @I4@ INDI
+1 NAME Other
+1 FAMC @F2@
+0 @F1@ FAM
+1 HUSB @I1@
+1 WIFE @I2@
+1 CHIL @I3@
+1 MARR
+2 DATE FROM 1910 TO 1920
+0 @F2@ FAM
+1 WIFE @I2@
+1 CHIL @I4@
+0 @S1@ SOUR
+1 TITL Parish register
+0 TRLR
+`
+
+func TestDecoder_Decode(t *testing.T) {
+	document, err := gedcomx.NewDecoder(strings.NewReader(gedcomxJSON)).Decode()
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedGEDCOM, document.String())
+
+	family := document.Families().ByPointer("F1")
+	require.NotNil(t, family)
+	assert.Equal(t, "John Smith", family.Husband().Individual().Name().String())
+	assert.Equal(t, "Baby", family.Children()[0].Individual().Name().String())
+}
+
+func TestDecoder_DecodeInvalid(t *testing.T) {
+	_, err := gedcomx.NewDecoder(strings.NewReader("0 HEAD")).Decode()
+
+	assert.Error(t, err)
+}
+
+func TestDecoder_DecodeFormalDates(t *testing.T) {
+	for formal, expected := range map[string]string{
+		"+1890":              "1890",
+		"+1890-03":           "MAR 1890",
+		"+1890-03-12":        "12 MAR 1890",
+		"+1890-03-12T10:00Z": "12 MAR 1890",
+		"A+1890":             "ABT 1890",
+		"/+1890":             "BEF 1890",
+		"+1890/":             "AFT 1890",
+		"+1890/+1900":        "FROM 1890 TO 1900",
+		"A+1890/+1900":       "BET 1890 AND 1900",
+		"P10Y":               "",
+		"1890":               "",
+	} {
+		t.Run(formal, func(t *testing.T) {
+			json := `{"persons": [{"id": "P1", "facts": [{"type": "http://gedcomx.org/Birth", "date": {"formal": "` + formal + `"}}]}]}`
+
+			document, err := gedcomx.NewDecoder(strings.NewReader(json)).Decode()
+			require.NoError(t, err)
+
+			birth := gedcom.First(gedcom.NodesWithTag(document.Individuals()[0], gedcom.TagBirth))
+			assert.Equal(t, expected, firstValue(birth, gedcom.TagDate))
+		})
+	}
+}
+
+func firstValue(node gedcom.Node, tag gedcom.Tag) string {
+	if child := gedcom.First(gedcom.NodesWithTag(node, tag)); child != nil {
+		return child.Value()
+	}
+
+	return ""
+}
+
+func TestDecoder_DecodeUnsafeValues(t *testing.T) {
+	json := `{
+  "persons": [
+    {
+      "id": "I2 x",
+      "names": [{"nameForms": [{"fullText": "@John@ Smith"}]}],
+      "notes": [{"text": "first\rsecond"}]
+    },
+    {"id": "I2", "names": [{"nameForms": [{"fullText": "Jane"}]}]}
+  ],
+  "relationships": [
+    {
+      "type": "http://gedcomx.org/Couple",
+      "person1": {"resource": "#I2 x"},
+      "person2": {"resource": "#I2"}
+    }
+  ]
+}`
+
+	document, err := gedcomx.NewDecoder(strings.NewReader(json)).Decode()
+	require.NoError(t, err)
+
+	assert.Equal(t, `0 HEAD
+1 GEDC
+2 VERS 5.5.1
+2 FORM LINEAGE-LINKED
+1 CHAR UTF-8
+0 @I1@ INDI
+1 NAME @@John@@ Smith
+1 FAMS @F1@
+1 NOTE first
+2 CONT second
+0 @I2@ INDI
+1 NAME Jane
+1 FAMS @F1@
+0 @F1@ FAM
+1 HUSB @I1@
+1 WIFE @I2@
+0 TRLR
+`, document.String())
+
+	// The document can be decoded again.
+	_, err = gedcom.NewDocumentFromString(document.String())
+	assert.NoError(t, err)
+}
+
+func TestIsGEDCOMX(t *testing.T) {
+	for data, expected := range map[string]bool{
+		gedcomxJSON:                          true,
+		`{"relationships": []}`:              true,
+		`{"description": {}, "persons": []}`: true,
+		`{"ID": "P1", "Name": "Bob"}`:        false,
+		`[{"persons": []}]`:                  false,
+		`{"a": {"persons": []}}`:             false,
+		"0 HEAD\n1 CHAR UTF-8":               false,
+		"":                                   false,
+	} {
+		assert.Equal(t, expected, gedcomx.IsGEDCOMX(strings.NewReader(data)), data)
+	}
+}
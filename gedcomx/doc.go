@@ -0,0 +1,32 @@
+// Package gedcomx converts between GEDCOM X JSON and gedcom.Document.
+//
+// GEDCOM X (https://github.com/FamilySearch/gedcomx) is the data model used by
+// the FamilySearch API and many newer tools. The JSON serialization is read
+// and written with:
+//
+//   document, err := gedcomx.NewDecoder(r).Decode()
+//
+//   err := gedcomx.NewEncoder(w, document).Encode()
+//
+// Mapping
+//
+// Each IndividualNode is a person, and the pointer of the individual is the id
+// of the person. Names, the sex and all of the events (such as BIRT and OCCU)
+// become names, the gender and facts of the person.
+//
+// Each FamilyNode is a Couple relationship between the husband and wife, with
+// a ParentChild relationship from each parent to each child. The events of the
+// family (such as MARR) are the facts of the Couple relationship. When
+// decoding, ParentChild relationships that do not have a matching Couple
+// relationship create a family with only those parents.
+//
+// SOUR records are source descriptions. A SOUR of an individual, family or
+// event is a source reference to the source description.
+//
+// Dates are written with both the original value and, when it can be
+// calculated, the formal value, like "+1890-03-12". When decoding, the
+// original value is preferred.
+//
+// Information that has no equivalent, such as citation pages, is not
+// converted.
+package gedcomx
@@ -0,0 +1,319 @@
+package gedcomx
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/elliotchance/gedcom/v39"
+)
+
+// Encoder writes GEDCOM X JSON.
+type Encoder struct {
+	w        io.Writer
+	document *gedcom.Document
+}
+
+// NewEncoder creates an encoder that will write the document as GEDCOM X
+// JSON.
+func NewEncoder(w io.Writer, document *gedcom.Document) *Encoder {
+	return &Encoder{
+		w:        w,
+		document: document,
+	}
+}
+
+// Encode writes the entire document.
+func (enc *Encoder) Encode() error {
+	encoder := json.NewEncoder(enc.w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(newExporter(enc.document).root())
+}
+
+// exporter converts a Document into the GEDCOM X model.
+type exporter struct {
+	document *gedcom.Document
+	r        *root
+
+	// ids contains all of the ids (and pointers) that have been used.
+	ids map[string]bool
+}
+
+func newExporter(document *gedcom.Document) *exporter {
+	exp := &exporter{
+		document: document,
+		r:        &root{},
+		ids:      map[string]bool{},
+	}
+
+	for _, node := range document.Nodes() {
+		exp.ids[node.Pointer()] = true
+	}
+
+	return exp
+}
+
+func (exp *exporter) root() *root {
+	for _, individual := range exp.document.Individuals() {
+		exp.person(individual)
+	}
+
+	for _, f := range exp.document.Families() {
+		exp.relationships(f)
+	}
+
+	for _, s := range exp.document.Sources() {
+		description := sourceDescription{
+			ID:    s.Pointer(),
+			Notes: exp.notes(s),
+		}
+
+		if title := exp.text(s.Title()); title != "" {
+			description.Titles = []textValue{{title}}
+		}
+
+		if citation := exp.sourceCitation(s); citation != "" {
+			description.Citations = []textValue{{citation}}
+		}
+
+		exp.r.SourceDescriptions = append(exp.r.SourceDescriptions, description)
+	}
+
+	return exp.r
+}
+
+// sourceCitation is the author, title and publication facts of the source,
+// like "John Smith. Parish register. London, 1890.".
+func (exp *exporter) sourceCitation(s *gedcom.SourceNode) string {
+	parts := []string{}
+	for _, tag := range []gedcom.Tag{
+		gedcom.TagAuthor, gedcom.TagTitle, gedcom.TagPublication,
+	} {
+		if child := gedcom.First(gedcom.NodesWithTag(s, tag)); child != nil {
+			parts = append(parts, strings.TrimSuffix(exp.text(child.Value()), ".")+".")
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// newID returns an id that has not been used, like "S3".
+func (exp *exporter) newID(prefix string) string {
+	for i := 1; ; i++ {
+		id := fmt.Sprintf("%s%d", prefix, i)
+		if !exp.ids[id] {
+			exp.ids[id] = true
+
+			return id
+		}
+	}
+}
+
+// recordPointer returns the pointer of a node that points to a record, like
+// "@S1@". The second value will be false if the value is not a pointer.
+func recordPointer(node gedcom.Node) (string, bool) {
+	value := node.Value()
+	if len(value) > 2 && strings.HasPrefix(value, "@") &&
+		strings.HasSuffix(value, "@") {
+		return value[1 : len(value)-1], true
+	}
+
+	return "", false
+}
+
+func resource(pointer string) resourceReference {
+	return resourceReference{Resource: "#" + pointer}
+}
+
+func (exp *exporter) person(individual *gedcom.IndividualNode) {
+	p := person{
+		ID:      individual.Pointer(),
+		Notes:   exp.notes(individual),
+		Sources: exp.sourceReferences(individual),
+	}
+
+	if sex := individual.Sex(); sex != nil {
+		for t, s := range genders {
+			if s == sex.Value() {
+				p.Gender = &gender{typePrefix + t}
+			}
+		}
+	}
+
+	for _, n := range individual.Names() {
+		p.Names = append(p.Names, exp.name(n))
+	}
+
+	p.Facts = exp.facts(individual)
+
+	exp.r.Persons = append(exp.r.Persons, p)
+}
+
+func (exp *exporter) name(n *gedcom.NameNode) name {
+	form := nameForm{
+		FullText: exp.text(n.String()),
+	}
+
+	surname := gedcom.CleanSpace(n.SurnamePrefix() + " " + n.Surname())
+	for _, part := range []namePart{
+		{partPrefix, exp.text(n.Prefix())},
+		{partGiven, exp.text(n.GivenName())},
+		{partSurname, exp.text(surname)},
+		{partSuffix, exp.text(n.Suffix())},
+	} {
+		if part.Value != "" {
+			form.Parts = append(form.Parts, part)
+		}
+	}
+
+	return name{
+		Type:      nameTypeForNameType(n.Type()),
+		NameForms: []nameForm{form},
+	}
+}
+
+// facts returns a fact for each event of the individual or family.
+func (exp *exporter) facts(node gedcom.Node) (facts []fact) {
+	for _, child := range node.Nodes() {
+		tag := child.Tag()
+		f := fact{
+			Type: factTypeForTag(tag),
+		}
+
+		switch {
+		case tag.Is(gedcom.TagEvent):
+			f.Type = customTypePrefix + exp.firstText(child, gedcom.TagType)
+			f.Value = exp.text(child.Value())
+
+		case f.Type == "":
+			continue
+
+		case isValueTag(tag):
+			f.Value = exp.text(child.Value())
+		}
+
+		if d, ok := gedcom.First(gedcom.NodesWithTag(child, gedcom.TagDate)).(*gedcom.DateNode); ok {
+			f.Date = &date{
+				Original: exp.text(d.Value()),
+				Formal:   formalDate(d, exp.document.DateLocales),
+			}
+		}
+
+		if place := exp.firstText(child, gedcom.TagPlace); place != "" {
+			f.Place = &placeReference{place}
+		}
+
+		f.Notes = exp.notes(child)
+		f.Sources = exp.sourceReferences(child)
+
+		facts = append(facts, f)
+	}
+
+	return
+}
+
+func firstValue(node gedcom.Node, tag gedcom.Tag) string {
+	if child := gedcom.First(gedcom.NodesWithTag(node, tag)); child != nil {
+		return child.Value()
+	}
+
+	return ""
+}
+
+// text removes the "@@" escapes from a GEDCOM value because they are not
+// needed (or understood) by GEDCOM X.
+func (exp *exporter) text(value string) string {
+	return exp.document.Version.UnescapeValue(value)
+}
+
+// firstText is the text of the first child with the tag. See text.
+func (exp *exporter) firstText(node gedcom.Node, tag gedcom.Tag) string {
+	return exp.text(firstValue(node, tag))
+}
+
+// notes returns each NOTE of the node. The text of notes that point to a NOTE
+// record are included, if the record exists.
+func (exp *exporter) notes(node gedcom.Node) (notes []note) {
+	for _, child := range gedcom.NodesWithTag(node, gedcom.TagNote) {
+		text := child.Value()
+
+		if pointer, ok := recordPointer(child); ok {
+			record := exp.document.NodeByPointer(pointer)
+			if record == nil {
+				continue
+			}
+
+			text = record.Value()
+		}
+
+		notes = append(notes, note{exp.text(text)})
+	}
+
+	return
+}
+
+// sourceReferences returns a reference for each SOUR of the node. A SOUR that
+// is not a pointer to a SOUR record creates a new source description with the
+// value as its title.
+func (exp *exporter) sourceReferences(node gedcom.Node) (refs []sourceReference) {
+	for _, child := range gedcom.NodesWithTag(node, gedcom.TagSource) {
+		pointer, ok := recordPointer(child)
+		if !ok {
+			pointer = exp.newID("S")
+			exp.r.SourceDescriptions = append(exp.r.SourceDescriptions,
+				sourceDescription{
+					ID:        pointer,
+					Titles:    []textValue{{exp.text(child.Value())}},
+					Citations: []textValue{{exp.text(child.Value())}},
+				})
+		}
+
+		refs = append(refs, sourceReference{"#" + pointer})
+	}
+
+	return
+}
+
+// relationships adds the Couple relationship for the husband and wife, and a
+// ParentChild relationship from each parent to each child.
+func (exp *exporter) relationships(f *gedcom.FamilyNode) {
+	parents := []string{}
+	for _, parent := range []gedcom.Node{f.Husband(), f.Wife()} {
+		if gedcom.IsNil(parent) {
+			continue
+		}
+
+		if pointer, ok := recordPointer(parent); ok {
+			parents = append(parents, pointer)
+		}
+	}
+
+	if len(parents) == 2 {
+		exp.r.Relationships = append(exp.r.Relationships, relationship{
+			ID:      f.Pointer(),
+			Type:    typeCouple,
+			Person1: resource(parents[0]),
+			Person2: resource(parents[1]),
+			Facts:   exp.facts(f),
+			Notes:   exp.notes(f),
+			Sources: exp.sourceReferences(f),
+		})
+	}
+
+	for _, child := range f.Children() {
+		childPointer, ok := recordPointer(child)
+		if !ok {
+			continue
+		}
+
+		for _, parent := range parents {
+			exp.r.Relationships = append(exp.r.Relationships, relationship{
+				Type:    typeParentChild,
+				Person1: resource(parent),
+				Person2: resource(childPointer),
+			})
+		}
+	}
+}
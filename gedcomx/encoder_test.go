@@ -0,0 +1,235 @@
+package gedcomx_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/gedcomx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encode(t *testing.T, document *gedcom.Document) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	require.NoError(t, gedcomx.NewEncoder(buf, document).Encode())
+
+	return buf
+}
+
+func TestEncoder_Encode(t *testing.T) {
+	document, err := gedcom.NewDocumentFromString(`0 HEAD
+0 @I1@ INDI
+1 NAME Dr John /Smith/
+2 NPFX Dr
+2 GIVN John
+2 SURN Smith
+1 SEX M
+1 BIRT
+2 DATE Abt. 1890
+2 SOUR @S1@
+1 NOTE @N1@
+0 @I2@ INDI
+1 NAME Jane /Doe/
+1 SEX F
+0 @I3@ INDI
+1 NAME Baby
+0 @F1@ FAM
+1 HUSB @I1@
+1 WIFE @I2@
+1 CHIL @I3@
+1 MARR
+2 PLAC London
+0 @S1@ SOUR
+1 AUTH St Mary
+1 TITL Parish register
+0 @N1@ NOTE A note
+0 TRLR
+`)
+	require.NoError(t, err)
+
+	var result map[string]interface{}
+	require.NoError(t, json.Unmarshal(encode(t, document).Bytes(), &result))
+
+	expected := map[string]interface{}{
+		"persons": []interface{}{
+			map[string]interface{}{
+				"id":     "I1",
+				"gender": map[string]interface{}{"type": "http://gedcomx.org/Male"},
+				"names": []interface{}{
+					map[string]interface{}{
+						"nameForms": []interface{}{
+							map[string]interface{}{
+								"fullText": "Dr John Smith",
+								"parts": []interface{}{
+									map[string]interface{}{"type": "http://gedcomx.org/Prefix", "value": "Dr"},
+									map[string]interface{}{"type": "http://gedcomx.org/Given", "value": "John"},
+									map[string]interface{}{"type": "http://gedcomx.org/Surname", "value": "Smith"},
+								},
+							},
+						},
+					},
+				},
+				"facts": []interface{}{
+					map[string]interface{}{
+						"type": "http://gedcomx.org/Birth",
+						"date": map[string]interface{}{
+							"original": "Abt. 1890",
+							"formal":   "A+1890",
+						},
+						"sources": []interface{}{
+							map[string]interface{}{"description": "#S1"},
+						},
+					},
+				},
+				"notes": []interface{}{
+					map[string]interface{}{"text": "A note"},
+				},
+			},
+			map[string]interface{}{
+				"id":     "I2",
+				"gender": map[string]interface{}{"type": "http://gedcomx.org/Female"},
+				"names": []interface{}{
+					map[string]interface{}{
+						"nameForms": []interface{}{
+							map[string]interface{}{
+								"fullText": "Jane Doe",
+								"parts": []interface{}{
+									map[string]interface{}{"type": "http://gedcomx.org/Given", "value": "Jane"},
+									map[string]interface{}{"type": "http://gedcomx.org/Surname", "value": "Doe"},
+								},
+							},
+						},
+					},
+				},
+			},
+			map[string]interface{}{
+				"id": "I3",
+				"names": []interface{}{
+					map[string]interface{}{
+						"nameForms": []interface{}{
+							map[string]interface{}{
+								"fullText": "Baby",
+								"parts": []interface{}{
+									map[string]interface{}{"type": "http://gedcomx.org/Given", "value": "Baby"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"relationships": []interface{}{
+			map[string]interface{}{
+				"id":      "F1",
+				"type":    "http://gedcomx.org/Couple",
+				"person1": map[string]interface{}{"resource": "#I1"},
+				"person2": map[string]interface{}{"resource": "#I2"},
+				"facts": []interface{}{
+					map[string]interface{}{
+						"type":  "http://gedcomx.org/Marriage",
+						"place": map[string]interface{}{"original": "London"},
+					},
+				},
+			},
+			map[string]interface{}{
+				"type":    "http://gedcomx.org/ParentChild",
+				"person1": map[string]interface{}{"resource": "#I1"},
+				"person2": map[string]interface{}{"resource": "#I3"},
+			},
+			map[string]interface{}{
+				"type":    "http://gedcomx.org/ParentChild",
+				"person1": map[string]interface{}{"resource": "#I2"},
+				"person2": map[string]interface{}{"resource": "#I3"},
+			},
+		},
+		"sourceDescriptions": []interface{}{
+			map[string]interface{}{
+				"id":        "S1",
+				"titles":    []interface{}{map[string]interface{}{"value": "Parish register"}},
+				"citations": []interface{}{map[string]interface{}{"value": "St Mary. Parish register."}},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, result)
+}
+
+func TestEncoder_EncodeRoundTrip(t *testing.T) {
+	document, err := gedcomx.NewDecoder(strings.NewReader(gedcomxJSON)).Decode()
+	require.NoError(t, err)
+
+	result, err := gedcomx.NewDecoder(encode(t, document)).Decode()
+	require.NoError(t, err)
+
+	// The name without parts will now have a GIVN.
+	expected := strings.Replace(expectedGEDCOM,
+		"1 NAME Jane Smith\n2 TYPE married\n",
+		"1 NAME Jane Smith\n2 TYPE married\n2 GIVN Jane Smith\n", 1)
+	expected = strings.Replace(expected,
+		"1 NAME Baby\n", "1 NAME Baby\n2 GIVN Baby\n", 1)
+	expected = strings.Replace(expected,
+		"1 NAME Other\n", "1 NAME Other\n2 GIVN Other\n", 1)
+
+	assert.Equal(t, expected, result.String())
+}
+
+func TestEncoder_EncodeEscapes(t *testing.T) {
+	ged := "0 HEAD\n1 GEDC\n2 VERS 5.5.1\n0 @I1@ INDI\n1 NAME John /Smith@@home/\n1 OCCU @@work\n1 NOTE me@@example.com\n"
+	document, err := gedcom.NewDecoder(strings.NewReader(ged)).Decode()
+	require.NoError(t, err)
+
+	json := encode(t, document).String()
+	assert.Contains(t, json, `"fullText": "John Smith@home"`)
+	assert.Contains(t, json, `"value": "@work"`)
+	assert.Contains(t, json, `"text": "me@example.com"`)
+
+	result, err := gedcomx.NewDecoder(encode(t, document)).Decode()
+	require.NoError(t, err)
+
+	individual := result.Individuals()[0]
+	assert.Equal(t, "Smith@@home", individual.Name().Surname())
+	assert.Equal(t, "@@work", firstValue(individual, gedcom.TagOccupation))
+	assert.Equal(t, "me@@example.com", firstValue(individual, gedcom.TagNote))
+}
+
+func TestEncoder_EncodeFormalDates(t *testing.T) {
+	for value, expected := range map[string]string{
+		"12 MAR 1890":               "+1890-03-12",
+		"MAR 1890":                  "+1890-03",
+		"1890":                      "+1890",
+		"ABT 1890":                  "A+1890",
+		"EST 1890":                  "A+1890",
+		"BEF 1890":                  "/+1890",
+		"AFT 1890":                  "+1890/",
+		"FROM 1890":                 "+1890/",
+		"TO 1890":                   "/+1890",
+		"FROM 1890 TO 1900":         "+1890/+1900",
+		"BET 1890 AND 1900":         "A+1890/+1900",
+		"@#DJULIAN@ 12 FEB 1699/00": "",
+		"(during the war)":          "",
+	} {
+		t.Run(value, func(t *testing.T) {
+			document := gedcom.NewDocument()
+			document.AddIndividual("P1",
+				gedcom.NewBirthNode("", gedcom.NewDateNode(value)))
+
+			var result struct {
+				Persons []struct {
+					Facts []struct {
+						Date struct {
+							Original, Formal string
+						}
+					}
+				}
+			}
+			require.NoError(t, json.Unmarshal(encode(t, document).Bytes(), &result))
+
+			date := result.Persons[0].Facts[0].Date
+			assert.Equal(t, value, date.Original)
+			assert.Equal(t, expected, date.Formal)
+		})
+	}
+}
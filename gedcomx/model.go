@@ -0,0 +1,90 @@
+package gedcomx
+
+// The types in this file are the parts of the GEDCOM X JSON model that can be
+// represented in GEDCOM. All other properties are ignored.
+
+type root struct {
+	Persons            []person            `json:"persons,omitempty"`
+	Relationships      []relationship      `json:"relationships,omitempty"`
+	SourceDescriptions []sourceDescription `json:"sourceDescriptions,omitempty"`
+}
+
+type person struct {
+	ID      string            `json:"id,omitempty"`
+	Gender  *gender           `json:"gender,omitempty"`
+	Names   []name            `json:"names,omitempty"`
+	Facts   []fact            `json:"facts,omitempty"`
+	Sources []sourceReference `json:"sources,omitempty"`
+	Notes   []note            `json:"notes,omitempty"`
+}
+
+type gender struct {
+	Type string `json:"type"`
+}
+
+type name struct {
+	Type      string     `json:"type,omitempty"`
+	NameForms []nameForm `json:"nameForms,omitempty"`
+}
+
+type nameForm struct {
+	FullText string     `json:"fullText,omitempty"`
+	Parts    []namePart `json:"parts,omitempty"`
+}
+
+type namePart struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type fact struct {
+	Type    string            `json:"type"`
+	Date    *date             `json:"date,omitempty"`
+	Place   *placeReference   `json:"place,omitempty"`
+	Value   string            `json:"value,omitempty"`
+	Sources []sourceReference `json:"sources,omitempty"`
+	Notes   []note            `json:"notes,omitempty"`
+}
+
+type date struct {
+	Original string `json:"original,omitempty"`
+	Formal   string `json:"formal,omitempty"`
+}
+
+type placeReference struct {
+	Original string `json:"original,omitempty"`
+}
+
+type sourceReference struct {
+	Description string `json:"description"`
+}
+
+type note struct {
+	Text string `json:"text"`
+}
+
+type relationship struct {
+	ID      string            `json:"id,omitempty"`
+	Type    string            `json:"type"`
+	Person1 resourceReference `json:"person1"`
+	Person2 resourceReference `json:"person2"`
+	Facts   []fact            `json:"facts,omitempty"`
+	Sources []sourceReference `json:"sources,omitempty"`
+	Notes   []note            `json:"notes,omitempty"`
+}
+
+type resourceReference struct {
+	Resource   string `json:"resource,omitempty"`
+	ResourceID string `json:"resourceId,omitempty"`
+}
+
+type sourceDescription struct {
+	ID        string      `json:"id,omitempty"`
+	Citations []textValue `json:"citations,omitempty"`
+	Titles    []textValue `json:"titles,omitempty"`
+	Notes     []note      `json:"notes,omitempty"`
+}
+
+type textValue struct {
+	Value string `json:"value"`
+}
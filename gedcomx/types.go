@@ -0,0 +1,125 @@
+package gedcomx
+
+import (
+	"strings"
+
+	"github.com/elliotchance/gedcom/v39"
+)
+
+// typePrefix is the prefix of all of the types that are defined by GEDCOM X.
+const typePrefix = "http://gedcomx.org/"
+
+// customTypePrefix is used for types that are not defined by GEDCOM X, like
+// "data:,Funeral".
+const customTypePrefix = "data:,"
+
+const (
+	typeCouple      = typePrefix + "Couple"
+	typeParentChild = typePrefix + "ParentChild"
+)
+
+// factTypes maps the GEDCOM X fact types onto GEDCOM tags. Fact types that are
+// not listed here become an EVEN with a TYPE.
+var factTypes = map[string]gedcom.Tag{
+	"Adoption":         gedcom.TagAdoption,
+	"AdultChristening": gedcom.TagAdultChristening,
+	"Annulment":        gedcom.TagAnnulment,
+	"Baptism":          gedcom.TagBaptism,
+	"BarMitzvah":       gedcom.TagBarMitzvah,
+	"BatMitzvah":       gedcom.TagBasMitzvah,
+	"Birth":            gedcom.TagBirth,
+	"Blessing":         gedcom.TagBlessing,
+	"Burial":           gedcom.TagBurial,
+	"Census":           gedcom.TagCensus,
+	"Christening":      gedcom.TagChristening,
+	"Confirmation":     gedcom.TagConfirmation,
+	"Cremation":        gedcom.TagCremation,
+	"Death":            gedcom.TagDeath,
+	"Divorce":          gedcom.TagDivorce,
+	"DivorceFiling":    gedcom.TagDivorceFiled,
+	"Education":        gedcom.TagEducation,
+	"Emigration":       gedcom.TagEmigration,
+	"Engagement":       gedcom.TagEngagement,
+	"FirstCommunion":   gedcom.TagFirstCommunion,
+	"Graduation":       gedcom.TagGraduation,
+	"Immigration":      gedcom.TagImmigration,
+	"Marriage":         gedcom.TagMarriage,
+	"MarriageBanns":    gedcom.TagMarriageBann,
+	"MarriageContract": gedcom.TagMarriageContract,
+	"MarriageLicense":  gedcom.TagMarriageLicence,
+	"Naturalization":   gedcom.TagNaturalization,
+	"Occupation":       gedcom.TagOccupation,
+	"Ordination":       gedcom.TagOrdination,
+	"Probate":          gedcom.TagProbate,
+	"Property":         gedcom.TagProperty,
+	"Religion":         gedcom.TagReligion,
+	"Residence":        gedcom.TagResidence,
+	"Retirement":       gedcom.TagRetirement,
+	"Will":             gedcom.TagWill,
+}
+
+// valueTags are the GEDCOM events where the value is the value of the fact.
+// For example, the value of OCCU is the occupation.
+var valueTags = []gedcom.Tag{
+	gedcom.TagEducation, gedcom.TagOccupation, gedcom.TagReligion,
+	gedcom.TagProperty,
+}
+
+func isValueTag(tag gedcom.Tag) bool {
+	for _, valueTag := range valueTags {
+		if valueTag.Is(tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// factTypeForTag is the inverse of factTypes. The result will be empty if
+// there is no equivalent fact type.
+func factTypeForTag(tag gedcom.Tag) string {
+	for factType, factTag := range factTypes {
+		if factTag.Is(tag) {
+			return typePrefix + factType
+		}
+	}
+
+	return ""
+}
+
+// typeName removes the prefix from a type, like "Birth" for
+// "http://gedcomx.org/Birth".
+func typeName(t string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(t, typePrefix), customTypePrefix)
+}
+
+var nameTypes = map[string]gedcom.NameType{
+	"BirthName":   gedcom.NameTypeMaidenName,
+	"MarriedName": gedcom.NameTypeMarriedName,
+	"AlsoKnownAs": gedcom.NameTypeAlsoKnownAs,
+	"Nickname":    gedcom.NameTypeNickname,
+}
+
+func nameTypeForNameType(nameType gedcom.NameType) string {
+	for t, n := range nameTypes {
+		if n == nameType {
+			return typePrefix + t
+		}
+	}
+
+	return ""
+}
+
+var genders = map[string]string{
+	"Male":    gedcom.SexMale,
+	"Female":  gedcom.SexFemale,
+	"Unknown": gedcom.SexUnknown,
+}
+
+// Name part types.
+const (
+	partPrefix  = typePrefix + "Prefix"
+	partGiven   = typePrefix + "Given"
+	partSurname = typePrefix + "Surname"
+	partSuffix  = typePrefix + "Suffix"
+)
@@ -0,0 +1,193 @@
+package gramps
+
+import "encoding/xml"
+
+// Namespace is the XML namespace of the Gramps XML version that is written by
+// the Encoder. The Decoder accepts any version.
+const Namespace = "http://gramps-project.org/xml/1.7.1/"
+
+// The types in this file mirror the elements of the Gramps XML DTD. Only the
+// elements that can be represented in GEDCOM are included. The order of the
+// fields is important because it is the order that elements are written.
+
+type database struct {
+	XMLName      xml.Name     `xml:"database"`
+	Namespace    string       `xml:"xmlns,attr,omitempty"`
+	Header       header       `xml:"header"`
+	Events       []event      `xml:"events>event"`
+	People       []person     `xml:"people>person"`
+	Families     []family     `xml:"families>family"`
+	Citations    []citation   `xml:"citations>citation"`
+	Sources      []source     `xml:"sources>source"`
+	Places       []place      `xml:"places>placeobj"`
+	Repositories []repository `xml:"repositories>repository"`
+	Notes        []note       `xml:"notes>note"`
+}
+
+type header struct {
+	Created created `xml:"created"`
+}
+
+type created struct {
+	Date    string `xml:"date,attr"`
+	Version string `xml:"version,attr"`
+}
+
+type ref struct {
+	Handle string `xml:"hlink,attr"`
+}
+
+type eventRef struct {
+	Handle string `xml:"hlink,attr"`
+	Role   string `xml:"role,attr,omitempty"`
+}
+
+type childRef struct {
+	Handle       string `xml:"hlink,attr"`
+	MotherRel    string `xml:"mrel,attr,omitempty"`
+	FatherRel    string `xml:"frel,attr,omitempty"`
+	CitationRefs []ref  `xml:"citationref"`
+}
+
+type repoRef struct {
+	Handle     string `xml:"hlink,attr"`
+	CallNumber string `xml:"callno,attr,omitempty"`
+}
+
+type person struct {
+	Handle       string     `xml:"handle,attr"`
+	ID           string     `xml:"id,attr,omitempty"`
+	Gender       string     `xml:"gender"`
+	Names        []name     `xml:"name"`
+	EventRefs    []eventRef `xml:"eventref"`
+	ChildOf      []ref      `xml:"childof"`
+	ParentIn     []ref      `xml:"parentin"`
+	NoteRefs     []ref      `xml:"noteref"`
+	CitationRefs []ref      `xml:"citationref"`
+}
+
+type name struct {
+	Alt          string    `xml:"alt,attr,omitempty"`
+	Type         string    `xml:"type,attr,omitempty"`
+	First        string    `xml:"first,omitempty"`
+	Call         string    `xml:"call,omitempty"`
+	Surnames     []surname `xml:"surname"`
+	Suffix       string    `xml:"suffix,omitempty"`
+	Title        string    `xml:"title,omitempty"`
+	Nick         string    `xml:"nick,omitempty"`
+	NoteRefs     []ref     `xml:"noteref"`
+	CitationRefs []ref     `xml:"citationref"`
+}
+
+type surname struct {
+	Prefix  string `xml:"prefix,attr,omitempty"`
+	Primary string `xml:"prim,attr,omitempty"`
+	Value   string `xml:",chardata"`
+}
+
+type family struct {
+	Handle       string     `xml:"handle,attr"`
+	ID           string     `xml:"id,attr,omitempty"`
+	Rel          *relation  `xml:"rel"`
+	Father       *ref       `xml:"father"`
+	Mother       *ref       `xml:"mother"`
+	EventRefs    []eventRef `xml:"eventref"`
+	ChildRefs    []childRef `xml:"childref"`
+	NoteRefs     []ref      `xml:"noteref"`
+	CitationRefs []ref      `xml:"citationref"`
+}
+
+type relation struct {
+	Type string `xml:"type,attr"`
+}
+
+type event struct {
+	Handle       string     `xml:"handle,attr"`
+	ID           string     `xml:"id,attr,omitempty"`
+	Type         string     `xml:"type,omitempty"`
+	DateRange    *dateRange `xml:"daterange"`
+	DateSpan     *dateRange `xml:"datespan"`
+	DateVal      *dateVal   `xml:"dateval"`
+	DateStr      *dateStr   `xml:"datestr"`
+	Place        *ref       `xml:"place"`
+	Description  string     `xml:"description,omitempty"`
+	NoteRefs     []ref      `xml:"noteref"`
+	CitationRefs []ref      `xml:"citationref"`
+}
+
+// dateRange is used for both the daterange ("between") and datespan ("from")
+// elements. Only the name of the attributes are different.
+type dateRange struct {
+	Start    string `xml:"start,attr"`
+	Stop     string `xml:"stop,attr"`
+	Quality  string `xml:"quality,attr,omitempty"`
+	CFormat  string `xml:"cformat,attr,omitempty"`
+	DualDate string `xml:"dualdated,attr,omitempty"`
+}
+
+type dateVal struct {
+	Value    string `xml:"val,attr"`
+	Type     string `xml:"type,attr,omitempty"`
+	Quality  string `xml:"quality,attr,omitempty"`
+	CFormat  string `xml:"cformat,attr,omitempty"`
+	DualDate string `xml:"dualdated,attr,omitempty"`
+}
+
+type dateStr struct {
+	Value string `xml:"val,attr"`
+}
+
+type citation struct {
+	Handle     string `xml:"handle,attr"`
+	ID         string `xml:"id,attr,omitempty"`
+	Page       string `xml:"page,omitempty"`
+	Confidence int    `xml:"confidence"`
+	NoteRefs   []ref  `xml:"noteref"`
+	SourceRef  ref    `xml:"sourceref"`
+}
+
+type source struct {
+	Handle   string    `xml:"handle,attr"`
+	ID       string    `xml:"id,attr,omitempty"`
+	Title    string    `xml:"stitle,omitempty"`
+	Author   string    `xml:"sauthor,omitempty"`
+	PubInfo  string    `xml:"spubinfo,omitempty"`
+	Abbrev   string    `xml:"sabbrev,omitempty"`
+	NoteRefs []ref     `xml:"noteref"`
+	RepoRefs []repoRef `xml:"reporef"`
+}
+
+type place struct {
+	Handle    string      `xml:"handle,attr"`
+	ID        string      `xml:"id,attr,omitempty"`
+	Type      string      `xml:"type,attr,omitempty"`
+	Title     string      `xml:"ptitle,omitempty"`
+	Names     []placeName `xml:"pname"`
+	Coord     *coord      `xml:"coord"`
+	PlaceRefs []ref       `xml:"placeref"`
+	NoteRefs  []ref       `xml:"noteref"`
+}
+
+type placeName struct {
+	Value string `xml:"value,attr"`
+}
+
+type coord struct {
+	Longitude string `xml:"long,attr"`
+	Latitude  string `xml:"lat,attr"`
+}
+
+type repository struct {
+	Handle   string `xml:"handle,attr"`
+	ID       string `xml:"id,attr,omitempty"`
+	Name     string `xml:"rname"`
+	Type     string `xml:"type"`
+	NoteRefs []ref  `xml:"noteref"`
+}
+
+type note struct {
+	Handle string `xml:"handle,attr"`
+	ID     string `xml:"id,attr,omitempty"`
+	Type   string `xml:"type,attr,omitempty"`
+	Text   string `xml:"text"`
+}
@@ -0,0 +1,224 @@
+package gramps
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elliotchance/gedcom/v39"
+)
+
+// calendars maps the Gramps "cformat" of a date onto the GEDCOM calendar.
+// Gramps calendars that are not listed here (such as "Islamic") cannot be
+// represented in GEDCOM and are kept as text.
+var calendars = map[string]gedcom.DateCalendar{
+	"":                  gedcom.DateCalendarGregorian,
+	"Gregorian":         gedcom.DateCalendarGregorian,
+	"Julian":            gedcom.DateCalendarJulian,
+	"Hebrew":            gedcom.DateCalendarHebrew,
+	"French Republican": gedcom.DateCalendarFrenchRepublican,
+}
+
+// gedcomDate returns the GEDCOM value for the date of the event. The result
+// will be empty if the event does not have a date.
+func (e *event) gedcomDate() string {
+	switch {
+	case e.DateVal != nil:
+		return e.DateVal.gedcomDate()
+
+	case e.DateRange != nil:
+		return e.DateRange.gedcomDate("BET %s AND %s")
+
+	case e.DateSpan != nil:
+		return e.DateSpan.gedcomDate("FROM %s TO %s")
+
+	case e.DateStr != nil:
+		return datePhrase(e.DateStr.Value)
+	}
+
+	return ""
+}
+
+func (val *dateVal) gedcomDate() string {
+	date, ok := parseDate(val.Value, val.CFormat, val.DualDate)
+	if !ok {
+		return datePhrase(val.Value)
+	}
+
+	keyword := map[string]string{
+		"about":  "ABT",
+		"before": "BEF",
+		"after":  "AFT",
+		"from":   "FROM",
+		"to":     "TO",
+	}[val.Type]
+
+	// GEDCOM does not allow a quality to be combined with the other keywords
+	// so the type takes precedence.
+	if keyword == "" {
+		keyword = map[string]string{
+			"estimated":  "EST",
+			"calculated": "CAL",
+		}[val.Quality]
+	}
+
+	return gedcom.CleanSpace(keyword + " " + date)
+}
+
+func (dr *dateRange) gedcomDate(format string) string {
+	start, ok := parseDate(dr.Start, dr.CFormat, dr.DualDate)
+	if !ok {
+		return datePhrase(dr.Start + " - " + dr.Stop)
+	}
+
+	stop, ok := parseDate(dr.Stop, dr.CFormat, dr.DualDate)
+	if !ok {
+		return datePhrase(dr.Start + " - " + dr.Stop)
+	}
+
+	return fmt.Sprintf(format, start, stop)
+}
+
+// datePhrase is text that could not be interpreted as a date.
+func datePhrase(text string) string {
+	return fmt.Sprintf("(%s)", text)
+}
+
+// parseDate converts a Gramps date value, like "1890-03-12", into the GEDCOM
+// form, like "12 MAR 1890". The month and day are optional.
+func parseDate(value, cformat, dualDated string) (string, bool) {
+	calendar, ok := calendars[cformat]
+	if !ok {
+		return "", false
+	}
+
+	parts := strings.Split(value, "-")
+	if len(parts) > 3 {
+		return "", false
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return "", false
+		}
+
+		numbers[i] = number
+	}
+
+	date := gedcom.Date{
+		Year:     numbers[0],
+		Month:    time.Month(numbers[1]),
+		Day:      numbers[2],
+		Calendar: calendar,
+	}
+
+	if date.Year == 0 {
+		return "", false
+	}
+
+	if dualDated == "1" {
+		date.DualYear = date.Year + 1
+	}
+
+	return strings.ToUpper(date.String()), true
+}
+
+// setDate sets the date of the event from a GEDCOM DATE. Dates that cannot be
+// represented by Gramps are kept as text.
+func (e *event) setDate(node *gedcom.DateNode, locales []*gedcom.DateLocale) {
+	value := gedcom.CleanSpace(node.Value())
+
+	quality := ""
+	for prefix, q := range map[string]string{
+		"EST ": "estimated",
+		"CAL ": "calculated",
+	} {
+		if strings.HasPrefix(strings.ToUpper(value), prefix) {
+			quality = q
+			value = value[len(prefix):]
+		}
+	}
+
+	dr := gedcom.NewDateRangeWithLocales(value, locales)
+	if dr.IsPhrase() || !dr.IsValid() || dr.ParseError() != nil {
+		e.DateStr = &dateStr{strings.Trim(node.Value(), "()")}
+
+		return
+	}
+
+	start, end := dr.StartAndEndDates()
+	cformat, ok := cformatForCalendar(start.Calendar)
+	if !ok {
+		e.DateStr = &dateStr{node.Value()}
+
+		return
+	}
+
+	dualDated := ""
+	if start.DualYear != 0 {
+		dualDated = "1"
+	}
+
+	switch {
+	case dr.IsOpenEnd():
+		e.DateVal = &dateVal{Value: isoDate(start), Type: "from",
+			CFormat: cformat, DualDate: dualDated}
+
+	case dr.IsOpenStart():
+		e.DateVal = &dateVal{Value: isoDate(end), Type: "to",
+			CFormat: cformat, DualDate: dualDated}
+
+	case dr.IsPeriod():
+		e.DateSpan = &dateRange{Start: isoDate(start), Stop: isoDate(end),
+			CFormat: cformat, DualDate: dualDated}
+
+	case dr.IsRange():
+		e.DateRange = &dateRange{Start: isoDate(start), Stop: isoDate(end),
+			CFormat: cformat, DualDate: dualDated}
+
+	default:
+		e.DateVal = &dateVal{
+			Value: isoDate(start),
+			Type: map[gedcom.DateConstraint]string{
+				gedcom.DateConstraintAbout:  "about",
+				gedcom.DateConstraintBefore: "before",
+				gedcom.DateConstraintAfter:  "after",
+			}[start.Constraint],
+			Quality:  quality,
+			CFormat:  cformat,
+			DualDate: dualDated,
+		}
+	}
+}
+
+// cformatForCalendar is the inverse of calendars.
+func cformatForCalendar(calendar gedcom.DateCalendar) (string, bool) {
+	if calendar == gedcom.DateCalendarGregorian {
+		return "", true
+	}
+
+	for cformat, c := range calendars {
+		if c == calendar {
+			return cformat, true
+		}
+	}
+
+	return "", false
+}
+
+// isoDate is the form used by Gramps, like "1890-03-12". The month and day are
+// only included when they are known.
+func isoDate(date gedcom.Date) string {
+	switch {
+	case date.Day != 0:
+		return fmt.Sprintf("%04d-%02d-%02d", date.Year, date.Month, date.Day)
+
+	case date.Month != 0:
+		return fmt.Sprintf("%04d-%02d", date.Year, date.Month)
+	}
+
+	return fmt.Sprintf("%04d", date.Year)
+}
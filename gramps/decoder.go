@@ -0,0 +1,498 @@
+package gramps
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/elliotchance/gedcom/v39"
+)
+
+// gzipMagic are the first bytes of a gzip stream.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// pedigrees maps the relationship of a child to its parents onto the PEDI of
+// the FAMC. The "Birth" relationship is the default so it is not written.
+var pedigrees = map[string]string{
+	"Adopted": "adopted",
+	"Foster":  "foster",
+	"Sealed":  "sealed",
+}
+
+// Decoder reads Gramps XML.
+type Decoder struct {
+	r io.Reader
+}
+
+// NewDecoder creates a decoder for a Gramps XML stream. The stream may be
+// compressed with gzip, which is the format of a ".gramps" file.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{
+		r: r,
+	}
+}
+
+// Decode reads the entire stream and returns the equivalent Document.
+//
+// The Document is created with a gedcom.DocumentBuilder so all of the nodes are
+// the same types, and linked in the same way, as a decoded GEDCOM file.
+func (dec *Decoder) Decode() (*gedcom.Document, error) {
+	db, err := dec.database()
+	if err != nil {
+		return nil, err
+	}
+
+	return newImporter(db).document()
+}
+
+func (dec *Decoder) database() (*database, error) {
+	r := bufio.NewReader(dec.r)
+
+	var in io.Reader = r
+	if magic, _ := r.Peek(len(gzipMagic)); bytes.Equal(magic, gzipMagic) {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+
+		defer gz.Close()
+
+		in = gz
+	}
+
+	db := &database{}
+	if err := xml.NewDecoder(in).Decode(db); err != nil {
+		return nil, fmt.Errorf("cannot decode gramps xml: %s", err)
+	}
+
+	return db, nil
+}
+
+// importer converts a database into GEDCOM nodes.
+type importer struct {
+	db       *database
+	builder  *gedcom.DocumentBuilder
+	pointers map[string]string
+
+	events    map[string]*event
+	families  map[string]*family
+	citations map[string]*citation
+	places    map[string]*place
+}
+
+func newImporter(db *database) *importer {
+	imp := &importer{
+		db:        db,
+		builder:   gedcom.NewDocumentBuilder(newDocument()),
+		pointers:  map[string]string{},
+		events:    map[string]*event{},
+		families:  map[string]*family{},
+		citations: map[string]*citation{},
+		places:    map[string]*place{},
+	}
+
+	for i := range db.Events {
+		imp.events[db.Events[i].Handle] = &db.Events[i]
+	}
+
+	for i := range db.Families {
+		imp.families[db.Families[i].Handle] = &db.Families[i]
+	}
+
+	for i := range db.Citations {
+		imp.citations[db.Citations[i].Handle] = &db.Citations[i]
+	}
+
+	for i := range db.Places {
+		imp.places[db.Places[i].Handle] = &db.Places[i]
+	}
+
+	// The Gramps ID is used as the pointer. IDs are optional, not guaranteed
+	// to be unique and may contain characters that are not allowed in a
+	// pointer so the handle is used when it cannot be.
+	used := map[string]bool{}
+	addPointer := func(handle, id string) {
+		pointer := id
+		if used[pointer] || !gedcom.IsValidPointer(pointer) {
+			pointer = strings.TrimPrefix(handle, "_")
+		}
+
+		used[pointer] = true
+		imp.pointers[handle] = pointer
+	}
+
+	for _, p := range db.People {
+		addPointer(p.Handle, p.ID)
+	}
+
+	for _, f := range db.Families {
+		addPointer(f.Handle, f.ID)
+	}
+
+	for _, s := range db.Sources {
+		addPointer(s.Handle, s.ID)
+	}
+
+	for _, r := range db.Repositories {
+		addPointer(r.Handle, r.ID)
+	}
+
+	for _, n := range db.Notes {
+		addPointer(n.Handle, n.ID)
+	}
+
+	return imp
+}
+
+// newDocument is an empty UTF-8 GEDCOM 5.5.1 document, which is the same as
+// the HEAD that is created by the importer.
+func newDocument() *gedcom.Document {
+	document := gedcom.NewDocument()
+	document.CharacterSet = gedcom.CharacterSetUTF8
+	document.Version = gedcom.GEDCOMVersion551
+
+	return document
+}
+
+// document returns the entire database as a Document.
+func (imp *importer) document() (*gedcom.Document, error) {
+	imp.line(0, "", gedcom.TagHeader, "")
+	imp.line(1, "", gedcom.TagSource, "Gramps")
+	if version := imp.db.Header.Created.Version; version != "" {
+		imp.line(2, "", gedcom.TagVersion, version)
+	}
+	imp.line(1, "", gedcom.TagGedcomInformation, "")
+	imp.line(2, "", gedcom.TagVersion, gedcom.GEDCOMVersion551.String())
+	imp.line(2, "", gedcom.TagFormat, "LINEAGE-LINKED")
+	imp.line(1, "", gedcom.TagCharacterSet, "UTF-8")
+
+	for _, p := range imp.db.People {
+		imp.person(p)
+	}
+
+	for _, f := range imp.db.Families {
+		imp.family(f)
+	}
+
+	for _, s := range imp.db.Sources {
+		imp.source(s)
+	}
+
+	for _, r := range imp.db.Repositories {
+		imp.line(0, imp.pointers[r.Handle], gedcom.TagRepository, "")
+		imp.line(1, "", gedcom.TagName, r.Name)
+		imp.noteRefs(1, r.NoteRefs)
+	}
+
+	for _, n := range imp.db.Notes {
+		imp.line(0, imp.pointers[n.Handle], gedcom.TagNote, n.Text)
+	}
+
+	imp.line(0, "", gedcom.TagTrailer, "")
+
+	return imp.builder.Document()
+}
+
+// line adds a single node. See gedcom.DocumentBuilder.
+func (imp *importer) line(level int, pointer string, tag gedcom.Tag, value string) {
+	imp.builder.Add(level, pointer, tag, value)
+}
+
+// optionalLine only writes the line if the value is not empty.
+func (imp *importer) optionalLine(level int, tag gedcom.Tag, value string) {
+	if value != "" {
+		imp.line(level, "", tag, value)
+	}
+}
+
+// pointerLine adds a node that points to another record. Nothing is added if
+// the record does not exist.
+func (imp *importer) pointerLine(level int, tag gedcom.Tag, handle string) {
+	if pointer, ok := imp.pointers[handle]; ok {
+		imp.builder.AddPointer(level, tag, pointer)
+	}
+}
+
+func (imp *importer) person(p person) {
+	imp.line(0, imp.pointers[p.Handle], gedcom.TagIndividual, "")
+
+	for _, n := range p.Names {
+		imp.name(n)
+	}
+
+	// Gramps uses "U" when the gender is not known, which is the same as not
+	// having a SEX.
+	switch p.Gender {
+	case gedcom.SexMale, gedcom.SexFemale:
+		imp.line(1, "", gedcom.TagSex, p.Gender)
+	}
+
+	for _, ref := range p.EventRefs {
+		imp.event(1, ref)
+	}
+
+	for _, ref := range p.ChildOf {
+		imp.pointerLine(1, gedcom.TagFamilyChild, ref.Handle)
+
+		if pedigree := imp.pedigree(ref.Handle, p.Handle); pedigree != "" {
+			imp.line(2, "", gedcom.TagPedigree, pedigree)
+		}
+	}
+
+	for _, ref := range p.ParentIn {
+		imp.pointerLine(1, gedcom.TagFamilySpouse, ref.Handle)
+	}
+
+	imp.noteRefs(1, p.NoteRefs)
+	imp.citationRefs(1, p.CitationRefs)
+}
+
+// pedigree returns the PEDI for a child of a family, or an empty string if
+// the child was born into the family.
+func (imp *importer) pedigree(familyHandle, childHandle string) string {
+	f, ok := imp.families[familyHandle]
+	if !ok {
+		return ""
+	}
+
+	for _, child := range f.ChildRefs {
+		if child.Handle != childHandle {
+			continue
+		}
+
+		if pedigree, ok := pedigrees[child.FatherRel]; ok {
+			return pedigree
+		}
+
+		return pedigrees[child.MotherRel]
+	}
+
+	return ""
+}
+
+func (imp *importer) name(n name) {
+	prefix, surnames := "", []string{}
+	for _, s := range n.Surnames {
+		if prefix == "" {
+			prefix = s.Prefix
+		}
+
+		if s.Value != "" {
+			surnames = append(surnames, s.Value)
+		}
+	}
+
+	surname := strings.Join(surnames, " ")
+
+	value := n.First
+	if fullSurname := gedcom.CleanSpace(prefix + " " + surname); fullSurname != "" {
+		value += " /" + fullSurname + "/"
+	}
+
+	value = gedcom.CleanSpace(value + " " + n.Suffix)
+
+	imp.line(1, "", gedcom.TagName, value)
+
+	switch n.Type {
+	case "Married Name":
+		imp.line(2, "", gedcom.TagType, string(gedcom.NameTypeMarriedName))
+
+	case "Also Known As":
+		imp.line(2, "", gedcom.TagType, string(gedcom.NameTypeAlsoKnownAs))
+	}
+
+	imp.optionalLine(2, gedcom.TagNamePrefix, n.Title)
+	imp.optionalLine(2, gedcom.TagGivenName, n.First)
+	imp.optionalLine(2, gedcom.TagNickname, n.Nick)
+	imp.optionalLine(2, gedcom.TagSurnamePrefix, prefix)
+	imp.optionalLine(2, gedcom.TagSurname, surname)
+	imp.optionalLine(2, gedcom.TagNameSuffix, n.Suffix)
+	imp.noteRefs(2, n.NoteRefs)
+	imp.citationRefs(2, n.CitationRefs)
+}
+
+func (imp *importer) event(level int, ref eventRef) {
+	e, ok := imp.events[ref.Handle]
+	if !ok {
+		return
+	}
+
+	tag, known := eventTypes[e.Type]
+	isPrimary := ref.Role == "" || ref.Role == rolePrimary ||
+		ref.Role == roleFamily
+
+	switch {
+	case !isPrimary:
+		imp.line(level, "", gedcom.TagEvent, e.Description)
+		imp.line(level+1, "", gedcom.TagType, e.Type)
+		imp.line(level+1, "", gedcom.TagRole, ref.Role)
+
+	case !known:
+		imp.line(level, "", gedcom.TagEvent, e.Description)
+		imp.optionalLine(level+1, gedcom.TagType, e.Type)
+
+	case isAttributeTag(tag):
+		imp.line(level, "", tag, e.Description)
+
+	default:
+		imp.line(level, "", tag, "")
+		imp.optionalLine(level+1, gedcom.TagType, e.Description)
+	}
+
+	imp.optionalLine(level+1, gedcom.TagDate, e.gedcomDate())
+
+	if e.Place != nil {
+		imp.place(level+1, e.Place.Handle)
+	}
+
+	imp.noteRefs(level+1, e.NoteRefs)
+	imp.citationRefs(level+1, e.CitationRefs)
+}
+
+func (imp *importer) place(level int, handle string) {
+	p, ok := imp.places[handle]
+	if !ok {
+		return
+	}
+
+	imp.line(level, "", gedcom.TagPlace, imp.placeName(p, 0))
+
+	if p.Coord != nil {
+		imp.line(level+1, "", gedcom.TagMap, "")
+		imp.line(level+2, "", gedcom.TagLatitude,
+			gedcomCoordinate(p.Coord.Latitude, "N", "S"))
+		imp.line(level+2, "", gedcom.TagLongitude,
+			gedcomCoordinate(p.Coord.Longitude, "E", "W"))
+	}
+}
+
+// maxPlaceDepth prevents a place that (incorrectly) encloses itself from
+// causing an infinite loop.
+const maxPlaceDepth = 32
+
+// placeName is the names of the place and the places that enclose it,
+// separated by commas.
+func (imp *importer) placeName(p *place, depth int) string {
+	placeName := p.Title
+	if len(p.Names) > 0 {
+		placeName = p.Names[0].Value
+	} else {
+		// The title is already the full name.
+		return placeName
+	}
+
+	if len(p.PlaceRefs) == 0 || depth >= maxPlaceDepth {
+		return placeName
+	}
+
+	parent, ok := imp.places[p.PlaceRefs[0].Handle]
+	if !ok {
+		return placeName
+	}
+
+	return placeName + ", " + imp.placeName(parent, depth+1)
+}
+
+// gedcomCoordinate converts a decimal coordinate, like "-89.6", into the form
+// used by GEDCOM, like "W89.6". Coordinates that are not decimal are returned
+// as they are.
+func gedcomCoordinate(coordinate, positive, negative string) string {
+	coordinate = strings.TrimSpace(coordinate)
+	if _, err := strconv.ParseFloat(coordinate, 64); err != nil {
+		return coordinate
+	}
+
+	if strings.HasPrefix(coordinate, "-") {
+		return negative + coordinate[1:]
+	}
+
+	return positive + strings.TrimPrefix(coordinate, "+")
+}
+
+func (imp *importer) noteRefs(level int, refs []ref) {
+	for _, ref := range refs {
+		imp.pointerLine(level, gedcom.TagNote, ref.Handle)
+	}
+}
+
+func (imp *importer) citationRefs(level int, refs []ref) {
+	for _, ref := range refs {
+		c, ok := imp.citations[ref.Handle]
+		if !ok {
+			continue
+		}
+
+		if _, ok := imp.pointers[c.SourceRef.Handle]; !ok {
+			continue
+		}
+
+		imp.pointerLine(level, gedcom.TagSource, c.SourceRef.Handle)
+		imp.optionalLine(level+1, gedcom.TagPage, c.Page)
+
+		if quality := gedcomQuality(c.Confidence); quality != "" {
+			imp.line(level+1, "", gedcom.TagQualityOfData, quality)
+		}
+
+		imp.noteRefs(level+1, c.NoteRefs)
+	}
+}
+
+// gedcomQuality converts the confidence of a citation (0 to 4) into a QUAY (0
+// to 3). "Normal" (2) is the default confidence so it is not written.
+func gedcomQuality(confidence int) string {
+	switch {
+	case confidence == 2:
+		return ""
+
+	case confidence > 3:
+		return "3"
+
+	case confidence < 0:
+		return "0"
+	}
+
+	return strconv.Itoa(confidence)
+}
+
+func (imp *importer) family(f family) {
+	imp.line(0, imp.pointers[f.Handle], gedcom.TagFamily, "")
+
+	if f.Father != nil {
+		imp.pointerLine(1, gedcom.TagHusband, f.Father.Handle)
+	}
+
+	if f.Mother != nil {
+		imp.pointerLine(1, gedcom.TagWife, f.Mother.Handle)
+	}
+
+	for _, child := range f.ChildRefs {
+		imp.pointerLine(1, gedcom.TagChild, child.Handle)
+	}
+
+	for _, ref := range f.EventRefs {
+		imp.event(1, ref)
+	}
+
+	imp.noteRefs(1, f.NoteRefs)
+	imp.citationRefs(1, f.CitationRefs)
+}
+
+func (imp *importer) source(s source) {
+	imp.line(0, imp.pointers[s.Handle], gedcom.TagSource, "")
+	imp.optionalLine(1, gedcom.TagTitle, s.Title)
+	imp.optionalLine(1, gedcom.TagAuthor, s.Author)
+	imp.optionalLine(1, gedcom.TagPublication, s.PubInfo)
+	imp.optionalLine(1, gedcom.TagAbbreviation, s.Abbrev)
+
+	for _, repo := range s.RepoRefs {
+		imp.pointerLine(1, gedcom.TagRepository, repo.Handle)
+		imp.optionalLine(2, gedcom.TagCallNumber, repo.CallNumber)
+	}
+
+	imp.noteRefs(1, s.NoteRefs)
+}
@@ -0,0 +1,275 @@
+package gramps_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/gramps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const grampsXML = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE database PUBLIC "-//Gramps//DTD Gramps XML 1.7.1//EN"
+"http://gramps-project.org/xml/1.7.1/grampsxml.dtd">
+<database xmlns="http://gramps-project.org/xml/1.7.1/">
+  <header>
+    <created date="2020-01-02" version="5.1.2"/>
+  </header>
+  <events>
+    <event handle="_e1" id="E0000">
+      <type>Birth</type>
+      <dateval val="1890-03-12" type="about"/>
+      <place hlink="_p3"/>
+      <citationref hlink="_c1"/>
+    </event>
+    <event handle="_e2" id="E0001">
+      <type>Marriage</type>
+      <datespan start="1910" stop="1920-06"/>
+    </event>
+    <event handle="_e3" id="E0002">
+      <type>Occupation</type>
+      <daterange start="1900" stop="1905" cformat="Julian"/>
+      <description>Farmer</description>
+    </event>
+    <event handle="_e4" id="E0003">
+      <type>Funeral</type>
+      <datestr val="during the war"/>
+    </event>
+  </events>
+  <people>
+    <person handle="_i1" id="I0001">
+      <gender>M</gender>
+      <name type="Birth Name">
+        <first>John</first>
+        <surname prefix="van">Dyke</surname>
+        <title>Dr</title>
+      </name>
+      <eventref hlink="_e1" role="Primary"/>
+      <eventref hlink="_e3" role="Primary"/>
+      <eventref hlink="_e4" role="Witness"/>
+      <parentin hlink="_f1"/>
+      <noteref hlink="_n1"/>
+    </person>
+    <person handle="_i2" id="I0002">
+      <gender>F</gender>
+      <name type="Birth Name">
+        <first>Jane</first>
+        <surname>Doe</surname>
+      </name>
+      <name alt="1" type="Married Name">
+        <first>Jane</first>
+        <surname prefix="van">Dyke</surname>
+      </name>
+      <parentin hlink="_f1"/>
+    </person>
+    <person handle="_i3" id="I0003">
+      <gender>U</gender>
+      <name type="Birth Name">
+        <first>Baby</first>
+      </name>
+      <childof hlink="_f1"/>
+    </person>
+  </people>
+  <families>
+    <family handle="_f1" id="F0001">
+      <rel type="Married"/>
+      <father hlink="_i1"/>
+      <mother hlink="_i2"/>
+      <eventref hlink="_e2" role="Family"/>
+      <childref hlink="_i3" frel="Adopted" mrel="Adopted"/>
+    </family>
+  </families>
+  <citations>
+    <citation handle="_c1" id="C0000">
+      <page>p. 12</page>
+      <confidence>3</confidence>
+      <sourceref hlink="_s1"/>
+    </citation>
+  </citations>
+  <sources>
+    <source handle="_s1" id="S0001">
+      <stitle>Parish register</stitle>
+      <sauthor>St Mary</sauthor>
+      <reporef hlink="_r1" callno="PR/12"/>
+    </source>
+  </sources>
+  <places>
+    <placeobj handle="_p1" id="P0000" type="Country">
+      <pname value="USA"/>
+    </placeobj>
+    <placeobj handle="_p2" id="P0001" type="State">
+      <pname value="Illinois"/>
+      <placeref hlink="_p1"/>
+    </placeobj>
+    <placeobj handle="_p3" id="P0002" type="City">
+      <pname value="Springfield"/>
+      <coord long="-89.65" lat="39.8"/>
+      <placeref hlink="_p2"/>
+    </placeobj>
+  </places>
+  <repositories>
+    <repository handle="_r1" id="R0001">
+      <rname>County Archive</rname>
+      <type>Archive</type>
+    </repository>
+  </repositories>
+  <notes>
+    <note handle="_n1" id="N0001" type="General">
+      <text>First line
+Second line</text>
+    </note>
+  </notes>
+</database>
+`
+
+const expectedGEDCOM = `0 HEAD
+1 SOUR Gramps
+2 VERS 5.1.2
+1 GEDC
+2 VERS 5.5.1
+2 FORM LINEAGE-LINKED
+1 CHAR UTF-8
+0 @I0001@ INDI
+1 NAME John /van Dyke/
+2 NPFX Dr
+2 GIVN John
+2 SPFX van
+2 SURN Dyke
+1 SEX M
+1 BIRT
+2 DATE ABT 12 MAR 1890
+2 PLAC Springfield, Illinois, USA
+3 MAP
+4 LATI N39.8
+4 LONG W89.65
+2 SOUR @S0001@
+3 PAGE p. 12
+3 QUAY 3
+1 OCCU Farmer
+2 DATE BET @#DJULIAN@ 1900 AND @#DJULIAN@ 1905
+1 EVEN
+2 TYPE Funeral
+2 ROLE Witness
+2 DATE (during the war)
+1 FAMS @F0001@
+1 NOTE @N0001@
+0 @I0002@ INDI
+1 NAME Jane /Doe/
+2 GIVN Jane
+2 SURN Doe
+1 NAME Jane /van Dyke/
+2 TYPE married
+2 GIVN Jane
+2 SPFX van
+2 SURN Dyke
+1 SEX F
+1 FAMS @F0001@
+0 @I0003@ INDI
+1 NAME Baby
+2 GIVN Baby
+1 FAMC @F0001@
+2 PEDI adopted
+0 @F0001@ FAM
+1 HUSB @I0001@
+1 WIFE @I0002@
+1 CHIL @I0003@
+1 MARR
+2 DATE FROM 1910 TO JUN 1920
+0 @S0001@ SOUR
+1 TITL Parish register
+1 AUTH St Mary
+1 REPO @R0001@
+2 CALN PR/12
+0 @R0001@ REPO
+1 NAME County Archive
+0 @N0001@ NOTE First line
+1 CONT Second line
+0 TRLR
+`
+
+func decode(t *testing.T, r *bytes.Buffer) *gedcom.Document {
+	document, err := gramps.NewDecoder(r).Decode()
+	require.NoError(t, err)
+
+	return document
+}
+
+func TestDecoder_Decode(t *testing.T) {
+	document := decode(t, bytes.NewBufferString(grampsXML))
+
+	assert.Equal(t, expectedGEDCOM, document.String())
+
+	john := document.Individuals().ByPointer("I0001")
+	require.NotNil(t, john)
+	assert.Equal(t, "Dr John van Dyke", john.Name().String())
+
+	family := document.Families().ByPointer("F0001")
+	require.NotNil(t, family)
+	assert.Equal(t, john, family.Husband().Individual())
+	assert.Equal(t, "Baby", family.Children()[0].Individual().Name().String())
+}
+
+func TestDecoder_DecodeCompressed(t *testing.T) {
+	compressed := &bytes.Buffer{}
+	gz := gzip.NewWriter(compressed)
+	_, err := gz.Write([]byte(grampsXML))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	document := decode(t, compressed)
+
+	assert.Equal(t, expectedGEDCOM, document.String())
+}
+
+func TestDecoder_DecodeInvalid(t *testing.T) {
+	_, err := gramps.NewDecoder(strings.NewReader("0 HEAD")).Decode()
+
+	assert.Error(t, err)
+}
+
+func TestDecoder_DecodeUnsafeValues(t *testing.T) {
+	xml := `<?xml version="1.0" encoding="UTF-8"?>
+<database xmlns="http://gramps-project.org/xml/1.7.1/">
+  <people>
+    <person handle="_i1" id="I 1">
+      <name type="Birth Name">
+        <first>@John@</first>
+        <surname>Smith</surname>
+      </name>
+      <noteref hlink="_n1"/>
+    </person>
+  </people>
+  <notes>
+    <note handle="_n1" id="N@1">
+      <text>first&#13;second</text>
+    </note>
+  </notes>
+</database>
+`
+
+	document := decode(t, bytes.NewBufferString(xml))
+
+	assert.Equal(t, `0 HEAD
+1 SOUR Gramps
+1 GEDC
+2 VERS 5.5.1
+2 FORM LINEAGE-LINKED
+1 CHAR UTF-8
+0 @i1@ INDI
+1 NAME @@John@@ /Smith/
+2 GIVN @@John@@
+2 SURN Smith
+1 NOTE @n1@
+0 @n1@ NOTE first
+1 CONT second
+0 TRLR
+`, document.String())
+
+	// The document can be decoded again.
+	_, err := gedcom.NewDocumentFromString(document.String())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,48 @@
+// Package gramps converts between Gramps XML and gedcom.Document.
+//
+// Gramps (https://gramps-project.org) stores its databases as XML, usually
+// compressed with gzip and saved with a ".gramps" extension. The Decoder reads
+// both the compressed and uncompressed forms:
+//
+//   f, err := os.Open("family.gramps")
+//   if err != nil {
+//     panic(err)
+//   }
+//
+//   document, err := gramps.NewDecoder(f).Decode()
+//
+// The result is an ordinary gedcom.Document, so it can be used anywhere that a
+// decoded GEDCOM file can be used, such as with gedcom.CompareNodes or the
+// html package.
+//
+// The Encoder does the reverse:
+//
+//   err := gramps.NewEncoder(f, document).Encode()
+//
+// Mapping
+//
+// People become INDI records and families become FAM records. The Gramps ID
+// (like "I0001") is used as the pointer.
+//
+// Events are attached to the person or family that references them. Gramps
+// event types, like "Birth" and "Marriage", are mapped onto the equivalent
+// GEDCOM tag. Events that do not have an equivalent tag become an EVEN with a
+// TYPE. A person that takes part in an event without being the primary person
+// (such as a witness) receives an EVEN with the TYPE of the event and a ROLE.
+//
+// Places are written as a PLAC with the names of the place hierarchy separated
+// by commas, like "Springfield, Illinois, USA". Coordinates become a MAP.
+// When encoding, the hierarchy is rebuilt from the comma separated parts.
+//
+// Citations become a SOUR that points to the source record, with the page as
+// the PAGE and the confidence as the QUAY. Sources, repositories and notes
+// become their own records.
+//
+// Gramps dates are converted into the equivalent GEDCOM date values, including
+// ranges ("BET ... AND ..."), periods ("FROM ... TO ...") and the Julian,
+// Hebrew and French Republican calendars. Dates that Gramps could not parse
+// are kept as text.
+//
+// Information that has no equivalent, such as media objects and tags, is
+// ignored.
+package gramps
@@ -0,0 +1,552 @@
+package gramps
+
+import (
+	"compress/gzip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elliotchance/gedcom/v39"
+)
+
+// grampsVersion is the version of Gramps that the Namespace belongs to.
+const grampsVersion = "5.1.0"
+
+const doctype = `<!DOCTYPE database PUBLIC "-//Gramps//DTD Gramps XML 1.7.1//EN"
+"http://gramps-project.org/xml/1.7.1/grampsxml.dtd">
+`
+
+// Encoder writes Gramps XML.
+type Encoder struct {
+	w        io.Writer
+	document *gedcom.Document
+
+	// Compress will compress the XML with gzip, which is the format of a
+	// ".gramps" file. It is enabled by NewEncoder.
+	Compress bool
+}
+
+// NewEncoder creates an encoder that will write the document as a compressed
+// Gramps XML file.
+func NewEncoder(w io.Writer, document *gedcom.Document) *Encoder {
+	return &Encoder{
+		w:        w,
+		document: document,
+		Compress: true,
+	}
+}
+
+// Encode writes the entire document.
+func (enc *Encoder) Encode() (err error) {
+	w := enc.w
+	if enc.Compress {
+		gz := gzip.NewWriter(w)
+		defer func() {
+			if closeErr := gz.Close(); err == nil {
+				err = closeErr
+			}
+		}()
+
+		w = gz
+	}
+
+	if _, err := io.WriteString(w, xml.Header+doctype); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(newExporter(enc.document).database()); err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "\n")
+
+	return err
+}
+
+// exporter converts a Document into a database.
+type exporter struct {
+	document *gedcom.Document
+	db       *database
+
+	// ids contains all of the IDs (and pointers) that have been used.
+	ids map[string]bool
+
+	// places are the indexes of database.Places by their full name.
+	places map[string]int
+
+	childOf, parentIn map[string][]ref
+}
+
+func newExporter(document *gedcom.Document) *exporter {
+	exp := &exporter{
+		document: document,
+		db: &database{
+			Namespace: Namespace,
+			Header: header{
+				Created: created{
+					Date:    time.Now().Format("2006-01-02"),
+					Version: grampsVersion,
+				},
+			},
+		},
+		ids:      map[string]bool{},
+		places:   map[string]int{},
+		childOf:  map[string][]ref{},
+		parentIn: map[string][]ref{},
+	}
+
+	for _, node := range document.Nodes() {
+		exp.ids[node.Pointer()] = true
+	}
+
+	return exp
+}
+
+func (exp *exporter) database() *database {
+	families := exp.document.Families()
+
+	for _, f := range families {
+		familyRef := ref{handle(f.Pointer())}
+
+		for _, parent := range []*gedcom.IndividualNode{
+			f.Husband().Individual(), f.Wife().Individual(),
+		} {
+			if parent != nil {
+				exp.parentIn[parent.Pointer()] = append(
+					exp.parentIn[parent.Pointer()], familyRef)
+			}
+		}
+
+		for _, child := range f.Children() {
+			if individual := child.Individual(); individual != nil {
+				exp.childOf[individual.Pointer()] = append(
+					exp.childOf[individual.Pointer()], familyRef)
+			}
+		}
+	}
+
+	for _, individual := range exp.document.Individuals() {
+		exp.person(individual)
+	}
+
+	for _, f := range families {
+		exp.family(f)
+	}
+
+	for _, s := range exp.document.Sources() {
+		exp.source(s)
+	}
+
+	for _, r := range exp.document.Repositories() {
+		exp.db.Repositories = append(exp.db.Repositories, repository{
+			Handle:   handle(r.Pointer()),
+			ID:       r.Pointer(),
+			Name:     exp.text(r.Name()),
+			Type:     "Unknown",
+			NoteRefs: exp.noteRefs(r),
+		})
+	}
+
+	for _, n := range exp.document.Notes() {
+		exp.db.Notes = append(exp.db.Notes, note{
+			Handle: handle(n.Pointer()),
+			ID:     n.Pointer(),
+			Type:   "General",
+			Text:   exp.text(n.Value()),
+		})
+	}
+
+	return exp.db
+}
+
+// handle is the Gramps handle for an ID. Handles and IDs are different in
+// Gramps but there is no reason for them to be here.
+func handle(id string) string {
+	return "_" + id
+}
+
+// newID returns an ID that has not been used, like "E0012".
+func (exp *exporter) newID(prefix string) string {
+	for i := 0; ; i++ {
+		id := fmt.Sprintf("%s%04d", prefix, i)
+		if !exp.ids[id] {
+			exp.ids[id] = true
+
+			return id
+		}
+	}
+}
+
+// recordPointer returns the pointer of a node that points to a record, like
+// "@S1@". The second value will be false if the value is not a pointer.
+func recordPointer(node gedcom.Node) (string, bool) {
+	value := node.Value()
+	if len(value) > 2 && strings.HasPrefix(value, "@") &&
+		strings.HasSuffix(value, "@") {
+		return value[1 : len(value)-1], true
+	}
+
+	return "", false
+}
+
+func firstValue(node gedcom.Node, tag gedcom.Tag) string {
+	if child := gedcom.First(gedcom.NodesWithTag(node, tag)); child != nil {
+		return child.Value()
+	}
+
+	return ""
+}
+
+// text removes the "@@" escapes from a GEDCOM value because they are not
+// needed (or understood) by Gramps.
+func (exp *exporter) text(value string) string {
+	return exp.document.Version.UnescapeValue(value)
+}
+
+// firstText is the text of the first child with the tag. See text.
+func (exp *exporter) firstText(node gedcom.Node, tag gedcom.Tag) string {
+	return exp.text(firstValue(node, tag))
+}
+
+func (exp *exporter) person(individual *gedcom.IndividualNode) {
+	pointer := individual.Pointer()
+	p := person{
+		Handle:   handle(pointer),
+		ID:       pointer,
+		Gender:   gender(individual.Sex()),
+		ChildOf:  exp.childOf[pointer],
+		ParentIn: exp.parentIn[pointer],
+	}
+
+	for i, n := range individual.Names() {
+		p.Names = append(p.Names, exp.name(n, i > 0))
+	}
+
+	for _, node := range individual.Nodes() {
+		if isEvent(node) {
+			p.EventRefs = append(p.EventRefs, exp.event(node, rolePrimary))
+		}
+	}
+
+	p.NoteRefs = exp.noteRefs(individual)
+	p.CitationRefs = exp.citationRefs(individual)
+
+	exp.db.People = append(exp.db.People, p)
+}
+
+func gender(sex *gedcom.SexNode) string {
+	switch {
+	case sex.IsMale():
+		return gedcom.SexMale
+
+	case sex.IsFemale():
+		return gedcom.SexFemale
+	}
+
+	return gedcom.SexUnknown
+}
+
+func (exp *exporter) name(n *gedcom.NameNode, isAlternate bool) name {
+	result := name{
+		Type:         "Birth Name",
+		First:        exp.text(n.GivenName()),
+		Suffix:       exp.text(n.Suffix()),
+		Title:        exp.text(n.Prefix()),
+		Nick:         exp.firstText(n, gedcom.TagNickname),
+		NoteRefs:     exp.noteRefs(n),
+		CitationRefs: exp.citationRefs(n),
+	}
+
+	if isAlternate {
+		result.Alt = "1"
+	}
+
+	switch n.Type() {
+	case gedcom.NameTypeMarriedName:
+		result.Type = "Married Name"
+
+	case gedcom.NameTypeAlsoKnownAs, gedcom.NameTypeNickname:
+		result.Type = "Also Known As"
+	}
+
+	if n.Surname() != "" || n.SurnamePrefix() != "" {
+		result.Surnames = []surname{{
+			Prefix: exp.text(n.SurnamePrefix()),
+			Value:  exp.text(n.Surname()),
+		}}
+	}
+
+	return result
+}
+
+func isEvent(node gedcom.Node) bool {
+	return node.Tag().Is(gedcom.TagEvent) || eventTypeForTag(node.Tag()) != ""
+}
+
+// event adds the event and returns the reference to it. The role is used
+// unless the event has its own ROLE.
+func (exp *exporter) event(node gedcom.Node, role string) eventRef {
+	e := event{
+		ID:           exp.newID("E"),
+		NoteRefs:     exp.noteRefs(node),
+		CitationRefs: exp.citationRefs(node),
+	}
+	e.Handle = handle(e.ID)
+
+	tag := node.Tag()
+	switch {
+	case tag.Is(gedcom.TagEvent):
+		e.Type = exp.firstText(node, gedcom.TagType)
+		e.Description = exp.text(node.Value())
+
+		if eventRole := exp.firstText(node, gedcom.TagRole); eventRole != "" {
+			role = eventRole
+		}
+
+	case isAttributeTag(tag):
+		e.Type = eventTypeForTag(tag)
+		e.Description = exp.text(node.Value())
+
+	default:
+		e.Type = eventTypeForTag(tag)
+		e.Description = exp.firstText(node, gedcom.TagType)
+	}
+
+	if date, ok := gedcom.First(gedcom.NodesWithTag(node, gedcom.TagDate)).(*gedcom.DateNode); ok {
+		e.setDate(date, exp.document.DateLocales)
+	}
+
+	if p, ok := gedcom.First(gedcom.NodesWithTag(node, gedcom.TagPlace)).(*gedcom.PlaceNode); ok {
+		if placeHandle := exp.place(p); placeHandle != "" {
+			e.Place = &ref{placeHandle}
+		}
+	}
+
+	exp.db.Events = append(exp.db.Events, e)
+
+	return eventRef{e.Handle, role}
+}
+
+// place returns the handle of the place. The places that enclose it are
+// created from the comma separated parts of the name.
+func (exp *exporter) place(node *gedcom.PlaceNode) string {
+	parts := []string{}
+	for _, part := range strings.Split(exp.text(node.Value()), ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	if len(parts) == 0 {
+		return ""
+	}
+
+	i := exp.placeHierarchy(parts)
+
+	if m := node.Map(); m != nil && exp.db.Places[i].Coord == nil {
+		exp.db.Places[i].Coord = &coord{
+			Latitude:  decimalCoordinate(firstValue(m, gedcom.TagLatitude), "N", "S"),
+			Longitude: decimalCoordinate(firstValue(m, gedcom.TagLongitude), "E", "W"),
+		}
+	}
+
+	return exp.db.Places[i].Handle
+}
+
+// placeHierarchy returns the index of the place, creating it (and the places
+// that enclose it) if needed.
+func (exp *exporter) placeHierarchy(parts []string) int {
+	fullName := strings.Join(parts, ", ")
+	if i, ok := exp.places[fullName]; ok {
+		return i
+	}
+
+	p := place{
+		ID:    exp.newID("P"),
+		Type:  "Unknown",
+		Names: []placeName{{parts[0]}},
+	}
+	p.Handle = handle(p.ID)
+
+	if len(parts) > 1 {
+		parent := exp.placeHierarchy(parts[1:])
+		p.PlaceRefs = []ref{{exp.db.Places[parent].Handle}}
+	}
+
+	exp.db.Places = append(exp.db.Places, p)
+	exp.places[fullName] = len(exp.db.Places) - 1
+
+	return len(exp.db.Places) - 1
+}
+
+// decimalCoordinate is the inverse of gedcomCoordinate.
+func decimalCoordinate(coordinate, positive, negative string) string {
+	coordinate = strings.TrimSpace(coordinate)
+
+	switch {
+	case strings.HasPrefix(strings.ToUpper(coordinate), negative):
+		return "-" + coordinate[1:]
+
+	case strings.HasPrefix(strings.ToUpper(coordinate), positive):
+		return coordinate[1:]
+	}
+
+	return coordinate
+}
+
+// noteRefs returns the references for each NOTE of the node. Notes that are
+// not a pointer to a NOTE record are created as a new note.
+func (exp *exporter) noteRefs(node gedcom.Node) (refs []ref) {
+	for _, child := range gedcom.NodesWithTag(node, gedcom.TagNote) {
+		if pointer, ok := recordPointer(child); ok {
+			refs = append(refs, ref{handle(pointer)})
+
+			continue
+		}
+
+		n := note{
+			ID:   exp.newID("N"),
+			Type: "General",
+			Text: exp.text(child.Value()),
+		}
+		n.Handle = handle(n.ID)
+
+		exp.db.Notes = append(exp.db.Notes, n)
+		refs = append(refs, ref{n.Handle})
+	}
+
+	return
+}
+
+// citationRefs creates a citation for each SOUR of the node. A SOUR that is
+// not a pointer to a SOUR record creates a new source with the value as its
+// title.
+func (exp *exporter) citationRefs(node gedcom.Node) (refs []ref) {
+	for _, child := range gedcom.NodesWithTag(node, gedcom.TagSource) {
+		c := citation{
+			ID:         exp.newID("C"),
+			Page:       exp.firstText(child, gedcom.TagPage),
+			Confidence: confidence(firstValue(child, gedcom.TagQualityOfData)),
+			NoteRefs:   exp.noteRefs(child),
+		}
+		c.Handle = handle(c.ID)
+
+		if pointer, ok := recordPointer(child); ok {
+			c.SourceRef = ref{handle(pointer)}
+		} else {
+			s := source{
+				ID:    exp.newID("S"),
+				Title: exp.text(child.Value()),
+			}
+			s.Handle = handle(s.ID)
+
+			exp.db.Sources = append(exp.db.Sources, s)
+			c.SourceRef = ref{s.Handle}
+		}
+
+		exp.db.Citations = append(exp.db.Citations, c)
+		refs = append(refs, ref{c.Handle})
+	}
+
+	return
+}
+
+// confidence is the inverse of gedcomQuality. A missing or invalid QUAY is
+// "Normal" (2).
+func confidence(quality string) int {
+	c, err := strconv.Atoi(quality)
+	if err != nil || c < 0 || c > 3 {
+		return 2
+	}
+
+	return c
+}
+
+func (exp *exporter) family(f *gedcom.FamilyNode) {
+	pointer := f.Pointer()
+	result := family{
+		Handle: handle(pointer),
+		ID:     pointer,
+	}
+
+	if husband := f.Husband().Individual(); husband != nil {
+		result.Father = &ref{handle(husband.Pointer())}
+	}
+
+	if wife := f.Wife().Individual(); wife != nil {
+		result.Mother = &ref{handle(wife.Pointer())}
+	}
+
+	for _, child := range f.Children() {
+		individual := child.Individual()
+		if individual == nil {
+			continue
+		}
+
+		relationship := childRelationship(individual, pointer)
+		result.ChildRefs = append(result.ChildRefs, childRef{
+			Handle:    handle(individual.Pointer()),
+			FatherRel: relationship,
+			MotherRel: relationship,
+		})
+	}
+
+	for _, node := range f.Nodes() {
+		if isEvent(node) {
+			result.EventRefs = append(result.EventRefs, exp.event(node, roleFamily))
+		}
+	}
+
+	result.NoteRefs = exp.noteRefs(f)
+	result.CitationRefs = exp.citationRefs(f)
+
+	exp.db.Families = append(exp.db.Families, result)
+}
+
+// childRelationship is the Gramps relationship of a child to their parents
+// from the PEDI of the FAMC. An empty string is the default ("Birth").
+func childRelationship(child *gedcom.IndividualNode, familyPointer string) string {
+	for _, famc := range gedcom.NodesWithTag(child, gedcom.TagFamilyChild) {
+		if pointer, _ := recordPointer(famc); pointer != familyPointer {
+			continue
+		}
+
+		pedigree := strings.ToLower(firstValue(famc, gedcom.TagPedigree))
+		for relationship, p := range pedigrees {
+			if p == pedigree {
+				return relationship
+			}
+		}
+	}
+
+	return ""
+}
+
+func (exp *exporter) source(s *gedcom.SourceNode) {
+	result := source{
+		Handle:   handle(s.Pointer()),
+		ID:       s.Pointer(),
+		Title:    exp.text(s.Title()),
+		Author:   exp.firstText(s, gedcom.TagAuthor),
+		PubInfo:  exp.firstText(s, gedcom.TagPublication),
+		Abbrev:   exp.firstText(s, gedcom.TagAbbreviation),
+		NoteRefs: exp.noteRefs(s),
+	}
+
+	for _, repo := range gedcom.NodesWithTag(s, gedcom.TagRepository) {
+		if pointer, ok := recordPointer(repo); ok {
+			result.RepoRefs = append(result.RepoRefs, repoRef{
+				Handle:     handle(pointer),
+				CallNumber: exp.firstText(repo, gedcom.TagCallNumber),
+			})
+		}
+	}
+
+	exp.db.Sources = append(exp.db.Sources, result)
+}
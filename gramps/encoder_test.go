@@ -0,0 +1,104 @@
+package gramps_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/gramps"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encode(t *testing.T, document *gedcom.Document, compress bool) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	encoder := gramps.NewEncoder(buf, document)
+	encoder.Compress = compress
+	require.NoError(t, encoder.Encode())
+
+	return buf
+}
+
+func TestEncoder_Encode(t *testing.T) {
+	document, err := gedcom.NewDecoder(strings.NewReader(expectedGEDCOM)).Decode()
+	require.NoError(t, err)
+
+	xml := encode(t, document, false).String()
+
+	assert.Contains(t, xml, `<database xmlns="http://gramps-project.org/xml/1.7.1/">`)
+	assert.Contains(t, xml, `<dateval val="1890-03-12" type="about"></dateval>`)
+	assert.Contains(t, xml, `<datespan start="1910" stop="1920-06"></datespan>`)
+	assert.Contains(t, xml, `<daterange start="1900" stop="1905" cformat="Julian"></daterange>`)
+	assert.Contains(t, xml, `<eventref hlink="_E0002" role="Witness"></eventref>`)
+	assert.Contains(t, xml, `<childref hlink="_I0003" mrel="Adopted" frel="Adopted"></childref>`)
+	assert.Contains(t, xml, `<coord long="-89.65" lat="39.8"></coord>`)
+}
+
+func TestEncoder_EncodeRoundTrip(t *testing.T) {
+	document, err := gedcom.NewDecoder(strings.NewReader(expectedGEDCOM)).Decode()
+	require.NoError(t, err)
+
+	for _, compress := range []bool{false, true} {
+		t.Run(fmt.Sprintf("%v", compress), func(t *testing.T) {
+			result := decode(t, encode(t, document, compress))
+
+			expected := strings.Replace(expectedGEDCOM, "VERS 5.1.2", "VERS 5.1.0", 1)
+			assert.Equal(t, expected, result.String())
+		})
+	}
+}
+
+func TestEncoder_EncodeDates(t *testing.T) {
+	for date, expected := range map[string]string{
+		"3 MAR 1890":                "3 MAR 1890",
+		"Abt. 1890":                 "ABT 1890",
+		"bef 1890":                  "BEF 1890",
+		"AFT MAR 1890":              "AFT MAR 1890",
+		"EST 1890":                  "EST 1890",
+		"CAL 1890":                  "CAL 1890",
+		"FROM 1890":                 "FROM 1890",
+		"TO 1890":                   "TO 1890",
+		"FROM 1890 TO 1900":         "FROM 1890 TO 1900",
+		"BET 1890 AND 1900":         "BET 1890 AND 1900",
+		"@#DJULIAN@ 12 FEB 1699/00": "@#DJULIAN@ 12 FEB 1699/00",
+		"@#DHEBREW@ 3 TSH 5780":     "@#DHEBREW@ 3 TSH 5780",
+		"@#DFRENCH R@ 1 VEND 3":     "@#DFRENCH R@ 1 VEND 3",
+		"(during the war)":          "(during the war)",
+		"something else":            "(something else)",
+	} {
+		t.Run(date, func(t *testing.T) {
+			document := gedcom.NewDocument()
+			document.AddIndividual("P1",
+				gedcom.NewBirthNode("", gedcom.NewDateNode(date)))
+
+			result := decode(t, encode(t, document, false))
+
+			birth := gedcom.First(gedcom.NodesWithTag(result.Individuals()[0], gedcom.TagBirth))
+			require.NotNil(t, birth)
+			assert.Equal(t, expected, gedcom.First(gedcom.NodesWithTag(birth, gedcom.TagDate)).Value())
+		})
+	}
+}
+
+func TestEncoder_EncodeEscapes(t *testing.T) {
+	ged := "0 HEAD\n1 GEDC\n2 VERS 5.5.1\n0 @I1@ INDI\n1 NAME John /Smith@@home/\n1 NOTE me@@example.com\n0 @N1@ NOTE @@work\n"
+	document, err := gedcom.NewDecoder(strings.NewReader(ged)).Decode()
+	require.NoError(t, err)
+
+	xml := encode(t, document, false).String()
+	assert.Contains(t, xml, "<text>me@example.com</text>")
+	assert.Contains(t, xml, "<text>@work</text>")
+	assert.Contains(t, xml, "<surname>Smith@home</surname>")
+
+	result := decode(t, encode(t, document, false))
+	assert.Equal(t, "Smith@@home", result.Individuals()[0].Name().Surname())
+
+	notes := []string{}
+	for _, note := range result.Notes() {
+		notes = append(notes, note.Value())
+	}
+
+	assert.ElementsMatch(t, []string{"@@work", "me@@example.com"}, notes)
+}
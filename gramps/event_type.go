@@ -0,0 +1,82 @@
+package gramps
+
+import "github.com/elliotchance/gedcom/v39"
+
+// Event roles that mean the event belongs to the person or family that
+// references it.
+const (
+	rolePrimary = "Primary"
+	roleFamily  = "Family"
+)
+
+// eventTypes maps the Gramps event types onto GEDCOM tags. Gramps types that
+// are not listed here (including custom types) become an EVEN with a TYPE.
+var eventTypes = map[string]gedcom.Tag{
+	"Adopted":             gedcom.TagAdoption,
+	"Adult Christening":   gedcom.TagAdultChristening,
+	"Annulment":           gedcom.TagAnnulment,
+	"Baptism":             gedcom.TagBaptism,
+	"Bar Mitzvah":         gedcom.TagBarMitzvah,
+	"Bas Mitzvah":         gedcom.TagBasMitzvah,
+	"Birth":               gedcom.TagBirth,
+	"Blessing":            gedcom.TagBlessing,
+	"Burial":              gedcom.TagBurial,
+	"Census":              gedcom.TagCensus,
+	"Christening":         gedcom.TagChristening,
+	"Confirmation":        gedcom.TagConfirmation,
+	"Cremation":           gedcom.TagCremation,
+	"Death":               gedcom.TagDeath,
+	"Divorce":             gedcom.TagDivorce,
+	"Divorce Filing":      gedcom.TagDivorceFiled,
+	"Education":           gedcom.TagEducation,
+	"Emigration":          gedcom.TagEmigration,
+	"Engagement":          gedcom.TagEngagement,
+	"First Communion":     gedcom.TagFirstCommunion,
+	"Graduation":          gedcom.TagGraduation,
+	"Immigration":         gedcom.TagImmigration,
+	"Marriage":            gedcom.TagMarriage,
+	"Marriage Banns":      gedcom.TagMarriageBann,
+	"Marriage Contract":   gedcom.TagMarriageContract,
+	"Marriage License":    gedcom.TagMarriageLicence,
+	"Marriage Settlement": gedcom.TagMarriageSettlement,
+	"Naturalization":      gedcom.TagNaturalization,
+	"Nobility Title":      gedcom.TagTitle,
+	"Occupation":          gedcom.TagOccupation,
+	"Ordination":          gedcom.TagOrdination,
+	"Probate":             gedcom.TagProbate,
+	"Property":            gedcom.TagProperty,
+	"Religion":            gedcom.TagReligion,
+	"Residence":           gedcom.TagResidence,
+	"Retirement":          gedcom.TagRetirement,
+	"Will":                gedcom.TagWill,
+}
+
+// eventTypeForTag is the inverse of eventTypes. The result will be empty if
+// the tag is not an event that Gramps understands.
+func eventTypeForTag(tag gedcom.Tag) string {
+	for eventType, eventTag := range eventTypes {
+		if eventTag.Is(tag) {
+			return eventType
+		}
+	}
+
+	return ""
+}
+
+var attributeTags = []gedcom.Tag{
+	gedcom.TagEducation, gedcom.TagOccupation, gedcom.TagReligion,
+	gedcom.TagTitle, gedcom.TagProperty,
+}
+
+// isAttributeTag is true for the GEDCOM events where the value is the
+// description of the event rather than a "Y" or empty. For example, the value
+// of OCCU is the occupation.
+func isAttributeTag(tag gedcom.Tag) bool {
+	for _, attributeTag := range attributeTags {
+		if attributeTag.Is(tag) {
+			return true
+		}
+	}
+
+	return false
+}
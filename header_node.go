@@ -0,0 +1,221 @@
+package gedcom
+
+import (
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+// SourceSystem is the system (software) that produced a GEDCOM file. It is
+// the SOUR of the HEAD.
+type SourceSystem struct {
+	// ID is the approved system ID, for example "Ancestry.com" or "MYHERITAGE".
+	ID string
+
+	// Name is the full name of the product.
+	Name string
+
+	// Version is the version of the product.
+	Version string
+
+	// Corporation is the name of the business that owns the product.
+	Corporation string
+}
+
+// modulePath is the import path of this library, without the major version.
+const modulePath = "github.com/elliotchance/gedcom"
+
+// DefaultSourceSystem is this library. It is used by Encoder.RegenerateHeader
+// when Encoder.SourceSystem is empty.
+var DefaultSourceSystem = SourceSystem{
+	ID:      "ELLIOTCHANCE_GEDCOM",
+	Name:    modulePath,
+	Version: moduleVersion(),
+}
+
+// moduleVersion returns the version of this module that was built into the
+// executable. It will be empty if it is not known, such as when running the
+// tests.
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	for _, module := range append([]*debug.Module{&info.Main}, info.Deps...) {
+		if strings.HasPrefix(module.Path, modulePath) &&
+			module.Version != "(devel)" {
+			return module.Version
+		}
+	}
+
+	return ""
+}
+
+// HeaderNode is the HEAD record. It contains information about the entire
+// file, such as the system that created it and the GEDCOM version.
+type HeaderNode struct {
+	*SimpleNode
+}
+
+// NewHeaderNode creates a new HEAD node.
+func NewHeaderNode(children ...Node) *HeaderNode {
+	return newHeaderNode("", "", children...)
+}
+
+// newHeaderNode is used by the Decoder so that an invalid value or pointer on
+// the HEAD is not lost.
+func newHeaderNode(value, pointer string, children ...Node) *HeaderNode {
+	return &HeaderNode{
+		newSimpleNode(TagHeader, value, pointer, children...),
+	}
+}
+
+// SourceSystem is the system that produced the file.
+//
+// If the node is nil or there is no SOUR the result will be empty.
+func (node *HeaderNode) SourceSystem() SourceSystem {
+	source := First(NodesWithTag(node, TagSource))
+	if source == nil {
+		return SourceSystem{}
+	}
+
+	return SourceSystem{
+		ID:          source.Value(),
+		Name:        firstValueWithTag(source, TagName),
+		Version:     firstValueWithTag(source, TagVersion),
+		Corporation: firstValueWithTag(source, TagCorporate),
+	}
+}
+
+// Destination is the system that the file was intended for.
+//
+// If the node is nil the result will be an empty string.
+func (node *HeaderNode) Destination() string {
+	return firstValueWithTag(node, TagDestination)
+}
+
+// Date is the date that the file was created.
+//
+// If the node is nil or there is no DATE the result will be nil.
+func (node *HeaderNode) Date() *DateNode {
+	date, _ := First(NodesWithTag(node, TagDate)).(*DateNode)
+
+	return date
+}
+
+// Time is the time of the Date, for example "13:45:10".
+//
+// If the node is nil the result will be an empty string.
+func (node *HeaderNode) Time() string {
+	return firstValueWithTag(node.Date(), TagTime)
+}
+
+// Submitter is the SUBM of the HEAD. SubmitterNode.Record can be used to get
+// the SUBM record that it points to.
+//
+// If the node is nil or there is no SUBM the result will be nil.
+func (node *HeaderNode) Submitter() *SubmitterNode {
+	submitter, _ := First(NodesWithTag(node, TagSubmitter)).(*SubmitterNode)
+
+	return submitter
+}
+
+// File is the name of the file that the data was originally saved to.
+//
+// If the node is nil the result will be an empty string.
+func (node *HeaderNode) File() string {
+	return firstValueWithTag(node, TagFile)
+}
+
+// Copyright is the copyright statement for the file.
+//
+// If the node is nil the result will be an empty string.
+func (node *HeaderNode) Copyright() string {
+	return firstValueWithTag(node, TagCopyright)
+}
+
+// GEDCOMVersion is the version in the GEDC of the HEAD.
+//
+// If the node is nil or the version is not known the result will be an empty
+// string.
+func (node *HeaderNode) GEDCOMVersion() GEDCOMVersion {
+	gedc := First(NodesWithTag(node, TagGedcomInformation))
+
+	return GEDCOMVersionFromString(firstValueWithTag(gedc, TagVersion))
+}
+
+// GEDCOMForm is the FORM in the GEDC of the HEAD. It is usually
+// "LINEAGE-LINKED".
+//
+// If the node is nil the result will be an empty string.
+func (node *HeaderNode) GEDCOMForm() string {
+	gedc := First(NodesWithTag(node, TagGedcomInformation))
+
+	return firstValueWithTag(gedc, TagFormat)
+}
+
+// CharacterSet is the CHAR of the HEAD. This may be different from the
+// Document.CharacterSet if the character set was detected from the data.
+//
+// If the node is nil or there is no CHAR the result will be an empty string.
+func (node *HeaderNode) CharacterSet() CharacterSet {
+	value := firstValueWithTag(node, TagCharacterSet)
+	if value == "" {
+		return ""
+	}
+
+	return CharacterSetFromString(value)
+}
+
+// regenerated returns a copy of the header where the SOUR, DATE and TIME
+// have been replaced. The other nodes are retained.
+func (node *HeaderNode) regenerated(source SourceSystem, timestamp time.Time, version GEDCOMVersion) *HeaderNode {
+	sourceNode := NewNode(TagSource, source.ID, "")
+	for _, child := range []struct {
+		tag   Tag
+		value string
+	}{
+		{TagVersion, source.Version},
+		{TagName, source.Name},
+		{TagCorporate, source.Corporation},
+	} {
+		if child.value != "" {
+			sourceNode.AddNode(NewNode(child.tag, child.value, ""))
+		}
+	}
+
+	children := Nodes{
+		sourceNode,
+		NewDateNode(strings.ToUpper(timestamp.Format("2 Jan 2006")),
+			NewNode(TagTime, timestamp.Format("15:04:05"), "")),
+	}
+
+	hasGEDCOMInformation := false
+	if node != nil {
+		for _, child := range node.Nodes() {
+			switch child.Tag() {
+			case TagSource, TagDate:
+				continue
+
+			case TagGedcomInformation:
+				hasGEDCOMInformation = true
+			}
+
+			children = append(children, child)
+		}
+	}
+
+	if !hasGEDCOMInformation {
+		gedc := NewNode(TagGedcomInformation, "", "",
+			NewNode(TagVersion, version.orDefault().String(), ""))
+
+		if version != GEDCOMVersion70 {
+			gedc.AddNode(NewFormatNode("LINEAGE-LINKED"))
+		}
+
+		children = append(children, gedc)
+	}
+
+	return NewHeaderNode(children...)
+}
@@ -0,0 +1,180 @@
+package gedcom_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+var headerNodeGEDCOM = `0 HEAD
+1 SOUR Ancestry.com Family Trees
+2 VERS (2010.3)
+2 NAME Ancestry.com Member Trees
+2 CORP Ancestry.com
+1 DEST GED55
+1 DATE 9 MAR 2019
+2 TIME 14:05:00
+1 SUBM @U1@
+1 FILE family.ged
+1 COPR Copyright (c) 2019 Bob Smith.
+1 GEDC
+2 VERS 5.5.1
+2 FORM LINEAGE-LINKED
+1 CHAR UTF-8
+0 @U1@ SUBM
+1 NAME Bob Smith
+`
+
+func TestHeaderNode(t *testing.T) {
+	doc, err := gedcom.NewDocumentFromString(headerNodeGEDCOM)
+	assert.NoError(t, err)
+
+	header := doc.Header()
+	assert.Equal(t, gedcom.SourceSystem{
+		ID:          "Ancestry.com Family Trees",
+		Name:        "Ancestry.com Member Trees",
+		Version:     "(2010.3)",
+		Corporation: "Ancestry.com",
+	}, header.SourceSystem())
+	assert.Equal(t, "GED55", header.Destination())
+	assert.Equal(t, "9 MAR 2019", header.Date().Value())
+	assert.Equal(t, "14:05:00", header.Time())
+	assert.Equal(t, "Bob Smith", header.Submitter().Name())
+	assert.Equal(t, "family.ged", header.File())
+	assert.Equal(t, "Copyright (c) 2019 Bob Smith.", header.Copyright())
+	assert.Equal(t, gedcom.GEDCOMVersion551, header.GEDCOMVersion())
+	assert.Equal(t, "LINEAGE-LINKED", header.GEDCOMForm())
+	assert.Equal(t, gedcom.CharacterSetUTF8, header.CharacterSet())
+}
+
+func TestHeaderNode_Nil(t *testing.T) {
+	header := (*gedcom.HeaderNode)(nil)
+
+	assert.Equal(t, gedcom.SourceSystem{}, header.SourceSystem())
+	assert.Equal(t, "", header.Destination())
+	assert.Nil(t, header.Date())
+	assert.Equal(t, "", header.Time())
+	assert.Nil(t, header.Submitter())
+	assert.Equal(t, "", header.File())
+	assert.Equal(t, "", header.Copyright())
+	assert.Equal(t, gedcom.GEDCOMVersion(""), header.GEDCOMVersion())
+	assert.Equal(t, "", header.GEDCOMForm())
+	assert.Equal(t, gedcom.CharacterSet(""), header.CharacterSet())
+}
+
+func TestHeaderNode_CharacterSet(t *testing.T) {
+	CharacterSet := tf.Function(t, (*gedcom.HeaderNode).CharacterSet)
+
+	CharacterSet(gedcom.NewHeaderNode()).Returns(gedcom.CharacterSet(""))
+	CharacterSet(gedcom.NewHeaderNode(
+		gedcom.NewNode(gedcom.TagCharacterSet, "ANSEL", ""),
+	)).Returns(gedcom.CharacterSetANSEL)
+}
+
+func TestDocument_Header(t *testing.T) {
+	doc := gedcom.NewDocument()
+	assert.Nil(t, doc.Header())
+
+	doc.AddIndividual("P1")
+	header := gedcom.NewHeaderNode()
+	doc.AddNode(header)
+	assert.Equal(t, header, doc.Header())
+}
+
+func TestEncoder_EncodeRegenerateHeader(t *testing.T) {
+	timestamp := time.Date(2021, time.July, 4, 9, 30, 15, 0, time.UTC)
+	source := gedcom.SourceSystem{ID: "MYAPP", Name: "My App", Version: "1.2"}
+
+	for testName, test := range map[string]struct {
+		ged      string
+		version  gedcom.GEDCOMVersion
+		expected string
+	}{
+		"Replace": {
+			headerNodeGEDCOM,
+			"",
+			`0 HEAD
+1 SOUR MYAPP
+2 VERS 1.2
+2 NAME My App
+1 DATE 4 JUL 2021
+2 TIME 09:30:15
+1 DEST GED55
+1 SUBM @U1@
+1 FILE family.ged
+1 COPR Copyright (c) 2019 Bob Smith.
+1 GEDC
+2 VERS 5.5.1
+2 FORM LINEAGE-LINKED
+1 CHAR UTF-8
+0 @U1@ SUBM
+1 NAME Bob Smith
+`,
+		},
+		"Missing": {
+			"0 @P1@ INDI\n",
+			"",
+			`0 HEAD
+1 SOUR MYAPP
+2 VERS 1.2
+2 NAME My App
+1 DATE 4 JUL 2021
+2 TIME 09:30:15
+1 GEDC
+2 VERS 5.5.1
+2 FORM LINEAGE-LINKED
+0 @P1@ INDI
+`,
+		},
+		"GEDCOM70": {
+			"0 @P1@ INDI\n",
+			gedcom.GEDCOMVersion70,
+			`0 HEAD
+1 SOUR MYAPP
+2 VERS 1.2
+2 NAME My App
+1 DATE 4 JUL 2021
+2 TIME 09:30:15
+1 GEDC
+2 VERS 7.0
+0 @P1@ INDI
+`,
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			doc, err := gedcom.NewDocumentFromString(test.ged)
+			assert.NoError(t, err)
+			original := doc.String()
+
+			buf := bytes.NewBufferString("")
+			encoder := gedcom.NewEncoder(buf, doc)
+			encoder.RegenerateHeader = true
+			encoder.SourceSystem = source
+			encoder.Timestamp = timestamp
+			encoder.Version = test.version
+
+			assert.NoError(t, encoder.Encode())
+			assert.Equal(t, test.expected, buf.String())
+			assert.Equal(t, original, doc.String())
+		})
+	}
+
+	t.Run("DefaultSourceSystem", func(t *testing.T) {
+		doc, err := gedcom.NewDocumentFromString("0 HEAD\n")
+		assert.NoError(t, err)
+
+		buf := bytes.NewBufferString("")
+		encoder := gedcom.NewEncoder(buf, doc)
+		encoder.RegenerateHeader = true
+		assert.NoError(t, encoder.Encode())
+
+		result, err := gedcom.NewDocumentFromString(buf.String())
+		assert.NoError(t, err)
+		assert.Equal(t, gedcom.DefaultSourceSystem, result.Header().SourceSystem())
+		assert.Equal(t, time.Now().Year(), result.Header().Date().StartDate().Year)
+	})
+}
@@ -15,14 +15,16 @@ type AllParentButtons struct {
 	document   *gedcom.Document
 	visibility LivingVisibility
 	placesMap  map[string]*place
+	dateLocale *gedcom.DateLocale
 }
 
-func NewAllParentButtons(document *gedcom.Document, individual *gedcom.IndividualNode, visibility LivingVisibility, placesMap map[string]*place) *AllParentButtons {
+func NewAllParentButtons(document *gedcom.Document, individual *gedcom.IndividualNode, visibility LivingVisibility, placesMap map[string]*place, dateLocale *gedcom.DateLocale) *AllParentButtons {
 	return &AllParentButtons{
 		individual: individual,
 		document:   document,
 		visibility: visibility,
 		placesMap:  placesMap,
+		dateLocale: dateLocale,
 	}
 }
 
@@ -39,7 +41,7 @@ func (c *AllParentButtons) WriteHTMLTo(w io.Writer) (int64, error) {
 		}
 
 		components = append(components,
-			NewParentButtons(c.document, family, c.visibility, c.placesMap))
+			NewParentButtons(c.document, family, c.visibility, c.placesMap, c.dateLocale))
 	}
 
 	// If there are no families we still want to show an empty family. We just
@@ -47,7 +49,7 @@ func (c *AllParentButtons) WriteHTMLTo(w io.Writer) (int64, error) {
 	if len(components) == 0 {
 		familyNode := gedcom.NewDocument().AddFamily("")
 		components = []core.Component{
-			NewParentButtons(c.document, familyNode, c.visibility, c.placesMap),
+			NewParentButtons(c.document, familyNode, c.visibility, c.placesMap, c.dateLocale),
 		}
 	}
 
@@ -10,14 +10,16 @@ import (
 // EventDate shows a date like "d. 1882" but will not show anything if the date
 // is not provided.
 type EventDate struct {
-	event string
-	dates []*gedcom.DateNode
+	event      string
+	dates      []*gedcom.DateNode
+	dateLocale *gedcom.DateLocale
 }
 
-func NewEventDate(event string, dates []*gedcom.DateNode) *EventDate {
+func NewEventDate(event string, dates []*gedcom.DateNode, dateLocale *gedcom.DateLocale) *EventDate {
 	return &EventDate{
-		event: event,
-		dates: dates,
+		event:      event,
+		dates:      dates,
+		dateLocale: dateLocale,
 	}
 }
 
@@ -28,7 +30,7 @@ func (c *EventDate) WriteHTMLTo(w io.Writer) (int64, error) {
 
 	return core.NewComponents(
 		core.NewTag("em", nil, core.NewText(c.event)),
-		core.NewText(" "+c.dates[0].String()),
+		core.NewText(" "+c.dates[0].StringInLocale(c.dateLocale)),
 	).WriteHTMLTo(w)
 }
 
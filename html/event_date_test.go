@@ -10,14 +10,18 @@ import (
 func TestEventDate_WriteHTMLTo(t *testing.T) {
 	c := testComponent(t, "EventDate")
 
-	c(html.NewEventDate("foo", gedcom.DateNodes{})).Returns(``)
+	c(html.NewEventDate("foo", gedcom.DateNodes{}, nil)).Returns(``)
 
 	c(html.NewEventDate("foo", gedcom.DateNodes{
 		gedcom.NewDateNode("3 Sep 1945"),
-	})).Returns(`<em>foo</em> 3 Sep 1945`)
+	}, nil)).Returns(`<em>foo</em> 3 Sep 1945`)
 
 	c(html.NewEventDate("bar", gedcom.DateNodes{
 		gedcom.NewDateNode("17 Sep 1945"),
 		gedcom.NewDateNode("3 Sep 1945"),
-	})).Returns(`<em>bar</em> 17 Sep 1945`)
+	}, nil)).Returns(`<em>bar</em> 17 Sep 1945`)
+
+	c(html.NewEventDate("baz", gedcom.DateNodes{
+		gedcom.NewDateNode("Abt. 3 May 1945"),
+	}, gedcom.DateLocaleDutch)).Returns(`<em>baz</em> ca. 3 mei 1945`)
 }
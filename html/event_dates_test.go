@@ -15,16 +15,16 @@ func TestEventDates_WriteHTMLTo(t *testing.T) {
 	c(html.NewEventDates([]*html.EventDate{
 		html.NewEventDate("foo", gedcom.DateNodes{
 			gedcom.NewDateNode("3 Sep 1945"),
-		}),
+		}, nil),
 	})).Returns("<em>foo</em> 3 Sep 1945")
 
 	c(html.NewEventDates([]*html.EventDate{
 		html.NewEventDate("foo", gedcom.DateNodes{
 			gedcom.NewDateNode("3 Sep 1945"),
-		}),
+		}, nil),
 		html.NewEventDate("bar", gedcom.DateNodes{
 			gedcom.NewDateNode("17 Sep 1945"),
 			gedcom.NewDateNode("3 Sep 1945"),
-		}),
+		}, nil),
 	})).Returns("<em>foo</em> 3 Sep 1945&nbsp;&nbsp;&nbsp;<em>bar</em> 17 Sep 1945")
 }
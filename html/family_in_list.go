@@ -25,8 +25,7 @@ func NewFamilyInList(document *gedcom.Document, family *gedcom.FamilyNode, visib
 
 func (c *FamilyInList) WriteHTMLTo(w io.Writer) (int64, error) {
 	date := "-"
-	n := gedcom.First(gedcom.NodesWithTagPath(c.family, gedcom.TagMarriage, gedcom.TagDate))
-	if n != nil {
+	if n, _ := c.family.Marriage(); n != nil {
 		date = n.Value()
 	}
 
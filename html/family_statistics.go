@@ -23,13 +23,11 @@ func (c *FamilyStatistics) WriteHTMLTo(w io.Writer) (int64, error) {
 	divorceEvents := 0
 
 	for _, family := range c.document.Families() {
-		n := gedcom.First(gedcom.NodesWithTagPath(family, gedcom.TagMarriage))
-		if n != nil {
+		if len(family.Marriages()) > 0 {
 			marriageEvents += 1
 		}
 
-		n = gedcom.First(gedcom.NodesWithTagPath(family, gedcom.TagDivorce))
-		if n != nil {
+		if len(family.Divorces()) > 0 {
 			divorceEvents += 1
 		}
 	}
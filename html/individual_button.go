@@ -16,14 +16,16 @@ type IndividualButton struct {
 	document   *gedcom.Document
 	visibility LivingVisibility
 	placesMap  map[string]*place
+	dateLocale *gedcom.DateLocale
 }
 
-func NewIndividualButton(document *gedcom.Document, individual *gedcom.IndividualNode, visibility LivingVisibility, placesMap map[string]*place) *IndividualButton {
+func NewIndividualButton(document *gedcom.Document, individual *gedcom.IndividualNode, visibility LivingVisibility, placesMap map[string]*place, dateLocale *gedcom.DateLocale) *IndividualButton {
 	return &IndividualButton{
 		individual: individual,
 		document:   document,
 		visibility: visibility,
 		placesMap:  placesMap,
+		dateLocale: dateLocale,
 	}
 }
 
@@ -46,7 +48,7 @@ func (c *IndividualButton) WriteHTMLTo(w io.Writer) (int64, error) {
 			PageIndividual(c.document, c.individual, c.visibility, c.placesMap))
 	}
 
-	eventDates := NewIndividualDates(c.individual, c.visibility)
+	eventDates := NewIndividualDates(c.individual, c.visibility, c.dateLocale)
 
 	isLiving := c.individual != nil && c.individual.IsLiving()
 	if isLiving {
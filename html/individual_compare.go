@@ -43,6 +43,26 @@ func (c *IndividualCompare) appendChildren(nd *gedcom.NodeDiff, prefix string) [
 	return tableRows
 }
 
+// familyEventRows compares the marriage and divorce of the same family in
+// both documents.
+func (c *IndividualCompare) familyEventRows(left, right *gedcom.FamilyNode) (tableRows []core.Component) {
+	prefix := "&nbsp;&nbsp;&nbsp;&nbsp;"
+
+	marriages := gedcom.CompareNodes(gedcom.First(left.Marriages()),
+		gedcom.First(right.Marriages()))
+	if marriages.Left != nil || marriages.Right != nil {
+		tableRows = append(tableRows, c.appendChildren(marriages, prefix)...)
+	}
+
+	divorces := gedcom.CompareNodes(gedcom.First(left.Divorces()),
+		gedcom.First(right.Divorces()))
+	if divorces.Left != nil || divorces.Right != nil {
+		tableRows = append(tableRows, c.appendChildren(divorces, prefix)...)
+	}
+
+	return
+}
+
 func (c *IndividualCompare) appendDiffRow(rows []core.Component, row *DiffRow) []core.Component {
 	if row.isEmpty() {
 		return rows
@@ -187,6 +207,13 @@ func (c *IndividualCompare) writeHTMLTo(w io.Writer) (int64, error) {
 			row := NewDiffRow("Spouse", nodeDiff, c.filterFlags.HideEqual)
 
 			tableRows = c.appendDiffRow(tableRows, row)
+
+			if spouse.Left != nil && spouse.Right != nil {
+				leftFamily := c.comparison.Left.FamilyWithSpouse(spouse.Left)
+				rightFamily := c.comparison.Right.FamilyWithSpouse(spouse.Right)
+				tableRows = append(tableRows,
+					c.familyEventRows(leftFamily, rightFamily)...)
+			}
 		}
 
 	case !gedcom.IsNil(left):
@@ -10,12 +10,14 @@ import (
 type IndividualDates struct {
 	individual *gedcom.IndividualNode
 	visibility LivingVisibility
+	dateLocale *gedcom.DateLocale
 }
 
-func NewIndividualDates(individual *gedcom.IndividualNode, visibility LivingVisibility) *IndividualDates {
+func NewIndividualDates(individual *gedcom.IndividualNode, visibility LivingVisibility, dateLocale *gedcom.DateLocale) *IndividualDates {
 	return &IndividualDates{
 		individual: individual,
 		visibility: visibility,
+		dateLocale: dateLocale,
 	}
 }
 
@@ -55,11 +57,11 @@ func (c *IndividualDates) EventDates() []*EventDate {
 	baptisms := c.individual.Baptisms()
 	switch {
 	case len(births) > 0:
-		eventDate := NewEventDate("b.", births[0].Dates())
+		eventDate := NewEventDate("b.", births[0].Dates(), c.dateLocale)
 		eventDates = append(eventDates, eventDate)
 
 	case len(baptisms) > 0:
-		eventDate := NewEventDate("bap.", baptisms[0].Dates())
+		eventDate := NewEventDate("bap.", baptisms[0].Dates(), c.dateLocale)
 		eventDates = append(eventDates, eventDate)
 	}
 
@@ -68,11 +70,11 @@ func (c *IndividualDates) EventDates() []*EventDate {
 	burials := c.individual.Burials()
 	switch {
 	case len(deaths) > 0:
-		eventDate := NewEventDate("d.", deaths[0].Dates())
+		eventDate := NewEventDate("d.", deaths[0].Dates(), c.dateLocale)
 		eventDates = append(eventDates, eventDate)
 
 	case len(burials) > 0:
-		eventDate := NewEventDate("bur.", burials[0].Dates())
+		eventDate := NewEventDate("bur.", burials[0].Dates(), c.dateLocale)
 		eventDates = append(eventDates, eventDate)
 	}
 
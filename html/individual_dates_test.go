@@ -13,6 +13,10 @@ func TestIndividualDates_WriteHTMLTo(t *testing.T) {
 	doc := gedcom.NewDocument()
 	elliot := individual(doc, "P1", "Elliot /Chance/", "4 Jan 1843", "17 Mar 1907")
 
-	c(html.NewIndividualDates(elliot, html.LivingVisibilityPlaceholder)).
+	c(html.NewIndividualDates(elliot, html.LivingVisibilityPlaceholder, nil)).
 		Returns("<em>b.</em> 4 Jan 1843&nbsp;&nbsp;&nbsp;<em>d.</em> 17 Mar 1907")
+
+	c(html.NewIndividualDates(elliot, html.LivingVisibilityPlaceholder,
+		gedcom.DateLocaleGerman)).
+		Returns("<em>b.</em> 4 Jan 1843&nbsp;&nbsp;&nbsp;<em>d.</em> 17 März 1907")
 }
@@ -15,14 +15,16 @@ type IndividualEvents struct {
 	individual *gedcom.IndividualNode
 	visibility LivingVisibility
 	placesMap  map[string]*place
+	dateLocale *gedcom.DateLocale
 }
 
-func NewIndividualEvents(document *gedcom.Document, individual *gedcom.IndividualNode, visibility LivingVisibility, placesMap map[string]*place) *IndividualEvents {
+func NewIndividualEvents(document *gedcom.Document, individual *gedcom.IndividualNode, visibility LivingVisibility, placesMap map[string]*place, dateLocale *gedcom.DateLocale) *IndividualEvents {
 	return &IndividualEvents{
 		document:   document,
 		individual: individual,
 		visibility: visibility,
 		placesMap:  placesMap,
+		dateLocale: dateLocale,
 	}
 }
 
@@ -34,27 +36,18 @@ func (c *IndividualEvents) WriteHTMLTo(w io.Writer) (int64, error) {
 		//date := gedcom.String(gedcom.First(gedcom.Dates(event)))
 		//place := gedcom.String(gedcom.First(gedcom.Places(event)))
 
-		e := NewIndividualEvent(gedcom.String(date), gedcom.String(place),
+		e := NewIndividualEvent(date.StringInLocale(c.dateLocale), gedcom.String(place),
 			core.NewEmpty(), c.individual, event, c.placesMap)
 		events = append(events, e)
 	}
 
 	for _, family := range c.individual.Families() {
-		marriage := gedcom.First(gedcom.NodesWithTag(family, gedcom.TagMarriage))
-		if marriage == nil {
-			continue
-		}
-
-		date := gedcom.First(gedcom.NodesWithTag(marriage, gedcom.TagDate))
+		marriage := gedcom.First(family.Marriages())
+		date, place := family.Marriage()
 		if date == nil {
 			continue
 		}
 
-		place := ""
-		if p := gedcom.First(gedcom.NodesWithTag(marriage, gedcom.TagPlace)); p != nil {
-			place = p.Value()
-		}
-
 		var description core.Component = core.NewEmpty()
 		if family.Husband().IsIndividual(c.individual) {
 			description = core.NewHTML(UnknownEmphasis)
@@ -77,7 +70,12 @@ func (c *IndividualEvents) WriteHTMLTo(w io.Writer) (int64, error) {
 		// Empty description means that the individual is a child so this is not
 		// an event we want to show.
 		if _, ok := description.(*core.Empty); !ok {
-			event := NewIndividualEvent(date.Value(), place,
+			dateText := date.Value()
+			if c.dateLocale != nil {
+				dateText = date.StringInLocale(c.dateLocale)
+			}
+
+			event := NewIndividualEvent(dateText, gedcom.String(place),
 				description, c.individual, marriage, c.placesMap)
 			events = append(events, event)
 		}
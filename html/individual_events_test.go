@@ -103,7 +103,7 @@ func TestNewIndividualEvents(t *testing.T) {
 	require.NoError(t, err)
 
 	component := html.NewIndividualEvents(doc, doc.Individuals()[0],
-		html.LivingVisibilityPlaceholder, nil)
+		html.LivingVisibilityPlaceholder, nil, nil)
 	buf := bytes.NewBuffer(nil)
 	_, err = component.WriteHTMLTo(buf)
 	require.NoError(t, err)
@@ -14,14 +14,16 @@ type IndividualInList struct {
 	document   *gedcom.Document
 	visibility LivingVisibility
 	placesMap  map[string]*place
+	dateLocale *gedcom.DateLocale
 }
 
-func NewIndividualInList(document *gedcom.Document, individual *gedcom.IndividualNode, visibility LivingVisibility, placesMap map[string]*place) *IndividualInList {
+func NewIndividualInList(document *gedcom.Document, individual *gedcom.IndividualNode, visibility LivingVisibility, placesMap map[string]*place, dateLocale *gedcom.DateLocale) *IndividualInList {
 	return &IndividualInList{
 		individual: individual,
 		document:   document,
 		visibility: visibility,
 		placesMap:  placesMap,
+		dateLocale: dateLocale,
 	}
 }
 
@@ -32,8 +34,8 @@ func (c *IndividualInList) WriteHTMLTo(w io.Writer) (int64, error) {
 	birthPlaceName := prettyPlaceName(gedcom.String(birthPlace))
 	deathPlaceName := prettyPlaceName(gedcom.String(deathPlace))
 
-	birthDateText := core.NewText(gedcom.String(birthDate))
-	deathDateText := core.NewText(gedcom.String(deathDate))
+	birthDateText := core.NewText(birthDate.StringInLocale(c.dateLocale))
+	deathDateText := core.NewText(deathDate.StringInLocale(c.dateLocale))
 
 	link := NewIndividualLink(c.document, c.individual, c.visibility, c.placesMap)
 	birthPlaceLink := NewPlaceLink(c.document, birthPlaceName, c.placesMap)
@@ -81,7 +81,7 @@ func (c *IndividualListPage) WriteHTMLTo(w io.Writer) (int64, error) {
 		}
 
 		table = append(table, NewIndividualInList(c.document, i,
-			c.options.LivingVisibility, c.placesMap))
+			c.options.LivingVisibility, c.placesMap, c.options.DateLocale))
 	}
 
 	livingRow := core.NewRow(
@@ -23,7 +23,7 @@ func NewIndividualNameAndDates(individual *gedcom.IndividualNode, visibility Liv
 
 func (c *IndividualNameAndDates) WriteHTMLTo(w io.Writer) (int64, error) {
 	name := NewIndividualName(c.individual, c.visibility, c.unknownText)
-	dates := NewIndividualDates(c.individual, c.visibility)
+	dates := NewIndividualDates(c.individual, c.visibility, nil)
 
 	isUnknown := name.IsUnknown()
 	datesAreBlank := dates.IsBlank()
@@ -34,7 +34,8 @@ func (c *IndividualPage) WriteHTMLTo(w io.Writer) (int64, error) {
 
 	individualName := NewIndividualName(c.individual, c.options.LivingVisibility,
 		UnknownEmphasis)
-	individualDates := NewIndividualDates(c.individual, c.options.LivingVisibility)
+	individualDates := NewIndividualDates(c.individual,
+		c.options.LivingVisibility, c.options.DateLocale)
 
 	return core.NewPage(
 		name.String(),
@@ -42,9 +43,12 @@ func (c *IndividualPage) WriteHTMLTo(w io.Writer) (int64, error) {
 			NewPublishHeader(c.document, name.String(), selectedExtraTab,
 				c.options, c.indexLetters, c.placesMap),
 			NewAllParentButtons(c.document, c.individual,
-				c.options.LivingVisibility, c.placesMap),
+				c.options.LivingVisibility, c.placesMap, c.options.DateLocale),
 			core.NewBigTitle(1, individualName),
 			core.NewBigTitle(3, individualDates),
+			NewIndividualRelationship(c.document, c.individual,
+				c.options.RootIndividual, c.options.LivingVisibility,
+				c.placesMap),
 			core.NewHorizontalRuleRow(),
 			core.NewRow(
 				core.NewColumn(core.HalfRow, NewIndividualNameAndSex(c.individual)),
@@ -52,10 +56,10 @@ func (c *IndividualPage) WriteHTMLTo(w io.Writer) (int64, error) {
 			),
 			core.NewSpace(),
 			NewIndividualEvents(c.document, c.individual,
-				c.options.LivingVisibility, c.placesMap),
+				c.options.LivingVisibility, c.placesMap, c.options.DateLocale),
 			core.NewSpace(),
 			NewPartnersAndChildren(c.document, c.individual,
-				c.options.LivingVisibility, c.placesMap),
+				c.options.LivingVisibility, c.placesMap, c.options.DateLocale),
 		),
 		c.googleAnalyticsID,
 	).WriteHTMLTo(w)
@@ -0,0 +1,55 @@
+package html
+
+import (
+	"io"
+	"strings"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/html/core"
+)
+
+// IndividualRelationship is shown on the individual page. It describes how the
+// individual is related to the root individual (see
+// PublishShowOptions.RootIndividual), like "Great-grandfather of John Smith".
+//
+// Nothing is shown if there is no root individual or they are not related.
+type IndividualRelationship struct {
+	document   *gedcom.Document
+	individual *gedcom.IndividualNode
+	root       *gedcom.IndividualNode
+	visibility LivingVisibility
+	placesMap  map[string]*place
+}
+
+func NewIndividualRelationship(document *gedcom.Document, individual, root *gedcom.IndividualNode, visibility LivingVisibility, placesMap map[string]*place) *IndividualRelationship {
+	return &IndividualRelationship{
+		document:   document,
+		individual: individual,
+		root:       root,
+		visibility: visibility,
+		placesMap:  placesMap,
+	}
+}
+
+func (c *IndividualRelationship) WriteHTMLTo(w io.Writer) (int64, error) {
+	if c.root == nil || c.individual.Is(c.root) {
+		return writeNothing()
+	}
+
+	if c.root.IsLiving() && c.visibility == LivingVisibilityHide {
+		return writeNothing()
+	}
+
+	relationship := c.individual.RelationshipTo(c.root)
+	if relationship == nil {
+		return writeNothing()
+	}
+
+	description := relationship.String()
+	description = strings.ToUpper(description[:1]) + description[1:] + " of "
+
+	return core.NewBigTitle(5, core.NewComponents(
+		core.NewText(description),
+		NewIndividualLink(c.document, c.root, c.visibility, c.placesMap),
+	)).WriteHTMLTo(w)
+}
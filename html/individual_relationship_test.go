@@ -0,0 +1,30 @@
+package html_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/html"
+)
+
+func TestIndividualRelationship_WriteHTMLTo(t *testing.T) {
+	doc := gedcom.NewDocument()
+	elliot := individual(doc, "P1", "Elliot /Chance/", "4 Jan 1843", "17 Mar 1907")
+	john := individual(doc, "P2", "John /Chance/", "1870", "1940")
+	jane := individual(doc, "P3", "Jane /Doe/", "1850", "1920")
+	elliot.SetSex(gedcom.SexMale)
+	doc.AddFamilyWithHusbandAndWife("F1", elliot, nil).AddChild(john)
+
+	c := testComponent(t, "IndividualRelationship")
+	visibility := html.NewLivingVisibility(html.LivingVisibilityPlaceholder)
+
+	c(html.NewIndividualRelationship(doc, elliot, john, visibility, nil)).
+		Returns(`<div class="row"><div class="col-12"><h5 class="text-center">Father of <a href="john-chance.html"><span class="Octicon Octicon-primitive-dot" style="color: black; font-size: 18px"></span>John Chance</a></h5></div></div>`)
+
+	c(html.NewIndividualRelationship(doc, elliot, nil, visibility, nil)).
+		Returns("")
+	c(html.NewIndividualRelationship(doc, elliot, elliot, visibility, nil)).
+		Returns("")
+	c(html.NewIndividualRelationship(doc, jane, john, visibility, nil)).
+		Returns("")
+}
@@ -14,22 +14,24 @@ type ParentButtons struct {
 	document   *gedcom.Document
 	visibility LivingVisibility
 	placesMap  map[string]*place
+	dateLocale *gedcom.DateLocale
 }
 
-func NewParentButtons(document *gedcom.Document, family *gedcom.FamilyNode, visibility LivingVisibility, placesMap map[string]*place) *ParentButtons {
+func NewParentButtons(document *gedcom.Document, family *gedcom.FamilyNode, visibility LivingVisibility, placesMap map[string]*place, dateLocale *gedcom.DateLocale) *ParentButtons {
 	return &ParentButtons{
 		family:     family,
 		document:   document,
 		visibility: visibility,
 		placesMap:  placesMap,
+		dateLocale: dateLocale,
 	}
 }
 
 func (c *ParentButtons) WriteHTMLTo(w io.Writer) (int64, error) {
 	husband := NewIndividualButton(c.document, c.family.Husband().Individual(),
-		c.visibility, c.placesMap)
+		c.visibility, c.placesMap, c.dateLocale)
 	wife := NewIndividualButton(c.document, c.family.Wife().Individual(),
-		c.visibility, c.placesMap)
+		c.visibility, c.placesMap, c.dateLocale)
 	svg := NewPlusSVG(false, true, true, true)
 	space := core.NewSpace()
 
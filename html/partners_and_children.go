@@ -14,14 +14,16 @@ type PartnersAndChildren struct {
 	document   *gedcom.Document
 	visibility LivingVisibility
 	placesMap  map[string]*place
+	dateLocale *gedcom.DateLocale
 }
 
-func NewPartnersAndChildren(document *gedcom.Document, individual *gedcom.IndividualNode, visibility LivingVisibility, placesMap map[string]*place) *PartnersAndChildren {
+func NewPartnersAndChildren(document *gedcom.Document, individual *gedcom.IndividualNode, visibility LivingVisibility, placesMap map[string]*place, dateLocale *gedcom.DateLocale) *PartnersAndChildren {
 	return &PartnersAndChildren{
 		individual: individual,
 		document:   document,
 		visibility: visibility,
 		placesMap:  placesMap,
+		dateLocale: dateLocale,
 	}
 }
 
@@ -51,7 +53,7 @@ func (c *PartnersAndChildren) WriteHTMLTo(w io.Writer) (int64, error) {
 
 		columns := []*core.Column{
 			core.NewColumn(core.QuarterRow, NewIndividualButton(c.document,
-				spouse, c.visibility, c.placesMap)),
+				spouse, c.visibility, c.placesMap, c.dateLocale)),
 		}
 
 		family := c.individual.FamilyWithSpouse(spouse)
@@ -78,7 +80,7 @@ func (c *PartnersAndChildren) WriteHTMLTo(w io.Writer) (int64, error) {
 		columns := []*core.Column{
 			core.NewColumn(core.QuarterRow,
 				NewIndividualButton(c.document, nil, c.visibility,
-					c.placesMap)),
+					c.placesMap, c.dateLocale)),
 		}
 
 		columns, rows = partnerSection(family, c, columns, rows)
@@ -133,7 +135,8 @@ func partnerSection(family *gedcom.FamilyNode, c *PartnersAndChildren, columns [
 
 		button := core.NewComponents(
 			svg,
-			NewIndividualButton(c.document, child, c.visibility, c.placesMap),
+			NewIndividualButton(c.document, child, c.visibility, c.placesMap,
+				c.dateLocale),
 		)
 		columns = append(columns, core.NewColumn(3, button))
 
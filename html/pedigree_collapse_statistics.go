@@ -0,0 +1,53 @@
+package html
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/html/core"
+)
+
+// PedigreeCollapseStatistics lists the individuals where the lines of their
+// ancestors join, with the number of repeated ancestors and the ancestor loss.
+type PedigreeCollapseStatistics struct {
+	document   *gedcom.Document
+	visibility LivingVisibility
+	placesMap  map[string]*place
+}
+
+func NewPedigreeCollapseStatistics(document *gedcom.Document, visibility LivingVisibility, placesMap map[string]*place) *PedigreeCollapseStatistics {
+	return &PedigreeCollapseStatistics{
+		document:   document,
+		visibility: visibility,
+		placesMap:  placesMap,
+	}
+}
+
+func (c *PedigreeCollapseStatistics) WriteHTMLTo(w io.Writer) (int64, error) {
+	rows := []core.Component{
+		core.NewTableHead("Name", "Repeated", "Loss"),
+	}
+
+	for _, collapse := range c.document.PedigreeCollapses() {
+		if collapse.Individual.IsLiving() && c.visibility == LivingVisibilityHide {
+			continue
+		}
+
+		link := NewIndividualLink(c.document, collapse.Individual,
+			c.visibility, c.placesMap)
+		repeated := core.NewNumber(len(collapse.RepeatedAncestors))
+		loss := core.NewText(fmt.Sprintf("%.1f%%", collapse.AncestorLoss()))
+
+		rows = append(rows, core.NewTableRow(
+			core.NewTableCell(link),
+			core.NewTableCell(repeated),
+			core.NewTableCell(loss).NoWrap(),
+		))
+	}
+
+	table := core.NewTable("", core.NewComponents(rows...))
+
+	return core.NewCard(core.NewText("Pedigree Collapse"), len(rows)-1, table).
+		WriteHTMLTo(w)
+}
@@ -0,0 +1,34 @@
+package html_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/html"
+)
+
+func TestPedigreeCollapseStatistics_WriteHTMLTo(t *testing.T) {
+	doc := gedcom.NewDocument()
+	p1 := individual(doc, "P1", "John /Smith/", "1800", "1870")
+	p2 := individual(doc, "P2", "Mary /Jones/", "1802", "1880")
+	p3 := individual(doc, "P3", "Adam /Smith/", "1830", "1890")
+	p4 := individual(doc, "P4", "Eve /Smith/", "1832", "1900")
+	p5 := individual(doc, "P5", "Bob /Smith/", "1860", "1920")
+	p6 := individual(doc, "P6", "Alice /Brown/", "1862", "1930")
+	p7 := individual(doc, "P7", "Jane /Smith/", "1890", "1950")
+
+	doc.AddFamilyWithHusbandAndWife("F1", p1, p2).AddChild(p3)
+	doc.Families().ByPointer("F1").AddChild(p4)
+	doc.AddFamilyWithHusbandAndWife("F2", p3, nil).AddChild(p5)
+	doc.AddFamilyWithHusbandAndWife("F3", nil, p4).AddChild(p6)
+	doc.AddFamilyWithHusbandAndWife("F4", p5, p6).AddChild(p7)
+
+	c := testComponent(t, "PedigreeCollapseStatistics")
+	visibility := html.NewLivingVisibility(html.LivingVisibilityPlaceholder)
+
+	c(html.NewPedigreeCollapseStatistics(doc, visibility, nil)).
+		Returns(`<div class="card"><h5 class="card-header">Pedigree Collapse<span class="badge badge-pill badge-secondary float-right">1</span></h5><table class="table "><thead><tr><th scope="col">Name</th><th scope="col">Repeated</th><th scope="col">Loss</th></tr></thead><tr><td scope="col"><a href="jane-smith.html"><span class="Octicon Octicon-primitive-dot" style="color: black; font-size: 18px"></span>Jane Smith</a></td><td scope="col">2</td><td scope="col" nowrap="nowrap">25.0%</td></tr></table></div>`)
+
+	c(html.NewPedigreeCollapseStatistics(gedcom.NewDocument(), visibility, nil)).
+		Returns(`<div class="card"><h5 class="card-header">Pedigree Collapse<span class="badge badge-pill badge-secondary float-right">0</span></h5><table class="table "><thead><tr><th scope="col">Name</th><th scope="col">Repeated</th><th scope="col">Loss</th></tr></thead></table></div>`)
+}
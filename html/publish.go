@@ -17,6 +17,15 @@ type PublishShowOptions struct {
 	ShowSources      bool
 	ShowStatistics   bool
 	LivingVisibility LivingVisibility
+
+	// DateLocale is used to render dates. If it is nil then dates are
+	// rendered in English.
+	DateLocale *gedcom.DateLocale
+
+	// RootIndividual is the individual that relationships are described
+	// from on each of the individual pages. Relationships are not shown if it
+	// is nil.
+	RootIndividual *gedcom.IndividualNode
 }
 
 type Publisher struct {
@@ -43,7 +43,12 @@ func (c *StatisticsPage) WriteHTMLTo(w io.Writer) (int64, error) {
 					core.NewSpace(),
 					newPlaceStatistics(c.document, c.placesMap),
 				)),
-				core.NewColumn(core.HalfRow, NewEventStatistics(c.document)),
+				core.NewColumn(core.HalfRow, core.NewComponents(
+					NewEventStatistics(c.document),
+					core.NewSpace(),
+					NewPedigreeCollapseStatistics(c.document,
+						c.options.LivingVisibility, c.placesMap),
+				)),
 			),
 		),
 		c.googleAnalyticsID,
@@ -984,12 +984,12 @@ func (node *IndividualNode) incorrectEventOrderWarnings() (warnings Warnings) {
 		for _, event := range group.Events {
 			for _, futureGroup := range eventOrder[i+1:] {
 				for _, futureEvent := range futureGroup.Events {
+					// IsDefinitelyBefore takes into account that a
+					// period (unlike a range) is true for all of its dates.
 					if event.Date.IsValid() &&
 						futureEvent.Date.IsValid() {
-						comparison := futureEvent.Date.DateRange().
-							Compare(event.Date.DateRange())
-
-						if comparison == DateRangeComparisonEntirelyBefore {
+						if futureEvent.Date.DateRange().
+							IsDefinitelyBefore(event.Date.DateRange()) {
 							warning := NewIncorrectEventOrderWarning(
 								futureEvent.Event, futureEvent.Date.DateRange(),
 								event.Event, event.Date.DateRange(),
@@ -1035,6 +1035,7 @@ func (node *IndividualNode) Warnings() (warnings Warnings) {
 	warnings = append(warnings, node.incorrectEventOrderWarnings()...)
 	warnings = append(warnings, node.tooOldWarnings()...)
 	warnings = append(warnings, node.multipleSexesWarnings()...)
+	warnings = append(warnings, node.pedigreeCollapseWarnings()...)
 
 	return
 }
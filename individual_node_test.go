@@ -1892,6 +1892,20 @@ var individualWarningTests = map[string]struct {
 		},
 		[]string{"Jane Chance (b. 12 Sep 1913, d. 7 Feb 2001) has multiple sexes; Male, Female and Male."},
 	},
+	"BurialRangeIncludesDeath": {
+		func(doc *gedcom.Document) {
+			elliot := individual(doc, "P1", "Elliot /Chance/", "", "1900")
+			elliot.AddBurialDate("Bet. 1899 and 1901")
+		},
+		nil,
+	},
+	"BaptismPeriodStartsBeforeBirth": {
+		func(doc *gedcom.Document) {
+			elliot := individual(doc, "P1", "Elliot /Chance/", "1900", "")
+			elliot.AddBaptismDate("From 1899 to 1901")
+		},
+		[]string{"The baptism (From 1899 to 1901) was before the birth (1900) of Elliot Chance (b. 1900)."},
+	},
 	"BurialYearSameAsExactDeath": {
 		func(doc *gedcom.Document) {
 			elliot := individual(doc, "P1", "Elliot /Chance/", "", "3 Nov 1768")
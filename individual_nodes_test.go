@@ -632,6 +632,10 @@ func assertEqual(t *testing.T, expected, actual interface{}) bool {
 		gedcom.FamilyNode{},
 		gedcom.DateNode{},
 		gedcom.ChildNode{},
+		gedcom.SourceNode{},
+		gedcom.RepositoryNode{},
+		gedcom.SubmitterNode{},
+		gedcom.MultimediaNode{},
 	), simplifyErrors)
 	if diff != "" {
 		assert.Fail(t, diff)
@@ -0,0 +1,229 @@
+package gedcom
+
+import (
+	"fmt"
+)
+
+// Ancestor is an individual found by IndividualNode.Ancestors.
+type Ancestor struct {
+	Individual *IndividualNode
+
+	// Generation is 1 for parents, 2 for grandparents, etc.
+	Generation int
+
+	// Path is the line from the individual that Ancestors was called on to
+	// this ancestor. It includes both individuals.
+	Path IndividualNodes
+
+	// Ahnentafel is the number of the ancestor in an Ahnentafel (or
+	// Sosa-Stradonitz) numbering, where the individual is 1. The father of
+	// each ancestor is double their number and the mother is one more than
+	// that. For example, 6 is the father of the mother.
+	Ahnentafel int
+}
+
+func (ancestor *Ancestor) String() string {
+	return fmt.Sprintf("%d. %s", ancestor.Ahnentafel, ancestor.Individual)
+}
+
+// Ancestors is returned by IndividualNode.Ancestors.
+type Ancestors []*Ancestor
+
+// Individuals returns each of the individuals once, in the same order as the
+// ancestors.
+func (ancestors Ancestors) Individuals() IndividualNodes {
+	individuals := IndividualNodes{}
+	seen := map[*IndividualNode]bool{}
+
+	for _, ancestor := range ancestors {
+		if !seen[ancestor.Individual] {
+			individuals = append(individuals, ancestor.Individual)
+			seen[ancestor.Individual] = true
+		}
+	}
+
+	return individuals
+}
+
+// Descendant is an individual found by IndividualNode.Descendants.
+type Descendant struct {
+	Individual *IndividualNode
+
+	// Generation is 1 for children, 2 for grandchildren, etc.
+	Generation int
+
+	// Path is the line from the individual that Descendants was called on to
+	// this descendant. It includes both individuals.
+	Path IndividualNodes
+
+	// DAboville is the number of the descendant in a d'Aboville numbering,
+	// where the individual is "1". The children of each descendant are
+	// numbered from 1, so "1.3.2" is the second child of the third child.
+	DAboville string
+}
+
+func (descendant *Descendant) String() string {
+	return fmt.Sprintf("%s %s", descendant.DAboville, descendant.Individual)
+}
+
+// Descendants is returned by IndividualNode.Descendants.
+type Descendants []*Descendant
+
+// Individuals returns each of the individuals once, in the same order as the
+// descendants.
+func (descendants Descendants) Individuals() IndividualNodes {
+	individuals := IndividualNodes{}
+	seen := map[*IndividualNode]bool{}
+
+	for _, descendant := range descendants {
+		if !seen[descendant.Individual] {
+			individuals = append(individuals, descendant.Individual)
+			seen[descendant.Individual] = true
+		}
+	}
+
+	return individuals
+}
+
+// Ancestors returns the ancestors of the individual up to maxGenerations. For
+// example, a maxGenerations of 2 will return the parents and grandparents. If
+// maxGenerations is zero (or less) there is no limit.
+//
+// The ancestors are ordered by their Ahnentafel number. An ancestor that can
+// be reached through more than one line (known as pedigree collapse) is
+// returned once for each line, with a different Path and Ahnentafel number.
+// Trees with a lot of pedigree collapse should use maxGenerations to limit
+// the number of lines.
+//
+// The parents of an individual are the husband and wife of every family that
+// they are a child of. This means that the Ahnentafel numbers will be repeated
+// for individuals that have more than one family of parents, such as adoptive
+// parents.
+//
+// An individual cannot be their own ancestor. However, this may happen with
+// bad data. In this case the line stops before the individual appears a second
+// time.
+//
+// If the node is nil the result will also be nil.
+func (node *IndividualNode) Ancestors(maxGenerations int) Ancestors {
+	if node == nil {
+		return nil
+	}
+
+	ancestors := Ancestors{}
+	queue := Ancestors{{
+		Individual: node,
+		Path:       IndividualNodes{node},
+		Ahnentafel: 1,
+	}}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if maxGenerations > 0 && current.Generation >= maxGenerations {
+			continue
+		}
+
+		for _, family := range current.Individual.Parents() {
+			parents := []*IndividualNode{
+				family.Husband().Individual(),
+				family.Wife().Individual(),
+			}
+
+			for i, parent := range parents {
+				if parent == nil || lineageContains(current.Path, parent) {
+					continue
+				}
+
+				ancestor := &Ancestor{
+					Individual: parent,
+					Generation: current.Generation + 1,
+					Path:       appendLineage(current.Path, parent),
+					Ahnentafel: current.Ahnentafel*2 + i,
+				}
+
+				ancestors = append(ancestors, ancestor)
+				queue = append(queue, ancestor)
+			}
+		}
+	}
+
+	return ancestors
+}
+
+// Descendants returns the descendants of the individual up to maxGenerations.
+// For example, a maxGenerations of 2 will return the children and
+// grandchildren. If maxGenerations is zero (or less) there is no limit.
+//
+// The descendants are ordered by generation and then by their d'Aboville
+// number. Children are numbered in the order they appear in the families of
+// each individual. A descendant that can be reached through more than one line
+// is returned once for each line, with a different Path and d'Aboville number.
+//
+// An individual cannot be their own descendant. However, this may happen with
+// bad data. In this case the line stops before the individual appears a second
+// time.
+//
+// If the node is nil the result will also be nil.
+func (node *IndividualNode) Descendants(maxGenerations int) Descendants {
+	if node == nil {
+		return nil
+	}
+
+	descendants := Descendants{}
+	queue := Descendants{{
+		Individual: node,
+		Path:       IndividualNodes{node},
+		DAboville:  "1",
+	}}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if maxGenerations > 0 && current.Generation >= maxGenerations {
+			continue
+		}
+
+		number := 0
+		for _, child := range current.Individual.Children() {
+			individual := child.Individual()
+			if individual == nil || lineageContains(current.Path, individual) {
+				continue
+			}
+
+			number++
+			descendant := &Descendant{
+				Individual: individual,
+				Generation: current.Generation + 1,
+				Path:       appendLineage(current.Path, individual),
+				DAboville:  fmt.Sprintf("%s.%d", current.DAboville, number),
+			}
+
+			descendants = append(descendants, descendant)
+			queue = append(queue, descendant)
+		}
+	}
+
+	return descendants
+}
+
+func lineageContains(path IndividualNodes, individual *IndividualNode) bool {
+	for _, node := range path {
+		if node == individual {
+			return true
+		}
+	}
+
+	return false
+}
+
+// appendLineage returns a new path so that the paths of each line do not share
+// the same underlying array.
+func appendLineage(path IndividualNodes, individual *IndividualNode) IndividualNodes {
+	newPath := make(IndividualNodes, len(path), len(path)+1)
+	copy(newPath, path)
+
+	return append(newPath, individual)
+}
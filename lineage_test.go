@@ -0,0 +1,156 @@
+package gedcom_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/stretchr/testify/assert"
+)
+
+func ancestorNumbers(ancestors gedcom.Ancestors) (numbers []string) {
+	for _, ancestor := range ancestors {
+		numbers = append(numbers, fmt.Sprintf("%d. %s", ancestor.Ahnentafel,
+			ancestor.Individual.Pointer()))
+	}
+
+	return
+}
+
+func descendantNumbers(descendants gedcom.Descendants) (numbers []string) {
+	for _, descendant := range descendants {
+		numbers = append(numbers, fmt.Sprintf("%s %s", descendant.DAboville,
+			descendant.Individual.Pointer()))
+	}
+
+	return
+}
+
+func TestIndividualNode_Ancestors(t *testing.T) {
+	doc := newDocumentFromString(relationshipDocument)
+	individual := doc.Individuals().ByPointer
+
+	t.Run("Nil", func(t *testing.T) {
+		assert.Nil(t, (*gedcom.IndividualNode)(nil).Ancestors(0))
+	})
+
+	t.Run("None", func(t *testing.T) {
+		assert.Equal(t, gedcom.Ancestors{}, individual("P1").Ancestors(0))
+	})
+
+	t.Run("All", func(t *testing.T) {
+		ancestors := individual("P15").Ancestors(0)
+
+		assert.Equal(t, []string{
+			"2. P13", "4. P8", "5. P12", "8. P4", "9. P7",
+			"16. P1", "17. P2",
+		}, ancestorNumbers(ancestors))
+
+		assert.Equal(t, &gedcom.Ancestor{
+			Individual: individual("P4"),
+			Generation: 3,
+			Path: gedcom.IndividualNodes{
+				individual("P15"), individual("P13"), individual("P8"),
+				individual("P4"),
+			},
+			Ahnentafel: 8,
+		}, ancestors[3])
+	})
+
+	t.Run("MaxGenerations", func(t *testing.T) {
+		assert.Equal(t, []string{"2. P13", "4. P8", "5. P12"},
+			ancestorNumbers(individual("P15").Ancestors(2)))
+	})
+
+	t.Run("MotherOnly", func(t *testing.T) {
+		assert.Equal(t, []string{"3. P11", "6. P10", "7. P5",
+			"14. P1", "15. P2"},
+			ancestorNumbers(individual("P14").Ancestors(0)))
+	})
+}
+
+func TestIndividualNode_Descendants(t *testing.T) {
+	doc := newDocumentFromString(relationshipDocument)
+	individual := doc.Individuals().ByPointer
+
+	t.Run("Nil", func(t *testing.T) {
+		assert.Nil(t, (*gedcom.IndividualNode)(nil).Descendants(0))
+	})
+
+	t.Run("All", func(t *testing.T) {
+		descendants := individual("P1").Descendants(0)
+
+		assert.Equal(t, []string{
+			"1.1 P4", "1.2 P5", "1.3 P6",
+			"1.1.1 P8", "1.1.2 P9", "1.2.1 P11",
+			"1.1.1.1 P13", "1.2.1.1 P14",
+			"1.1.1.1.1 P15",
+		}, descendantNumbers(descendants))
+
+		assert.Equal(t, &gedcom.Descendant{
+			Individual: individual("P11"),
+			Generation: 2,
+			Path: gedcom.IndividualNodes{
+				individual("P1"), individual("P5"), individual("P11"),
+			},
+			DAboville: "1.2.1",
+		}, descendants[5])
+	})
+
+	t.Run("MaxGenerations", func(t *testing.T) {
+		assert.Equal(t, []string{"1.1 P8", "1.2 P9"},
+			descendantNumbers(individual("P4").Descendants(1)))
+	})
+}
+
+func TestIndividualNode_AncestorsPedigreeCollapse(t *testing.T) {
+	// P3 and P4 are siblings and the parents of P5.
+	doc := newDocumentFromString(`0 @P1@ INDI
+0 @P2@ INDI
+0 @P3@ INDI
+0 @P4@ INDI
+0 @P5@ INDI
+0 @F1@ FAM
+1 HUSB @P1@
+1 WIFE @P2@
+1 CHIL @P3@
+1 CHIL @P4@
+0 @F2@ FAM
+1 HUSB @P3@
+1 WIFE @P4@
+1 CHIL @P5@
+`)
+	individual := doc.Individuals().ByPointer
+	ancestors := individual("P5").Ancestors(0)
+
+	assert.Equal(t, []string{
+		"2. P3", "3. P4", "4. P1", "5. P2", "6. P1", "7. P2",
+	}, ancestorNumbers(ancestors))
+
+	assert.Equal(t, gedcom.IndividualNodes{
+		individual("P3"), individual("P4"), individual("P1"), individual("P2"),
+	}, ancestors.Individuals())
+
+	assert.Equal(t, []string{
+		"1.1 P3", "1.2 P4", "1.1.1 P5", "1.2.1 P5",
+	}, descendantNumbers(individual("P1").Descendants(0)))
+}
+
+func TestIndividualNode_AncestorsLoop(t *testing.T) {
+	// P1 is the father of P2, and P2 is the father of P1.
+	doc := newDocumentFromString(`0 @P1@ INDI
+0 @P2@ INDI
+0 @F1@ FAM
+1 HUSB @P1@
+1 CHIL @P2@
+0 @F2@ FAM
+1 HUSB @P2@
+1 CHIL @P1@
+`)
+	individual := doc.Individuals().ByPointer
+
+	assert.Equal(t, []string{"2. P2"},
+		ancestorNumbers(individual("P1").Ancestors(0)))
+	assert.Equal(t, []string{"1.1 P1"},
+		descendantNumbers(individual("P2").Descendants(0)))
+}
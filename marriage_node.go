@@ -0,0 +1,59 @@
+package gedcom
+
+// MarriageNode is the legal, common-law, or customary event of creating a family
+// unit of a man and a woman as husband and wife.
+type MarriageNode struct {
+	*SimpleNode
+}
+
+// NewMarriageNode creates a new MARR node.
+func NewMarriageNode(value string, children ...Node) *MarriageNode {
+	return &MarriageNode{
+		newSimpleNode(TagMarriage, value, "", children...),
+	}
+}
+
+// Dates returns zero or more dates associated with the marriage.
+//
+// When more than one date is returned you should not assume that the order has
+// any significance for the importance of the dates.
+//
+// If the node is nil the result will also be nil.
+func (node *MarriageNode) Dates() DateNodes {
+	return Dates(node)
+}
+
+// Places returns zero or more places associated with the marriage.
+//
+// If the node is nil the result will also be nil.
+func (node *MarriageNode) Places() []*PlaceNode {
+	return Places(node)
+}
+
+// Equal will always return true if both nodes are not nil.
+//
+// If either node is nil (including both) or if the right side is not a
+// MarriageNode then false is always returned. Otherwise Equals will always return
+// true.
+//
+// The reason Equals always returns true is because Equals is a shallow test and
+// a family is expected to only have one marriage event. Therefore it is safe to
+// assume that marriage events themselves are equal, even if the children they
+// contain are not.
+//
+// This logic is especially important for CompareNodes.
+func (node *MarriageNode) Equals(node2 Node) bool {
+	if IsNil(node) {
+		return false
+	}
+
+	if IsNil(node2) {
+		return false
+	}
+
+	if _, ok := node2.(*MarriageNode); !ok {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,68 @@
+package gedcom_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMarriageNode(t *testing.T) {
+	child := gedcom.NewDateNode("")
+	node := gedcom.NewMarriageNode("foo", child)
+
+	assert.Equal(t, gedcom.TagMarriage, node.Tag())
+	assert.Equal(t, gedcom.Nodes{child}, node.Nodes())
+	assert.Equal(t, "foo", node.Value())
+	assert.Equal(t, "", node.Pointer())
+}
+
+func TestMarriageNode_Dates(t *testing.T) {
+	Dates := tf.NamedFunction(t, "MarriageNode_Dates", (*gedcom.MarriageNode).Dates)
+
+	Dates((*gedcom.MarriageNode)(nil)).Returns([]*gedcom.DateNode(nil))
+
+	Dates(gedcom.NewMarriageNode("")).Returns([]*gedcom.DateNode(nil))
+
+	Dates(gedcom.NewMarriageNode("",
+		gedcom.NewDateNode("3 Sep 2001"),
+		gedcom.NewPlaceNode("Sydney"),
+	)).Returns([]*gedcom.DateNode{
+		gedcom.NewDateNode("3 Sep 2001"),
+	})
+}
+
+func TestMarriageNode_Places(t *testing.T) {
+	Places := tf.NamedFunction(t, "MarriageNode_Places", (*gedcom.MarriageNode).Places)
+
+	Places((*gedcom.MarriageNode)(nil)).Returns([]*gedcom.PlaceNode(nil))
+
+	Places(gedcom.NewMarriageNode("")).Returns([]*gedcom.PlaceNode(nil))
+
+	Places(gedcom.NewMarriageNode("",
+		gedcom.NewDateNode("3 Sep 2001"),
+		gedcom.NewPlaceNode("Sydney"),
+	)).Returns([]*gedcom.PlaceNode{
+		gedcom.NewPlaceNode("Sydney"),
+	})
+}
+
+func TestMarriageNode_Equals(t *testing.T) {
+	Equals := tf.Function(t, (*gedcom.MarriageNode).Equals)
+
+	n1 := gedcom.NewMarriageNode("foo")
+	n2 := gedcom.NewMarriageNode("bar")
+
+	// nils
+	Equals((*gedcom.MarriageNode)(nil), (*gedcom.MarriageNode)(nil)).Returns(false)
+	Equals(n1, (*gedcom.MarriageNode)(nil)).Returns(false)
+	Equals((*gedcom.MarriageNode)(nil), n1).Returns(false)
+
+	// Wrong node type.
+	Equals(n1, gedcom.NewNameNode("foo")).Returns(false)
+
+	// All other cases are success.
+	Equals(n1, n1).Returns(true)
+	Equals(n1, n2).Returns(true)
+}
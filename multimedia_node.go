@@ -0,0 +1,105 @@
+package gedcom
+
+// MultimediaNode represents a multimedia object, such as a photo or scanned
+// document, that is stored outside of the GEDCOM file.
+//
+// The same node is used for the OBJE record and the OBJE that points to it
+// from another record. The accessors of a pointer will return the values of
+// the record it points to.
+//
+// GEDCOM 5.5 places the FORM and TITL directly in the OBJE whereas GEDCOM
+// 5.5.1 places them in the FILE. Both are supported.
+type MultimediaNode struct {
+	*simpleDocumentNode
+}
+
+// NewMultimediaNode creates a new OBJE node.
+func NewMultimediaNode(value, pointer string, children ...Node) *MultimediaNode {
+	return newMultimediaNode(nil, value, pointer, children...)
+}
+
+func newMultimediaNode(document *Document, value, pointer string, children ...Node) *MultimediaNode {
+	return &MultimediaNode{
+		newSimpleDocumentNode(document, TagObject, value, pointer, children...),
+	}
+}
+
+// ShallowCopy returns a OBJE with the same value and pointer that belongs to
+// the same document.
+func (node *MultimediaNode) ShallowCopy() Node {
+	return newMultimediaNode(node.Document(), node.Value(), node.Pointer())
+}
+
+// Record returns the OBJE record. If the node is a pointer it will be
+// resolved, otherwise the node itself is returned.
+//
+// If the node is nil or the pointer cannot be resolved the result will be nil.
+func (node *MultimediaNode) Record() *MultimediaNode {
+	if node == nil {
+		return nil
+	}
+
+	if valueToPointer(node.value) == "" {
+		return node
+	}
+
+	if record, ok := nodeForPointerValue(node.document, node.value).(*MultimediaNode); ok {
+		return record
+	}
+
+	return nil
+}
+
+// Files returns the file references. There may be more than one file for the
+// same object.
+//
+// If the node is nil the result will also be nil.
+func (node *MultimediaNode) Files() []string {
+	record := node.Record()
+	if record == nil {
+		return nil
+	}
+
+	var files []string
+	for _, file := range NodesWithTag(record, TagFile) {
+		files = append(files, file.Value())
+	}
+
+	return files
+}
+
+// File is the first file reference.
+//
+// If the node is nil the result will be an empty string.
+func (node *MultimediaNode) File() string {
+	return firstValueWithTag(node.Record(), TagFile)
+}
+
+// Format is the format of the first file, such as "jpg".
+//
+// If the node is nil the result will be an empty string.
+func (node *MultimediaNode) Format() string {
+	return node.fileValue(TagFormat)
+}
+
+// Title is the title of the first file.
+//
+// If the node is nil the result will be an empty string.
+func (node *MultimediaNode) Title() string {
+	return node.fileValue(TagTitle)
+}
+
+func (node *MultimediaNode) fileValue(tag Tag) string {
+	record := node.Record()
+	if record == nil {
+		return ""
+	}
+
+	if file := First(NodesWithTag(record, TagFile)); file != nil {
+		if value := firstValueWithTag(file, tag); value != "" {
+			return value
+		}
+	}
+
+	return firstValueWithTag(record, tag)
+}
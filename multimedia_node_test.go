@@ -0,0 +1,72 @@
+package gedcom_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultimediaNode_File(t *testing.T) {
+	File := tf.Function(t, (*gedcom.MultimediaNode).File)
+
+	File((*gedcom.MultimediaNode)(nil)).Returns("")
+	File(gedcom.NewMultimediaNode("", "M1")).Returns("")
+	File(gedcom.NewMultimediaNode("", "M1",
+		gedcom.NewNode(gedcom.TagFile, "a.jpg", ""),
+		gedcom.NewNode(gedcom.TagFile, "b.jpg", ""))).Returns("a.jpg")
+}
+
+func TestMultimediaNode_Files(t *testing.T) {
+	Files := tf.Function(t, (*gedcom.MultimediaNode).Files)
+
+	Files((*gedcom.MultimediaNode)(nil)).Returns(nil)
+	Files(gedcom.NewMultimediaNode("", "M1")).Returns(nil)
+	Files(gedcom.NewMultimediaNode("", "M1",
+		gedcom.NewNode(gedcom.TagFile, "a.jpg", ""),
+		gedcom.NewNode(gedcom.TagFile, "b.jpg", ""))).Returns([]string{"a.jpg", "b.jpg"})
+}
+
+func TestMultimediaNode_Format(t *testing.T) {
+	Format := tf.Function(t, (*gedcom.MultimediaNode).Format)
+
+	Format((*gedcom.MultimediaNode)(nil)).Returns("")
+	Format(gedcom.NewMultimediaNode("", "M1")).Returns("")
+
+	// GEDCOM 5.5.1
+	Format(gedcom.NewMultimediaNode("", "M1",
+		gedcom.NewNode(gedcom.TagFile, "a.jpg", "",
+			gedcom.NewNode(gedcom.TagFormat, "jpg", "")))).Returns("jpg")
+
+	// GEDCOM 5.5
+	Format(gedcom.NewMultimediaNode("", "M1",
+		gedcom.NewNode(gedcom.TagFormat, "bmp", ""),
+		gedcom.NewNode(gedcom.TagFile, "a.bmp", ""))).Returns("bmp")
+}
+
+func TestMultimediaNode_Title(t *testing.T) {
+	Title := tf.Function(t, (*gedcom.MultimediaNode).Title)
+
+	Title((*gedcom.MultimediaNode)(nil)).Returns("")
+	Title(gedcom.NewMultimediaNode("", "M1",
+		gedcom.NewNode(gedcom.TagFile, "a.jpg", "",
+			gedcom.NewNode(gedcom.TagTitle, "Portrait", "")))).Returns("Portrait")
+	Title(gedcom.NewMultimediaNode("", "M1",
+		gedcom.NewNode(gedcom.TagTitle, "Portrait", ""))).Returns("Portrait")
+}
+
+func TestMultimediaNode_Record(t *testing.T) {
+	doc, err := gedcom.NewDocumentFromString(
+		"0 @I1@ INDI\n1 OBJE @M1@\n1 OBJE\n2 FILE b.jpg\n0 @M1@ OBJE\n1 FILE a.jpg\n2 FORM jpg")
+	assert.NoError(t, err)
+
+	multimedia := doc.Multimedia()
+	references := gedcom.NodesWithTag(doc.Individuals()[0], gedcom.TagObject)
+
+	assert.Len(t, multimedia, 1)
+	assert.Equal(t, multimedia[0], references[0].(*gedcom.MultimediaNode).Record())
+	assert.Equal(t, "a.jpg", references[0].(*gedcom.MultimediaNode).File())
+	assert.Equal(t, "jpg", references[0].(*gedcom.MultimediaNode).Format())
+	assert.Equal(t, "b.jpg", references[1].(*gedcom.MultimediaNode).File())
+}
@@ -56,6 +56,20 @@ func NodesWithTag(node Node, tag Tag) (result Nodes) {
 	return nodes
 }
 
+// firstValueWithTag returns the value of the first child with the tag. If the
+// node is nil or there is no such child the result will be an empty string.
+func firstValueWithTag(node Node, tag Tag) string {
+	if IsNil(node) {
+		return ""
+	}
+
+	if n := First(NodesWithTag(node, tag)); n != nil {
+		return n.Value()
+	}
+
+	return ""
+}
+
 // NodesWithTagPath return all of the nodes that have an exact tag path. The
 // number of nodes returned can be zero and tag must match the tag path
 // completely and exactly.
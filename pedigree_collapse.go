@@ -0,0 +1,189 @@
+package gedcom
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultPedigreeCollapseGenerations is the number of generations that are
+// searched for the PedigreeCollapseWarning.
+const DefaultPedigreeCollapseGenerations = 10
+
+// RepeatedAncestor is an individual that appears more than once in the
+// ancestry of another individual.
+type RepeatedAncestor struct {
+	Individual *IndividualNode
+
+	// Ahnentafel contains the number of the ancestor for each distinct path.
+	// See Ancestor.Ahnentafel.
+	Ahnentafel []int
+}
+
+// Paths is the number of distinct paths to the ancestor. It will always be at
+// least 2.
+func (ancestor *RepeatedAncestor) Paths() int {
+	return len(ancestor.Ahnentafel)
+}
+
+// PedigreeCollapse is the result of IndividualNode.PedigreeCollapse.
+//
+// Pedigree collapse happens when the parents of an individual are related, so
+// that some ancestors appear more than once in the family tree. It is common in
+// small or isolated communities, where it is also known as endogamy.
+type PedigreeCollapse struct {
+	Individual *IndividualNode
+
+	// MaxGenerations is the number of generations that were searched.
+	MaxGenerations int
+
+	// RepeatedAncestors are ordered by their lowest Ahnentafel number.
+	RepeatedAncestors []*RepeatedAncestor
+
+	// Ancestors is the number of ancestors that were found, counting each
+	// ancestor once for each path. DistinctAncestors counts each individual
+	// once.
+	Ancestors, DistinctAncestors int
+}
+
+// PedigreeCollapse finds the ancestors that appear more than once in the
+// ancestry of the individual, up to maxGenerations. See Ancestors.
+//
+// If the node is nil the result will also be nil.
+func (node *IndividualNode) PedigreeCollapse(maxGenerations int) *PedigreeCollapse {
+	if node == nil {
+		return nil
+	}
+
+	ancestors := node.Ancestors(maxGenerations)
+	collapse := &PedigreeCollapse{
+		Individual:     node,
+		MaxGenerations: maxGenerations,
+		Ancestors:      len(ancestors),
+	}
+
+	repeated := map[*IndividualNode]*RepeatedAncestor{}
+	order := IndividualNodes{}
+
+	for _, ancestor := range ancestors {
+		if _, ok := repeated[ancestor.Individual]; !ok {
+			repeated[ancestor.Individual] = &RepeatedAncestor{
+				Individual: ancestor.Individual,
+			}
+			order = append(order, ancestor.Individual)
+		}
+
+		repeatedAncestor := repeated[ancestor.Individual]
+		repeatedAncestor.Ahnentafel = append(repeatedAncestor.Ahnentafel,
+			ancestor.Ahnentafel)
+	}
+
+	collapse.DistinctAncestors = len(order)
+
+	for _, individual := range order {
+		if repeated[individual].Paths() > 1 {
+			collapse.RepeatedAncestors = append(collapse.RepeatedAncestors,
+				repeated[individual])
+		}
+	}
+
+	return collapse
+}
+
+// AncestorLoss is the percentage of ancestors that are missing because they
+// are repeated. For example, if the parents are first cousins then there are
+// 12 distinct ancestors in three generations instead of 14, so the ancestor
+// loss is 14.3%.
+//
+// Only the ancestors that are known are used. That is, the loss is the
+// proportion of Ancestors that are not DistinctAncestors.
+func (collapse *PedigreeCollapse) AncestorLoss() float64 {
+	if collapse == nil || collapse.Ancestors == 0 {
+		return 0
+	}
+
+	repeats := collapse.Ancestors - collapse.DistinctAncestors
+
+	return 100 * float64(repeats) / float64(collapse.Ancestors)
+}
+
+func (collapse *PedigreeCollapse) String() string {
+	ancestors := []string{}
+	for _, ancestor := range collapse.RepeatedAncestors {
+		ancestors = append(ancestors, fmt.Sprintf("%s (%d paths)",
+			ancestor.Individual, ancestor.Paths()))
+	}
+
+	return fmt.Sprintf("%.1f%% ancestor loss in %d generations: %s",
+		collapse.AncestorLoss(), collapse.MaxGenerations,
+		strings.Join(ancestors, ", "))
+}
+
+// parentsAreRelated returns true if the parents of any family that the
+// individual is a child of share an ancestor within maxGenerations. A parent
+// may also be the ancestor of the other parent.
+func (node *IndividualNode) parentsAreRelated(maxGenerations int) bool {
+	for _, family := range node.Parents() {
+		father := family.Husband().Individual()
+		mother := family.Wife().Individual()
+
+		if father == nil || mother == nil {
+			continue
+		}
+
+		fatherAncestors, _ := relationshipAncestors(father, maxGenerations-1)
+		_, motherAncestors := relationshipAncestors(mother, maxGenerations-1)
+
+		for _, ancestor := range motherAncestors {
+			if _, ok := fatherAncestors[ancestor]; ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// pedigreeCollapse only returns the pedigree collapse for the individual where
+// the lines of their ancestors join (their parents are related). Otherwise all
+// of the descendants of that individual would also be reported.
+func (node *IndividualNode) pedigreeCollapse() *PedigreeCollapse {
+	generations := DefaultPedigreeCollapseGenerations
+	if !node.parentsAreRelated(generations) {
+		return nil
+	}
+
+	collapse := node.PedigreeCollapse(generations)
+	if len(collapse.RepeatedAncestors) == 0 {
+		return nil
+	}
+
+	return collapse
+}
+
+func (node *IndividualNode) pedigreeCollapseWarnings() Warnings {
+	collapse := node.pedigreeCollapse()
+	if collapse == nil {
+		return nil
+	}
+
+	return Warnings{
+		NewPedigreeCollapseWarning(collapse),
+	}
+}
+
+// PedigreeCollapses returns the pedigree collapse of each individual where the
+// lines of their ancestors join, in the same order as the individuals. The
+// descendants of these individuals are not included because they would have
+// the same repeated ancestors.
+//
+// Only DefaultPedigreeCollapseGenerations are searched. This is the same as
+// the PedigreeCollapseWarning.
+func (doc *Document) PedigreeCollapses() (collapses []*PedigreeCollapse) {
+	for _, individual := range doc.Individuals() {
+		if collapse := individual.pedigreeCollapse(); collapse != nil {
+			collapses = append(collapses, collapse)
+		}
+	}
+
+	return
+}
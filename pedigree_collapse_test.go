@@ -0,0 +1,109 @@
+package gedcom_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/stretchr/testify/assert"
+)
+
+// P7 and P8 are first cousins. They are the parents of P9, who is the parent
+// of P10.
+var pedigreeCollapseDocument = `0 @P1@ INDI
+1 NAME John /Smith/
+0 @P2@ INDI
+1 NAME Mary /Jones/
+0 @P3@ INDI
+0 @P4@ INDI
+0 @P5@ INDI
+0 @P6@ INDI
+0 @P7@ INDI
+0 @P8@ INDI
+0 @P9@ INDI
+1 NAME Jane /Smith/
+0 @P10@ INDI
+0 @F1@ FAM
+1 HUSB @P1@
+1 WIFE @P2@
+1 CHIL @P3@
+1 CHIL @P4@
+0 @F2@ FAM
+1 HUSB @P3@
+1 WIFE @P5@
+1 CHIL @P7@
+0 @F3@ FAM
+1 HUSB @P6@
+1 WIFE @P4@
+1 CHIL @P8@
+0 @F4@ FAM
+1 HUSB @P7@
+1 WIFE @P8@
+1 CHIL @P9@
+0 @F5@ FAM
+1 WIFE @P9@
+1 CHIL @P10@
+`
+
+func TestIndividualNode_PedigreeCollapse(t *testing.T) {
+	doc := newDocumentFromString(pedigreeCollapseDocument)
+	individual := doc.Individuals().ByPointer
+
+	t.Run("Nil", func(t *testing.T) {
+		assert.Nil(t, (*gedcom.IndividualNode)(nil).PedigreeCollapse(0))
+	})
+
+	t.Run("Collapse", func(t *testing.T) {
+		collapse := individual("P9").PedigreeCollapse(0)
+
+		assert.Equal(t, &gedcom.PedigreeCollapse{
+			Individual:     individual("P9"),
+			MaxGenerations: 0,
+			RepeatedAncestors: []*gedcom.RepeatedAncestor{
+				{Individual: individual("P1"), Ahnentafel: []int{8, 14}},
+				{Individual: individual("P2"), Ahnentafel: []int{9, 15}},
+			},
+			Ancestors:         10,
+			DistinctAncestors: 8,
+		}, collapse)
+
+		assert.Equal(t, 20.0, collapse.AncestorLoss())
+		assert.Equal(t, 2, collapse.RepeatedAncestors[0].Paths())
+	})
+
+	t.Run("MaxGenerations", func(t *testing.T) {
+		collapse := individual("P9").PedigreeCollapse(2)
+
+		assert.Empty(t, collapse.RepeatedAncestors)
+		assert.Equal(t, 0.0, collapse.AncestorLoss())
+	})
+
+	t.Run("Descendant", func(t *testing.T) {
+		collapse := individual("P10").PedigreeCollapse(0)
+
+		assert.Len(t, collapse.RepeatedAncestors, 2)
+		assert.Equal(t, 11, collapse.Ancestors)
+	})
+}
+
+func TestPedigreeCollapseWarning(t *testing.T) {
+	doc := newDocumentFromString(pedigreeCollapseDocument)
+
+	// The descendants of Jane (P10) do not have a warning.
+	assert.Equal(t, []string{
+		"Jane Smith has 2 repeated ancestors with 20.0% ancestor loss in 10 generations: John Smith (2 paths), Mary Jones (2 paths)",
+	}, doc.Warnings().Strings())
+
+	assert.Equal(t, "PedigreeCollapse", doc.Warnings()[0].Name())
+	assert.Equal(t, doc.Individuals().ByPointer("P9"),
+		doc.Warnings()[0].Context().Individual)
+}
+
+func TestDocument_PedigreeCollapses(t *testing.T) {
+	doc := newDocumentFromString(pedigreeCollapseDocument)
+	collapses := doc.PedigreeCollapses()
+
+	assert.Len(t, collapses, 1)
+	assert.Equal(t, doc.Individuals().ByPointer("P9"), collapses[0].Individual)
+	assert.Equal(t, gedcom.DefaultPedigreeCollapseGenerations,
+		collapses[0].MaxGenerations)
+}
@@ -0,0 +1,25 @@
+package gedcom
+
+import "fmt"
+
+// PedigreeCollapseWarning is produced for an individual whose parents are
+// related, so that some of their ancestors appear more than once.
+type PedigreeCollapseWarning struct {
+	SimpleWarning
+	Collapse *PedigreeCollapse
+}
+
+func NewPedigreeCollapseWarning(collapse *PedigreeCollapse) *PedigreeCollapseWarning {
+	return &PedigreeCollapseWarning{
+		Collapse: collapse,
+	}
+}
+
+func (w *PedigreeCollapseWarning) Name() string {
+	return "PedigreeCollapse"
+}
+
+func (w *PedigreeCollapseWarning) String() string {
+	return fmt.Sprintf("%s has %d repeated ancestors with %s",
+		w.Collapse.Individual, len(w.Collapse.RepeatedAncestors), w.Collapse)
+}
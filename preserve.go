@@ -0,0 +1,146 @@
+// Preserving the Original Formatting
+//
+// By default the Encoder writes every node in a consistent format, regardless
+// of how it was formatted when it was decoded. This means that decoding and
+// encoding a file may produce many differences that are not important, such as
+// line endings and spacing.
+//
+// When Decoder.PreserveFormatting is enabled the original bytes of each line
+// are retained. The Encoder will write the original bytes for any node that has
+// not been modified. Only the nodes that have been changed, added or moved are
+// written in the standard format, using the line ending of the original file.
+//
+// This makes it possible to edit a file and produce a minimal diff.
+package gedcom
+
+// nodeSource is the original bytes of a node. This includes any CONC or CONT
+// lines that immediately follow the node, the line endings and any blank lines
+// before the next node.
+//
+// The tag, value, pointer and indent are recorded when the record is complete
+// so that the Encoder can detect if the node has been modified.
+type nodeSource struct {
+	raw          []byte
+	characterSet CharacterSet
+	tag          Tag
+	value        string
+	pointer      string
+	indent       int
+}
+
+// isUnchanged returns true if the node has the same tag, value and pointer as
+// when it was decoded and it is being written at the same indent.
+func (source *nodeSource) isUnchanged(node Node, indent int) bool {
+	return source.tag == node.Tag() &&
+		source.value == node.Value() &&
+		source.pointer == node.Pointer() &&
+		source.indent == indent
+}
+
+// recordSource will attach the bytes that were read before start to the node
+// that they belong to. The remaining bytes will belong to node.
+func (dec *Decoder) recordSource(node Node, start int) {
+	if !dec.PreserveFormatting {
+		return
+	}
+
+	if !IsNil(dec.sourceNode) {
+		if dec.document.LineEnding == "" {
+			dec.document.LineEnding = detectLineEnding(dec.raw[:start])
+		}
+
+		dec.sourceNode.RawSimpleNode().source = &nodeSource{
+			raw:          append([]byte(nil), dec.raw[:start]...),
+			characterSet: dec.document.CharacterSet,
+		}
+	}
+
+	dec.raw = append([]byte(nil), dec.raw[start:]...)
+	dec.sourceNode = node
+}
+
+// recordContinuationSource is used when a CONC or CONT line is folded into its
+// parent. If there are other lines between the node and the continuation the
+// node can no longer be reproduced from the original bytes.
+func (dec *Decoder) recordContinuationSource(parent Node, start int) {
+	if !dec.PreserveFormatting || dec.sourceNode == parent {
+		return
+	}
+
+	dec.recordSource(nil, start)
+	parent.RawSimpleNode().source = nil
+}
+
+// completeSource records the final state of each node in the record. It must
+// be called once the record is complete.
+func completeSource(node Node, indent int) {
+	if source := node.RawSimpleNode().source; source != nil {
+		source.tag = node.Tag()
+		source.value = node.Value()
+		source.pointer = node.Pointer()
+		source.indent = indent
+	}
+
+	for _, child := range node.Nodes() {
+		completeSource(child, indent+1)
+	}
+}
+
+func detectLineEnding(raw []byte) string {
+	for i, b := range raw {
+		switch {
+		case b == '\r' && i+1 < len(raw) && raw[i+1] == '\n':
+			return "\r\n"
+
+		case b == '\r', b == '\n':
+			return string(b)
+		}
+	}
+
+	return ""
+}
+
+// source returns the original bytes for the node, encoded in the character
+// set being written. nil is returned if the node must be written in the
+// standard format.
+func (enc *Encoder) source(indent int, node Node) []byte {
+	source := node.RawSimpleNode().source
+	if source == nil || enc.output != enc.document ||
+		!source.isUnchanged(node, indent) ||
+		source.characterSet.orUTF8() != enc.characterSet().orUTF8() {
+		return nil
+	}
+
+	// UTF-16 has already been converted to UTF-8 by the Decoder.
+	if source.characterSet == CharacterSetUnicode {
+		return encodeUTF16LE(string(source.raw))
+	}
+
+	return source.raw
+}
+
+func (enc *Encoder) lineEnding() string {
+	if enc.output.LineEnding != "" {
+		return enc.output.LineEnding
+	}
+
+	return "\n"
+}
+
+// write will write the data, first adding a line ending if the original bytes
+// of the previous node did not end with one. This happens when the last line
+// of the original file does not have a line ending.
+func (enc *Encoder) write(data []byte) error {
+	if enc.missingLineEnding {
+		enc.missingLineEnding = false
+
+		_, err := enc.w.Write(enc.characterSet().encodeLine(enc.lineEnding()))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := enc.w.Write(data)
+
+	return err
+}
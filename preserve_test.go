@@ -0,0 +1,128 @@
+package gedcom_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/stretchr/testify/assert"
+)
+
+func decodePreserved(t *testing.T, ged string) *gedcom.Document {
+	decoder := gedcom.NewDecoder(strings.NewReader(ged))
+	decoder.PreserveFormatting = true
+
+	doc, err := decoder.Decode()
+	assert.NoError(t, err)
+
+	return doc
+}
+
+func encodePreserved(t *testing.T, doc *gedcom.Document) string {
+	buf := bytes.NewBufferString("")
+	encoder := gedcom.NewEncoder(buf, doc)
+
+	assert.NoError(t, encoder.Encode())
+
+	return buf.String()
+}
+
+func TestDecoder_PreserveFormatting(t *testing.T) {
+	for testName, ged := range map[string]string{
+		"LF":                "0 HEAD\n1 CHAR UTF-8\n0 @I1@ INDI\n1 NAME Bob /Smith/\n",
+		"CRLF":              "0 HEAD\r\n1 CHAR UTF-8\r\n0 @I1@ INDI\r\n1 NAME Bob\r\n",
+		"CR":                "0 HEAD\r0 @I1@ INDI\r1 NAME Bob\r",
+		"NoFinalLineEnding": "0 HEAD\n0 @I1@ INDI\n1 NAME Bob",
+		"Spacing":           "0  HEAD\n1   NAME Bob  \n1 SEX   M\n",
+		"BlankLines":        "0 HEAD\n\n\n0 @I1@ INDI\n\n1 NAME Bob\n",
+		"Continuation":      "0 NOTE foo \n1 CONC  bar\n1 CONT\n1 CONT baz\n",
+		"UnknownTags":       "0 @I1@ INDI\n1 _ZZZ a\n1 _AAA b\n1 _MMM c\n",
+		"BOM":               "\xef\xbb\xbf0 HEAD\r\n1 CHAR UTF-8\r\n",
+		"ANSEL":             "0 HEAD\r\n1 CHAR ANSEL\r\n0 @I1@ INDI\r\n1 NAME Jos\xe2e  \r\n",
+		"UTF16": "\xff\xfe0\x00 \x00H\x00E\x00A\x00D\x00\r\x00\n\x00" +
+			"1\x00 \x00C\x00H\x00A\x00R\x00 \x00U\x00N\x00I\x00C\x00O\x00D\x00E\x00\n\x00",
+	} {
+		t.Run(testName, func(t *testing.T) {
+			doc := decodePreserved(t, ged)
+
+			assert.Equal(t, ged, encodePreserved(t, doc))
+		})
+	}
+}
+
+func TestDecoder_PreserveFormattingModified(t *testing.T) {
+	ged := "0 HEAD\r\n0 @I1@ INDI\r\n1 NAME  Bob /Smith/ \r\n1 SEX M \r\n" +
+		"1 BIRT\r\n2 DATE  1900\r\n0 @I2@ INDI\r\n1 NAME Jane"
+
+	t.Run("ModifiedValue", func(t *testing.T) {
+		doc := decodePreserved(t, ged)
+		doc.Individuals()[0].SetSex(gedcom.SexFemale)
+
+		assert.Equal(t, strings.Replace(ged, "1 SEX M \r\n", "1 SEX F\r\n", 1),
+			encodePreserved(t, doc))
+	})
+
+	t.Run("AddedNode", func(t *testing.T) {
+		doc := decodePreserved(t, ged)
+		doc.Individuals()[0].AddNode(gedcom.NewNode(gedcom.TagDeath, "Y", ""))
+
+		assert.Equal(t, strings.Replace(ged, "0 @I2@", "1 DEAT Y\r\n0 @I2@", 1),
+			encodePreserved(t, doc))
+	})
+
+	t.Run("AddedToEnd", func(t *testing.T) {
+		doc := decodePreserved(t, ged)
+		doc.AddNode(gedcom.NewNode(gedcom.TagNote, "foo", ""))
+
+		assert.Equal(t, ged+"\r\n0 NOTE foo\r\n", encodePreserved(t, doc))
+	})
+
+	t.Run("DeletedNode", func(t *testing.T) {
+		doc := decodePreserved(t, ged)
+		individual := doc.Individuals()[0]
+		individual.DeleteNode(gedcom.First(gedcom.NodesWithTag(individual, gedcom.TagBirth)))
+
+		assert.Equal(t, strings.Replace(ged, "1 BIRT\r\n2 DATE  1900\r\n", "", 1),
+			encodePreserved(t, doc))
+	})
+
+	t.Run("MovedNode", func(t *testing.T) {
+		doc := decodePreserved(t, ged)
+		individuals := doc.Individuals()
+		birth := gedcom.First(gedcom.NodesWithTag(individuals[0], gedcom.TagBirth))
+		date := gedcom.First(gedcom.NodesWithTag(birth, gedcom.TagDate))
+		birth.DeleteNode(date)
+		individuals[0].AddNode(date)
+
+		assert.Equal(t, strings.Replace(ged, "1 BIRT\r\n2 DATE  1900\r\n",
+			"1 BIRT\r\n1 DATE 1900\r\n", 1), encodePreserved(t, doc))
+	})
+
+	t.Run("ContinuationAfterChildren", func(t *testing.T) {
+		doc := decodePreserved(t, "0 NOTE foo\r\n1 SOUR bar\r\n1 CONT baz\r\n")
+
+		assert.Equal(t, "0 NOTE foo\r\n1 CONT baz\r\n1 SOUR bar\r\n",
+			encodePreserved(t, doc))
+	})
+
+	t.Run("ChangedCharacterSet", func(t *testing.T) {
+		doc := decodePreserved(t, "0 HEAD\r\n1 CHAR ANSEL\r\n0 NOTE Jos\xe2e \r\n")
+
+		buf := bytes.NewBufferString("")
+		encoder := gedcom.NewEncoder(buf, doc)
+		encoder.CharacterSet = gedcom.CharacterSetUTF8
+
+		assert.NoError(t, encoder.Encode())
+		assert.Equal(t, "0 HEAD\r\n1 CHAR UTF-8\r\n0 NOTE José\r\n", buf.String())
+	})
+
+	t.Run("NotPreserved", func(t *testing.T) {
+		doc, err := gedcom.NewDocumentFromString(ged)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "0 HEAD\n0 @I1@ INDI\n1 NAME Bob /Smith/\n1 SEX M\n"+
+			"1 BIRT\n2 DATE 1900\n0 @I2@ INDI\n1 NAME Jane\n", doc.String())
+		assert.Equal(t, "", doc.LineEnding)
+	})
+}
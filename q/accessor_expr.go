@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"reflect"
 	"runtime/debug"
+	"strconv"
+	"strings"
+
+	"github.com/elliotchance/gedcom/v39"
 )
 
 // AccessorExpr is used to fetch the value of a property or to invoke a method.
@@ -12,13 +16,17 @@ import (
 //
 // When an accessor is used on a slice the accessor is performed on each
 // element, generating a new slice of that returned type.
+//
+// A method that takes arguments is called with Args, like ".Ancestors(3)".
 type AccessorExpr struct {
 	Query string
+	Args  []*Statement
 }
 
 // Evaluate  will automatically handle conversions between pointer and
-// non-pointers to find the property or method and return the value. However, if
-// it is a method it must not take any arguments.
+// non-pointers to find the property or method and return the value. If it is a
+// method the number of Args must be the same as the number of arguments that
+// the method takes.
 //
 // It will return an error if a property or method could not be found by that
 // name.
@@ -32,28 +40,45 @@ func (e *AccessorExpr) Evaluate(engine *Engine, input interface{}, args []*State
 
 	// If it is a slice we need to Evaluate each one.
 	if in.Kind() == reflect.Slice {
-		t := TypeOfSliceElement(input)
-		if t.Kind() == reflect.Ptr {
-			t = t.Elem()
-		}
-		returnType := e.getReturnType(accessor, reflect.New(t).Interface())
+		returnType := e.getElementReturnType(accessor, TypeOfSliceElement(input))
 
-		results := reflect.MakeSlice(reflect.SliceOf(returnType), 0, 0)
+		results := make([]interface{}, in.Len())
+		canBeNil := isNil(reflect.Zero(returnType).Interface())
 
-		for i := 0; i < in.Len(); i++ {
+		for i := range results {
 			result, err := e.Evaluate(engine, in.Index(i).Interface(), nil)
 			if err != nil {
 				return nil, err
 			}
 
-			results = reflect.Append(results, reflect.ValueOf(result))
+			results[i] = result
+			if result == nil && !canBeNil {
+				returnType = reflect.TypeOf((*interface{})(nil)).Elem()
+				canBeNil = true
+			}
+		}
+
+		typedResults := reflect.MakeSlice(reflect.SliceOf(returnType), 0, len(results))
+		for _, result := range results {
+			value := reflect.Zero(returnType)
+			if result != nil {
+				value = reflect.ValueOf(result)
+			}
+
+			typedResults = reflect.Append(typedResults, value)
 		}
 
-		return results.Interface(), nil
+		return typedResults.Interface(), nil
+	}
+
+	// The years of a missing date (such as the death of someone that has no
+	// death date) are unknown, rather than zero.
+	if _, ok := input.(yearser); ok && isNil(input) && accessor == "Years" {
+		return nil, nil
 	}
 
 	var err error
-	input, err = e.evaluateAccessor(accessor, input)
+	input, err = e.evaluateAccessor(engine, accessor, input)
 
 	if err != nil {
 		return nil, err
@@ -62,7 +87,7 @@ func (e *AccessorExpr) Evaluate(engine *Engine, input interface{}, args []*State
 	return input, nil
 }
 
-func (e *AccessorExpr) evaluateAccessor(accessor string, input interface{}) (r interface{}, err error) {
+func (e *AccessorExpr) evaluateAccessor(engine *Engine, accessor string, input interface{}) (r interface{}, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			stackTrace := string(debug.Stack())
@@ -75,7 +100,12 @@ func (e *AccessorExpr) evaluateAccessor(accessor string, input interface{}) (r i
 
 	switch {
 	case method != nil:
-		return callMethod(*method)
+		args, err := e.methodArgs(engine, accessor, method.Type(), input)
+		if err != nil {
+			return nil, err
+		}
+
+		return callMethod(*method, args)
 
 	case field != nil:
 		return field.Interface(), nil
@@ -99,6 +129,40 @@ func (e *AccessorExpr) getReturnType(accessor string, input interface{}) reflect
 	return nil
 }
 
+// getElementReturnType is the type returned for each element of a slice. If
+// the elements are also slices (such as a slice of gedcom.Ancestors) the
+// result is a slice of the type returned for their elements.
+//
+// If the type cannot be determined, such as when the accessor does not exist,
+// interface{} is returned so that the error can be found when evaluating each
+// element.
+func (e *AccessorExpr) getElementReturnType(accessor string, t reflect.Type) reflect.Type {
+	anyType := reflect.TypeOf((*interface{})(nil)).Elem()
+
+	switch {
+	case t == nil:
+		return anyType
+
+	case t.Kind() == reflect.Slice:
+		elementType := e.getElementReturnType(accessor, t.Elem())
+		if elementType == anyType {
+			return anyType
+		}
+
+		return reflect.SliceOf(elementType)
+
+	case t.Kind() == reflect.Ptr:
+		t = t.Elem()
+	}
+
+	returnType := e.getReturnType(accessor, reflect.New(t).Interface())
+	if returnType == nil {
+		return anyType
+	}
+
+	return returnType
+}
+
 func (e *AccessorExpr) getMethodOrField(accessor string, input interface{}) (*reflect.Value, *reflect.Value) {
 	method := e.getMethod(accessor, input)
 
@@ -162,8 +226,229 @@ func (e *AccessorExpr) getField(accessor string, input interface{}) *reflect.Val
 	return nil
 }
 
-func callMethod(methodByName reflect.Value) (interface{}, error) {
-	result := methodByName.Call([]reflect.Value{})
+// methodArgs evaluates the Args with the same input as the accessor. Each
+// value is converted to the type of the argument of the method.
+func (e *AccessorExpr) methodArgs(engine *Engine, accessor string, methodType reflect.Type, input interface{}) ([]reflect.Value, error) {
+	numIn := methodType.NumIn()
+
+	switch {
+	case methodType.IsVariadic() && len(e.Args) < numIn-1:
+		return nil, fmt.Errorf("%s.%s must take at least %d argument(s), but %d given",
+			getType(input), accessor, numIn-1, len(e.Args))
+
+	case !methodType.IsVariadic() && len(e.Args) != numIn:
+		return nil, fmt.Errorf("%s.%s must take %d argument(s), but %d given",
+			getType(input), accessor, numIn, len(e.Args))
+	}
+
+	args := []reflect.Value{}
+	for i, arg := range e.Args {
+		value, err := arg.Evaluate(engine, input)
+		if err != nil {
+			return nil, err
+		}
+
+		// All of the extra arguments of a variadic method have the same type.
+		var argType reflect.Type
+		if methodType.IsVariadic() && i >= numIn-1 {
+			argType = methodType.In(numIn - 1).Elem()
+		} else {
+			argType = methodType.In(i)
+		}
+
+		argValue, err := convertArg(input, value, argType)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d of %s.%s: %s", i+1,
+				getType(input), accessor, err)
+		}
+
+		args = append(args, argValue)
+	}
+
+	return args, nil
+}
+
+// argDefaults are used as the starting value when an object is converted to
+// a struct. Any keys in the object will replace the default values.
+var argDefaults = map[reflect.Type]func() interface{}{
+	reflect.TypeOf(gedcom.SimilarityOptions{}): func() interface{} {
+		return gedcom.NewSimilarityOptions()
+	},
+}
+
+// convertArg converts a value to the type of a method argument:
+//
+// 1. Constants are always strings, so they are converted to numbers, booleans
+// or named string types (such as gedcom.NameFormat) when needed.
+//
+// 2. A slice that contains exactly one value is treated as that value.
+//
+// 3. A string can be used for a node by its pointer, like "P1". The node is
+// found in the document of the input.
+//
+// 4. An object, like "{MaxYears: 5}", is converted to a struct. The keys are
+// the case-insensitive names of the fields.
+func convertArg(input, value interface{}, t reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Zero(t), nil
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Type().AssignableTo(t) {
+		return v, nil
+	}
+
+	if v.Kind() == reflect.Slice && t.Kind() != reflect.Slice {
+		switch v.Len() {
+		case 0:
+			if isNil(reflect.Zero(t).Interface()) {
+				return reflect.Zero(t), nil
+			}
+
+		case 1:
+			return convertArg(input, v.Index(0).Interface(), t)
+		}
+
+		return reflect.Value{}, fmt.Errorf("cannot use %d values as %s",
+			v.Len(), t)
+	}
+
+	s := fmt.Sprintf("%v", value)
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%v is not an integer", value)
+		}
+
+		return reflect.ValueOf(n).Convert(t), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%v is not a positive integer", value)
+		}
+
+		return reflect.ValueOf(n).Convert(t), nil
+
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%v is not a number", value)
+		}
+
+		return reflect.ValueOf(n).Convert(t), nil
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%v is not a boolean", value)
+		}
+
+		return reflect.ValueOf(b).Convert(t), nil
+
+	case reflect.String:
+		return reflect.ValueOf(s).Convert(t), nil
+
+	case reflect.Ptr, reflect.Interface:
+		if pointer, ok := value.(string); ok {
+			return convertPointerArg(input, pointer, t)
+		}
+	}
+
+	if m, ok := value.(map[string]interface{}); ok {
+		return convertObjectArg(input, m, t)
+	}
+
+	return reflect.Value{}, fmt.Errorf("cannot use %s as %s", getType(value), t)
+}
+
+// convertPointerArg finds the node for a pointer in the document of the input.
+func convertPointerArg(input interface{}, pointer string, t reflect.Type) (reflect.Value, error) {
+	var document *gedcom.Document
+
+	switch in := input.(type) {
+	case *gedcom.Document:
+		document = in
+
+	case interface{ Document() *gedcom.Document }:
+		document = in.Document()
+	}
+
+	if document == nil {
+		return reflect.Value{}, fmt.Errorf("cannot use %s as %s", pointer, t)
+	}
+
+	node := document.NodeByPointer(pointer)
+	if isNil(node) {
+		return reflect.Value{}, fmt.Errorf("no such pointer %s", pointer)
+	}
+
+	v := reflect.ValueOf(node)
+	if !v.Type().AssignableTo(t) {
+		return reflect.Value{}, fmt.Errorf("cannot use %s (%s) as %s", pointer,
+			getType(node), t)
+	}
+
+	return v, nil
+}
+
+// convertObjectArg creates a struct (or a pointer to a struct) from an object.
+func convertObjectArg(input interface{}, m map[string]interface{}, t reflect.Type) (reflect.Value, error) {
+	structType := t
+	if t.Kind() == reflect.Ptr {
+		structType = t.Elem()
+	}
+
+	if structType.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("cannot use object as %s", t)
+	}
+
+	result := reflect.New(structType).Elem()
+	if fn, ok := argDefaults[structType]; ok {
+		result.Set(reflect.ValueOf(fn()))
+	}
+
+	for key, value := range m {
+		field, ok := structType.FieldByNameFunc(func(name string) bool {
+			return strings.EqualFold(name, key)
+		})
+		if !ok || field.PkgPath != "" {
+			return reflect.Value{}, fmt.Errorf(`%s does not have a field named "%s"`,
+				structType.Name(), key)
+		}
+
+		fieldValue, err := convertArg(input, value, field.Type)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%s.%s: %s", structType.Name(),
+				field.Name, err)
+		}
+
+		result.FieldByIndex(field.Index).Set(fieldValue)
+	}
+
+	if t.Kind() == reflect.Ptr {
+		return result.Addr(), nil
+	}
+
+	return result, nil
+}
+
+// callMethod returns the first value returned from the method. If the last
+// value returned is a non-nil error it will be returned instead.
+func callMethod(methodByName reflect.Value, args []reflect.Value) (interface{}, error) {
+	result := methodByName.Call(args)
+
+	if len(result) == 0 {
+		return nil, nil
+	}
+
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+	last := result[len(result)-1]
+	if last.Type() == errorType && !last.IsNil() {
+		return nil, last.Interface().(error)
+	}
 
 	return result[0].Interface(), nil
 }
@@ -1,11 +1,15 @@
 package q_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
+	"github.com/elliotchance/gedcom/v39"
 	"github.com/elliotchance/gedcom/v39/q"
 	"github.com/elliotchance/tf"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type MyStruct struct {
@@ -24,6 +28,23 @@ func (ms MyStruct) Panic() string {
 	panic("oh no!")
 }
 
+func (ms MyStruct) Repeat(s string, times ...uint) string {
+	result := s
+	for _, n := range times {
+		result = strings.Repeat(result, int(n))
+	}
+
+	return result
+}
+
+func (ms MyStruct) Check(ok bool) (string, error) {
+	if !ok {
+		return "", errors.New("not ok")
+	}
+
+	return "ok", nil
+}
+
 func TestAccessorExpr_Evaluate(t *testing.T) {
 	Evaluate := tf.NamedFunction(t, "Accessor_Evaluate", (*q.AccessorExpr).Evaluate)
 	engine := &q.Engine{}
@@ -62,3 +83,138 @@ func TestAccessorExpr_Evaluate(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Regexp(t, `^panic MyStruct.Panic: oh no!\ngoroutine `, err)
 }
+
+func TestAccessorExpr_EvaluateVariadicArgs(t *testing.T) {
+	Evaluate := tf.NamedFunction(t, "Accessor_Evaluate", (*q.AccessorExpr).Evaluate)
+	engine := &q.Engine{}
+	ms := MyStruct{}
+
+	arg := func(value string) *q.Statement {
+		return &q.Statement{Expressions: []q.Expression{
+			&q.ConstantExpr{Value: value},
+		}}
+	}
+
+	Evaluate(&q.AccessorExpr{Query: ".Repeat", Args: []*q.Statement{
+		arg("ab"),
+	}}, engine, ms, nil).Returns("ab", nil)
+	Evaluate(&q.AccessorExpr{Query: ".Repeat", Args: []*q.Statement{
+		arg("ab"), arg("2"), arg("3"),
+	}}, engine, ms, nil).Returns("abababababab", nil)
+	Evaluate(&q.AccessorExpr{Query: ".Repeat"}, engine, ms, nil).
+		Errors("MyStruct.Repeat must take at least 1 argument(s), but 0 given")
+	Evaluate(&q.AccessorExpr{Query: ".Repeat", Args: []*q.Statement{
+		arg("ab"), arg("-1"),
+	}}, engine, ms, nil).
+		Errors("argument 2 of MyStruct.Repeat: -1 is not a positive integer")
+
+	Evaluate(&q.AccessorExpr{Query: ".Check", Args: []*q.Statement{
+		arg("true"),
+	}}, engine, ms, nil).Returns("ok", nil)
+	Evaluate(&q.AccessorExpr{Query: ".Check", Args: []*q.Statement{
+		arg("false"),
+	}}, engine, ms, nil).Errors("not ok")
+	Evaluate(&q.AccessorExpr{Query: ".Check", Args: []*q.Statement{
+		arg("yes"),
+	}}, engine, ms, nil).
+		Errors("argument 1 of MyStruct.Check: yes is not a boolean")
+}
+
+func TestAccessorExpr_EvaluateArgs(t *testing.T) {
+	document := gedcom.NewDocument()
+	father := document.AddIndividual("P1").
+		AddName("John /Smith/").
+		AddBirthDate("3 Sep 1850")
+	mother := document.AddIndividual("P2").AddName("Jane /Doe/")
+	child := document.AddIndividual("P3").
+		AddName("Bob /Smith/").
+		AddBirthDate("1880").
+		AddDeathDate("1950")
+	document.AddFamilyWithHusbandAndWife("F1", father, mother).AddChild(child)
+
+	parser := q.NewParser()
+
+	for _, test := range []struct {
+		query    string
+		expected interface{}
+		err      string
+	}{
+		{
+			query:    `.Individuals | Only(.Pointer = "P3") | .Ancestors(1) | .Ahnentafel`,
+			expected: [][]int{{2, 3}},
+		},
+		{
+			query:    `.Individuals | First(1) | .Descendants(5) | .DAboville`,
+			expected: [][]string{{"1.1"}},
+		},
+		{
+			query: `.Individuals | First(1) | .Ancestors`,
+			err:   "IndividualNode.Ancestors must take 1 argument(s), but 0 given",
+		},
+		{
+			query: `.Individuals | First(1) | .Ancestors("foo")`,
+			err:   "argument 1 of IndividualNode.Ancestors: foo is not an integer",
+		},
+		{
+			query:    `.Individuals | .Name.Format("%f %L")`,
+			expected: []string{"John SMITH", "Jane DOE", "Bob SMITH"},
+		},
+		{
+			query:    `.Individuals | First(1) | .Name.Format("%l")`,
+			expected: []string{"Smith"},
+		},
+		{
+			query:    `.Individuals | First(1) | .FamilyWithSpouse("P2") | .Pointer`,
+			expected: []string{"F1"},
+		},
+		{
+			query:    `.Individuals | First(1) | .FamilyWithSpouse("P3")`,
+			expected: []*gedcom.FamilyNode{nil},
+		},
+		{
+			query: `.Individuals | First(1) | .FamilyWithSpouse("P9")`,
+			err:   "argument 1 of IndividualNode.FamilyWithSpouse: no such pointer P9",
+		},
+		{
+			query: `.Individuals | First(1) | .FamilyWithSpouse("F1")`,
+			err:   "argument 1 of IndividualNode.FamilyWithSpouse: cannot use F1 (FamilyNode) as *gedcom.IndividualNode",
+		},
+		{
+			query:    `.Individuals | First(1) | .Similarity("P1", {})`,
+			expected: []float64{0.875},
+		},
+		{
+			query:    `.Individuals | First(1) | .Similarity("P1", {nameToDateRatio: 1})`,
+			expected: []float64{1},
+		},
+		{
+			query: `.Individuals | First(1) | .Similarity("P1", {Foo: 1})`,
+			err:   `argument 2 of IndividualNode.Similarity: SimilarityOptions does not have a field named "Foo"`,
+		},
+		{
+			query: `.Individuals | First(1) | .Similarity("P1", {maxYears: "x"})`,
+			err:   "argument 2 of IndividualNode.Similarity: SimilarityOptions.MaxYears: x is not a number",
+		},
+		{
+			query: `.Individuals | First(1) | .Similarity("P1", "P2")`,
+			err:   "argument 2 of IndividualNode.Similarity: cannot use string as gedcom.SimilarityOptions",
+		},
+		{
+			query:    `.Individuals | Only(.Pointer = "P3") | .AgeAt(.Deaths) | .Years > 69.9`,
+			expected: []bool{true},
+		},
+	} {
+		t.Run(test.query, func(t *testing.T) {
+			engine, err := parser.ParseString(test.query)
+			require.NoError(t, err)
+
+			actual, err := engine.Evaluate([]*gedcom.Document{document})
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package q
+
+// AvgExpr is a function. See Evaluate.
+type AvgExpr struct{}
+
+// Evaluate returns the average (mean) of the numbers in the slice. If there is
+// an argument then the value of the argument for each element is used instead.
+//
+// Values that are not numbers (see "Data Types") are ignored. The result is a
+// float64, or nil if there are no numbers.
+func (e *AvgExpr) Evaluate(engine *Engine, input interface{}, args []*Statement) (interface{}, error) {
+	numbers, err := evaluateNumbers(engine, input, args, "Avg")
+	if err != nil || len(numbers) == 0 {
+		return nil, err
+	}
+
+	sum := 0.0
+	for _, number := range numbers {
+		sum += number
+	}
+
+	return sum / float64(len(numbers)), nil
+}
@@ -0,0 +1,26 @@
+package q_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39/q"
+	"github.com/elliotchance/tf"
+)
+
+func TestAvgExpr_Evaluate(t *testing.T) {
+	Evaluate := tf.NamedFunction(t, "AvgExpr_Evaluate", (*q.AvgExpr).Evaluate)
+	engine := &q.Engine{}
+
+	argProperty := []*q.Statement{{Expressions: []q.Expression{
+		&q.AccessorExpr{Query: ".Property"},
+	}}}
+
+	Evaluate(&q.AvgExpr{}, engine, nil, nil).Returns(nil, nil)
+	Evaluate(&q.AvgExpr{}, engine, []string{"foo"}, nil).Returns(nil, nil)
+	Evaluate(&q.AvgExpr{}, engine, []int{1, 2, 6}, nil).Returns(3.0, nil)
+	Evaluate(&q.AvgExpr{}, engine, []string{"1.5", "foo", "2.5"}, nil).
+		Returns(2.0, nil)
+	Evaluate(&q.AvgExpr{}, engine,
+		[]MyStruct{{Property: 5}, {Property: 13}}, argProperty).
+		Returns(9.0, nil)
+}
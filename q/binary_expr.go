@@ -3,8 +3,11 @@ package q
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/elliotchance/gedcom/v39"
 )
 
 // BinaryExpr evaluates a binary operator expression.
@@ -13,22 +16,46 @@ type BinaryExpr struct {
 	Operator    string
 }
 
+// The precedence of each operator. Operators with a higher precedence are
+// evaluated first, so "1 + 2 * 3" is 7 and ".A or .B and .C" is the same as
+// ".A or (.B and .C)".
+const (
+	PrecedenceOr = iota + 1
+	PrecedenceAnd
+	PrecedenceComparison
+	PrecedenceAdditive
+	PrecedenceMultiplicative
+)
+
 // Operators contains the tokens and functions for all operators.
 //
 // It is important that the operators are ordered so that the operators with
 // most tokens are read first. This prevents it from consuming operators that
 // are subsets of others.
+//
+// Operators that are words (such as "and") use a single TokenWord. The value
+// of the word must be the same as the Name.
 var Operators = []struct {
-	Name     string
-	Tokens   []TokenKind
-	Function func(left, right interface{}) (bool, error)
+	Name       string
+	Tokens     []TokenKind
+	Precedence int
+	Function   func(left, right interface{}) (interface{}, error)
 }{
-	{"!=", []TokenKind{TokenNot, TokenEqual}, notEqual},
-	{">=", []TokenKind{TokenGreaterThan, TokenEqual}, greaterThanEqual},
-	{"<=", []TokenKind{TokenLessThan, TokenEqual}, lessThanEqual},
-	{"=", []TokenKind{TokenEqual}, equal},
-	{">", []TokenKind{TokenGreaterThan}, greaterThan},
-	{"<", []TokenKind{TokenLessThan}, lessThan},
+	{"or", []TokenKind{TokenWord}, PrecedenceOr, or},
+	{"and", []TokenKind{TokenWord}, PrecedenceAnd, and},
+	{"!=", []TokenKind{TokenNot, TokenEqual}, PrecedenceComparison, comparison(notEqual)},
+	{">=", []TokenKind{TokenGreaterThan, TokenEqual}, PrecedenceComparison, ordering(greaterThanEqual)},
+	{"<=", []TokenKind{TokenLessThan, TokenEqual}, PrecedenceComparison, ordering(lessThanEqual)},
+	{"=", []TokenKind{TokenEqual}, PrecedenceComparison, comparison(equal)},
+	{">", []TokenKind{TokenGreaterThan}, PrecedenceComparison, ordering(greaterThan)},
+	{"<", []TokenKind{TokenLessThan}, PrecedenceComparison, ordering(lessThan)},
+	{"contains", []TokenKind{TokenWord}, PrecedenceComparison, textual(contains)},
+	{"startsWith", []TokenKind{TokenWord}, PrecedenceComparison, textual(startsWith)},
+	{"matches", []TokenKind{TokenWord}, PrecedenceComparison, textual(matches)},
+	{"+", []TokenKind{TokenPlus}, PrecedenceAdditive, add},
+	{"-", []TokenKind{TokenMinus}, PrecedenceAdditive, arithmetic(subtract)},
+	{"*", []TokenKind{TokenMultiply}, PrecedenceMultiplicative, arithmetic(multiply)},
+	{"/", []TokenKind{TokenDivide}, PrecedenceMultiplicative, arithmetic(divide)},
 }
 
 func (e *BinaryExpr) Evaluate(engine *Engine, input interface{}, args []*Statement) (interface{}, error) {
@@ -36,18 +63,7 @@ func (e *BinaryExpr) Evaluate(engine *Engine, input interface{}, args []*Stateme
 
 	// If it is a slice we need to Evaluate each one.
 	if in.Kind() == reflect.Slice {
-		results := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(true)), 0, in.Len())
-
-		for i := 0; i < in.Len(); i++ {
-			result, err := e.Evaluate(engine, in.Index(i).Interface(), args)
-			if err != nil {
-				return nil, err
-			}
-
-			results = reflect.Append(results, reflect.ValueOf(result))
-		}
-
-		return results.Interface(), nil
+		return evaluateElements(engine, in, e, args)
 	}
 
 	left, err := e.Left.Evaluate(engine, input, args)
@@ -108,6 +124,20 @@ func compareStrings(s, t string, op func(string, string) bool) bool {
 }
 
 func equal(left, right interface{}) (bool, error) {
+	// A node is also equal to its raw value, so that ".Sex = "F"" works as
+	// well as ".Sex = "Female"".
+	if node, ok := left.(gedcom.Node); ok && !isNil(node) {
+		if isEqual, _ := equal(node.Value(), right); isEqual {
+			return true, nil
+		}
+	}
+
+	if node, ok := right.(gedcom.Node); ok && !isNil(node) {
+		if isEqual, _ := equal(left, node.Value()); isEqual {
+			return true, nil
+		}
+	}
+
 	sLeft, sRight := binaryStrings(left, right)
 
 	// Compare as numbers.
@@ -188,3 +218,238 @@ func lessThanEqual(left, right interface{}) (bool, error) {
 
 	return compareStrings(sLeft, sRight, compare), nil
 }
+
+// evaluateElements evaluates the expression for each element of the slice.
+// The result is a slice of the same type as the results, such as []bool for
+// comparisons. If the results are not all the same type the result will be
+// []interface{}.
+func evaluateElements(engine *Engine, in reflect.Value, e Expression, args []*Statement) (interface{}, error) {
+	results := make([]interface{}, in.Len())
+	var resultType reflect.Type
+
+	for i := range results {
+		result, err := e.Evaluate(engine, in.Index(i).Interface(), args)
+		if err != nil {
+			return nil, err
+		}
+
+		t := reflect.TypeOf(result)
+		if i == 0 {
+			resultType = t
+		} else if t != resultType {
+			resultType = nil
+		}
+
+		results[i] = result
+	}
+
+	if resultType == nil {
+		if len(results) == 0 {
+			return []bool{}, nil
+		}
+
+		return results, nil
+	}
+
+	typedResults := reflect.MakeSlice(reflect.SliceOf(resultType), 0, len(results))
+	for _, result := range results {
+		typedResults = reflect.Append(typedResults, reflect.ValueOf(result))
+	}
+
+	return typedResults.Interface(), nil
+}
+
+// comparison uses the years of a date or age when it is compared to a number
+// (or another date) so that ".Age > 80" compares the age in years.
+func comparison(op func(left, right interface{}) (bool, error)) func(left, right interface{}) (interface{}, error) {
+	return func(left, right interface{}) (interface{}, error) {
+		_, leftIsYears := left.(yearser)
+		_, rightIsYears := right.(yearser)
+
+		if leftIsYears || rightIsYears {
+			floatLeft, okLeft := arithmeticValue(left)
+			floatRight, okRight := arithmeticValue(right)
+
+			if okLeft && okRight {
+				return op(floatLeft, floatRight)
+			}
+		}
+
+		return op(left, right)
+	}
+}
+
+// ordering is a comparison that is always false when either side is nil, such
+// as the years of a missing date.
+func ordering(op func(left, right interface{}) (bool, error)) func(left, right interface{}) (interface{}, error) {
+	compare := comparison(op)
+
+	return func(left, right interface{}) (interface{}, error) {
+		if isNil(left) || isNil(right) {
+			return false, nil
+		}
+
+		return compare(left, right)
+	}
+}
+
+func textual(op func(left, right interface{}) (bool, error)) func(left, right interface{}) (interface{}, error) {
+	return func(left, right interface{}) (interface{}, error) {
+		return op(left, right)
+	}
+}
+
+func contains(left, right interface{}) (bool, error) {
+	// A slice contains an element that is equal.
+	if l := reflect.ValueOf(left); l.Kind() == reflect.Slice {
+		for i := 0; i < l.Len(); i++ {
+			if isEqual, _ := equal(l.Index(i).Interface(), right); isEqual {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	sLeft, sRight := binaryStrings(left, right)
+
+	return compareStrings(sLeft, sRight, strings.Contains), nil
+}
+
+func startsWith(left, right interface{}) (bool, error) {
+	sLeft, sRight := binaryStrings(left, right)
+
+	return compareStrings(sLeft, sRight, strings.HasPrefix), nil
+}
+
+func matches(left, right interface{}) (bool, error) {
+	sLeft, sRight := binaryStrings(left, right)
+
+	re, err := regexp.Compile(sRight)
+	if err != nil {
+		return false, err
+	}
+
+	return re.MatchString(sLeft), nil
+}
+
+// truthy returns the boolean value used by "and", "or" and "not". Only false,
+// nil, zero, empty strings and empty slices are false.
+func truthy(value interface{}) bool {
+	if isNil(value) {
+		return false
+	}
+
+	if b, ok := value.(bool); ok {
+		return b
+	}
+
+	if f, ok := numberValue(value); ok {
+		return f != 0
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.String:
+		return strings.TrimSpace(v.String()) != ""
+
+	case reflect.Slice, reflect.Map:
+		return v.Len() > 0
+	}
+
+	return true
+}
+
+func and(left, right interface{}) (interface{}, error) {
+	return truthy(left) && truthy(right), nil
+}
+
+func or(left, right interface{}) (interface{}, error) {
+	return truthy(left) || truthy(right), nil
+}
+
+// yearser is implemented by dates, date ranges and ages. The years are used in
+// place of the value for arithmetic.
+type yearser interface {
+	Years() float64
+}
+
+// arithmeticValue returns the number used for arithmetic. Dates (and anything
+// else that has a Years method) use their years.
+//
+// A date that is missing or cannot be understood has zero years. It is not a
+// number, otherwise subtracting a missing death date from a birth date would
+// result in a negative lifespan. An unknown age is also not a number, but an
+// age of zero is.
+func arithmeticValue(value interface{}) (float64, bool) {
+	if isNil(value) {
+		return 0, false
+	}
+
+	if age, ok := value.(gedcom.Age); ok {
+		return age.Years(), age.IsKnown
+	}
+
+	if v, ok := value.(yearser); ok {
+		years := v.Years()
+
+		return years, years != 0
+	}
+
+	return numberValue(value)
+}
+
+// arithmetic returns nil when either value is not a number, or the operation
+// has no result (such as dividing by zero).
+func arithmetic(op func(left, right float64) (float64, bool)) func(left, right interface{}) (interface{}, error) {
+	return func(left, right interface{}) (interface{}, error) {
+		floatLeft, okLeft := arithmeticValue(left)
+		floatRight, okRight := arithmeticValue(right)
+
+		if !okLeft || !okRight {
+			return nil, nil
+		}
+
+		if result, ok := op(floatLeft, floatRight); ok {
+			return result, nil
+		}
+
+		return nil, nil
+	}
+}
+
+// add will join the values as strings if either value is not a number.
+func add(left, right interface{}) (interface{}, error) {
+	floatLeft, okLeft := arithmeticValue(left)
+	floatRight, okRight := arithmeticValue(right)
+
+	if okLeft && okRight {
+		return floatLeft + floatRight, nil
+	}
+
+	var sLeft, sRight string
+	if !isNil(left) {
+		sLeft = fmt.Sprintf("%v", left)
+	}
+
+	if !isNil(right) {
+		sRight = fmt.Sprintf("%v", right)
+	}
+
+	return sLeft + sRight, nil
+}
+
+func subtract(left, right float64) (float64, bool) {
+	return left - right, true
+}
+
+func multiply(left, right float64) (float64, bool) {
+	return left * right, true
+}
+
+func divide(left, right float64) (float64, bool) {
+	if right == 0 {
+		return 0, false
+	}
+
+	return left / right, true
+}
@@ -3,6 +3,7 @@ package q_test
 import (
 	"testing"
 
+	"github.com/elliotchance/gedcom/v39"
 	"github.com/elliotchance/gedcom/v39/q"
 	"github.com/elliotchance/tf"
 )
@@ -33,6 +34,11 @@ func TestBinaryExpr_Evaluate(t *testing.T) {
 		{&q.ConstantExpr{"\nfoo "}, "!=", &q.ConstantExpr{" Foo\t"}, false},
 		{&q.ConstantExpr{"foo"}, "!=", &q.ConstantExpr{"bar"}, true},
 
+		{&q.ValueExpr{gedcom.NewNode(gedcom.TagSex, "F", "")}, "=", &q.ConstantExpr{"F"}, true},
+		{&q.ValueExpr{gedcom.NewNode(gedcom.TagSex, "F", "")}, "=", &q.ConstantExpr{"Female"}, true},
+		{&q.ConstantExpr{"M"}, "=", &q.ValueExpr{gedcom.NewNode(gedcom.TagSex, "F", "")}, false},
+		{&q.ValueExpr{gedcom.NewNode(gedcom.TagSex, "F", "")}, "!=", &q.ConstantExpr{"F"}, false},
+
 		{&q.ConstantExpr{"0"}, ">", &q.ConstantExpr{""}, true},
 		{&q.ConstantExpr{"1.50"}, ">", &q.ConstantExpr{"1.5"}, false},
 		{&q.ConstantExpr{"1.6"}, ">", &q.ConstantExpr{"1.601"}, false},
@@ -65,16 +71,76 @@ func TestBinaryExpr_Evaluate(t *testing.T) {
 		{&q.ConstantExpr{"\nfoo "}, "<=", &q.ConstantExpr{" Foo\t"}, true},
 		{&q.ConstantExpr{"foo"}, "<=", &q.ConstantExpr{"bar"}, false},
 
-		// TODO: Some ideas?
-		//
-		// >> ends with
-		// !>>
-		// << starts with
-		// !<<
-		// >< contains
-		// !><
-		// ~ match regexp
-		// !~
+		{&q.ValueExpr{nil}, ">", &q.ConstantExpr{"0"}, false},
+		{&q.ValueExpr{nil}, ">=", &q.ConstantExpr{"0"}, false},
+		{&q.ConstantExpr{"0"}, "<", &q.ValueExpr{nil}, false},
+		{&q.ConstantExpr{"0"}, "<=", &q.ValueExpr{nil}, false},
+
+		{&q.ConstantExpr{"John Smith"}, "contains", &q.ConstantExpr{"SMITH"}, true},
+		{&q.ConstantExpr{"John Smith"}, "contains", &q.ConstantExpr{"Jones"}, false},
+		{&q.ValueExpr{[]string{"a", "b"}}, "contains", &q.ConstantExpr{"B"}, true},
+		{&q.ValueExpr{[]string{"a", "b"}}, "contains", &q.ConstantExpr{"c"}, false},
+
+		{&q.ConstantExpr{"John Smith"}, "startsWith", &q.ConstantExpr{"john"}, true},
+		{&q.ConstantExpr{"John Smith"}, "startsWith", &q.ConstantExpr{"Smith"}, false},
+
+		{&q.ConstantExpr{"John Smith"}, "matches", &q.ConstantExpr{"^J.*h$"}, true},
+		{&q.ConstantExpr{"John Smith"}, "matches", &q.ConstantExpr{"^j"}, false},
+		{&q.ConstantExpr{"John Smith"}, "matches", &q.ConstantExpr{"(?i)^j"}, true},
+
+		{&q.ValueExpr{true}, "and", &q.ValueExpr{true}, true},
+		{&q.ValueExpr{true}, "and", &q.ValueExpr{false}, false},
+		{&q.ValueExpr{true}, "and", &q.ConstantExpr{""}, false},
+		{&q.ValueExpr{false}, "or", &q.ValueExpr{true}, true},
+		{&q.ValueExpr{false}, "or", &q.ValueExpr{nil}, false},
+		{&q.ValueExpr{false}, "or", &q.ConstantExpr{"0"}, false},
+		{&q.ValueExpr{false}, "or", &q.ConstantExpr{"foo"}, true},
+	} {
+		Evaluate(&q.BinaryExpr{
+			Left:     test.left,
+			Operator: test.op,
+			Right:    test.right,
+		}, engine, nil, nil).Returns(test.expected, nil)
+	}
+}
+
+func TestBinaryExpr_EvaluateArithmetic(t *testing.T) {
+	Evaluate := tf.NamedFunction(t, "BinaryExpr_EvaluateArithmetic", (*q.BinaryExpr).Evaluate)
+	engine := &q.Engine{}
+
+	date := gedcom.NewDateNode("1890")
+
+	for _, test := range []struct {
+		left     q.Expression
+		op       string
+		right    q.Expression
+		expected interface{}
+	}{
+		{&q.ConstantExpr{"1.5"}, "+", &q.ConstantExpr{"2"}, 3.5},
+		{&q.ValueExpr{3}, "+", &q.ConstantExpr{"2"}, 5.0},
+		{&q.ConstantExpr{"foo"}, "+", &q.ConstantExpr{" bar"}, "foo bar"},
+		{&q.ConstantExpr{"foo"}, "+", &q.ValueExpr{nil}, "foo"},
+		{&q.ConstantExpr{"10"}, "-", &q.ConstantExpr{"2.5"}, 7.5},
+		{&q.ConstantExpr{"foo"}, "-", &q.ConstantExpr{"2"}, nil},
+		{&q.ConstantExpr{"3"}, "*", &q.ConstantExpr{"4"}, 12.0},
+		{&q.ConstantExpr{"3"}, "/", &q.ConstantExpr{"4"}, 0.75},
+		{&q.ValueExpr{nil}, "/", &q.ConstantExpr{"4"}, nil},
+
+		// Dates use their years.
+		{&q.ValueExpr{date}, "-", &q.ConstantExpr{"1800"}, 90.5},
+		{&q.ValueExpr{date}, "+", &q.ConstantExpr{"10"}, 1900.5},
+
+		// Missing dates, and dates that cannot be understood, are not numbers.
+		{&q.ValueExpr{(*gedcom.DateNode)(nil)}, "-", &q.ValueExpr{date}, nil},
+		{&q.ValueExpr{gedcom.NewDateNode("")}, "-", &q.ValueExpr{date}, nil},
+		{&q.ValueExpr{gedcom.NewDateNode("foo")}, "*", &q.ConstantExpr{"2"}, nil},
+
+		// An age of zero is still a number, but an unknown age is not.
+		{&q.ValueExpr{gedcom.NewAge(0, false, gedcom.AgeConstraintLiving)}, "+", &q.ConstantExpr{"1"}, 1.0},
+		{&q.ValueExpr{gedcom.NewUnknownAge()}, "-", &q.ConstantExpr{"1"}, nil},
+
+		// Dividing by zero has no result.
+		{&q.ConstantExpr{"3"}, "/", &q.ConstantExpr{"0"}, nil},
 	} {
 		Evaluate(&q.BinaryExpr{
 			Left:     test.left,
@@ -82,4 +148,18 @@ func TestBinaryExpr_Evaluate(t *testing.T) {
 			Right:    test.right,
 		}, engine, nil, nil).Returns(test.expected, nil)
 	}
+
+	Evaluate(&q.BinaryExpr{
+		Left:     &q.ConstantExpr{"foo"},
+		Operator: "matches",
+		Right:    &q.ConstantExpr{"("},
+	}, engine, nil, nil).Errors("error parsing regexp: missing closing ): `(`")
+
+	// Slices are evaluated for each element.
+	Evaluate(&q.BinaryExpr{
+		Left:     &q.AccessorExpr{Query: ".Property"},
+		Operator: "*",
+		Right:    &q.ConstantExpr{"2"},
+	}, engine, []MyStruct{{Property: 3}, {Property: 4}}, nil).
+		Returns([]float64{6, 8}, nil)
 }
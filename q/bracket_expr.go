@@ -0,0 +1,12 @@
+package q
+
+// BracketExpr is an expression surrounded by brackets. It is used to group
+// operators, such as "(.A or .B) and .C", or to use the result of expressions
+// that are separated by pipes as one value, such as "(.Death | .Years) - 1".
+type BracketExpr struct {
+	Statement *Statement
+}
+
+func (e *BracketExpr) Evaluate(engine *Engine, input interface{}, args []*Statement) (interface{}, error) {
+	return e.Statement.Evaluate(engine, input)
+}
@@ -0,0 +1,27 @@
+package q_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39/q"
+	"github.com/elliotchance/tf"
+)
+
+func TestBracketExpr_Evaluate(t *testing.T) {
+	Evaluate := tf.NamedFunction(t, "BracketExpr_Evaluate", (*q.BracketExpr).Evaluate)
+	engine := &q.Engine{}
+
+	// The whole slice is the input, rather than each element.
+	Evaluate(&q.BracketExpr{&q.Statement{Expressions: []q.Expression{
+		&q.LengthExpr{},
+	}}}, engine, []int{1, 2, 3}, nil).Returns(3, nil)
+
+	Evaluate(&q.BracketExpr{&q.Statement{Expressions: []q.Expression{
+		&q.AccessorExpr{Query: ".Property"},
+		&q.BinaryExpr{
+			Left:     &q.ConstantExpr{"2"},
+			Operator: "+",
+			Right:    &q.ConstantExpr{"3"},
+		},
+	}}}, engine, MyStruct{Property: 3}, nil).Returns(5.0, nil)
+}
@@ -0,0 +1,34 @@
+package q
+
+// CountExpr is a function. See Evaluate.
+type CountExpr struct{}
+
+// Evaluate returns the number of elements in the slice. If there is an
+// argument then only the elements where the condition is true are counted.
+//
+// If the input value is not a slice then it is treated as a slice of one
+// element. A nil input has zero elements.
+func (e *CountExpr) Evaluate(engine *Engine, input interface{}, args []*Statement) (interface{}, error) {
+	in, ok := valueAsSlice(input)
+	if !ok {
+		return 0, nil
+	}
+
+	values, err := evaluateEach(engine, in, args, "Count")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(args) == 0 {
+		return len(values), nil
+	}
+
+	count := 0
+	for _, value := range values {
+		if condition, ok := value.(bool); ok && condition {
+			count++
+		}
+	}
+
+	return count, nil
+}
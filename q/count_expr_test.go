@@ -0,0 +1,31 @@
+package q_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39/q"
+	"github.com/elliotchance/tf"
+)
+
+func TestCountExpr_Evaluate(t *testing.T) {
+	Evaluate := tf.NamedFunction(t, "CountExpr_Evaluate", (*q.CountExpr).Evaluate)
+	engine := &q.Engine{}
+
+	argGreaterThan := []*q.Statement{{Expressions: []q.Expression{&q.BinaryExpr{
+		Left:     &q.AccessorExpr{Query: ".Property"},
+		Operator: ">",
+		Right:    &q.ConstantExpr{Value: "10"},
+	}}}}
+	argTwo := append(argGreaterThan, argGreaterThan...)
+
+	structs := []MyStruct{{Property: 5}, {Property: 13}, {Property: 20}}
+
+	Evaluate(&q.CountExpr{}, engine, nil, nil).Returns(0, nil)
+	Evaluate(&q.CountExpr{}, engine, []int{}, nil).Returns(0, nil)
+	Evaluate(&q.CountExpr{}, engine, "foo", nil).Returns(1, nil)
+	Evaluate(&q.CountExpr{}, engine, structs, nil).Returns(3, nil)
+	Evaluate(&q.CountExpr{}, engine, structs, argGreaterThan).Returns(2, nil)
+	Evaluate(&q.CountExpr{}, engine, structs, argTwo).
+		Returns(nil, errors.New("function Count() must take zero or one argument"))
+}
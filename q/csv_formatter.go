@@ -1,15 +1,10 @@
 package q
 
 import (
-	"errors"
 	"fmt"
 	"io"
 	"reflect"
-	"sort"
 	"strings"
-	"unicode"
-
-	"github.com/elliotchance/gedcom/v39"
 )
 
 type CSVFormatter struct {
@@ -17,28 +12,45 @@ type CSVFormatter struct {
 }
 
 func (f *CSVFormatter) Write(result interface{}) error {
-	columns, err := f.Header(result)
+	columns, rows, err := tabular(result)
 	if err != nil {
 		return err
 	}
 
 	f.writeLine(columns)
 
-	v := reflect.ValueOf(result)
-	if v.Kind() == reflect.Slice {
-		for i := 0; i < v.Len(); i++ {
-			line := []string{}
-			value := f.prepareLine(v.Index(i).Interface())
+	for _, row := range rows {
+		line := []string{}
+		for _, name := range columns {
+			line = append(line, csvValue(row[name]))
+		}
+
+		f.writeLine(line)
+	}
+
+	return nil
+}
+
+// csvValue returns the text for a single cell. The elements of a slice (such
+// as the Values of a Group) are separated by commas. Missing values (such as
+// a column that only exists in some rows) are empty.
+func csvValue(value interface{}) string {
+	if isNil(value) {
+		return ""
+	}
 
-			for _, name := range columns {
-				line = append(line, fmt.Sprintf("%v", value[name]))
-			}
+	v := reflect.ValueOf(value)
 
-			f.writeLine(line)
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+		values := []string{}
+		for i := 0; i < v.Len(); i++ {
+			values = append(values, fmt.Sprintf("%v", v.Index(i).Interface()))
 		}
+
+		return strings.Join(values, ", ")
 	}
 
-	return nil
+	return fmt.Sprintf("%v", value)
 }
 
 func (f *CSVFormatter) writeLine(fields []string) {
@@ -65,66 +77,9 @@ func (f *CSVFormatter) writeValue(s string) {
 	}
 }
 
-func (f *CSVFormatter) prepareLine(line interface{}) map[string]interface{} {
-	if m, ok := line.(gedcom.ObjectMapper); ok {
-		return m.ObjectMap()
-	}
-
-	l := reflect.ValueOf(line)
-
-	if l.Kind() == reflect.Ptr {
-		l = l.Elem()
-	}
-
-	if l.Kind() == reflect.Map {
-		m := map[string]interface{}{}
-		for _, name := range l.MapKeys() {
-			m[name.Interface().(string)] = l.MapIndex(name).Interface()
-		}
-
-		return m
-	}
-
-	if l.Kind() == reflect.Struct {
-		t := l.Type()
-		m := map[string]interface{}{}
-
-		for i := 0; i < t.NumField(); i++ {
-			name := t.Field(i).Name
-
-			// Ignore unexported
-			if !unicode.IsUpper(rune(name[0])) {
-				continue
-			}
-
-			m[name] = l.FieldByName(name).Interface()
-		}
-
-		return m
-	}
-
-	return nil
-}
-
+// Header returns the names of the columns.
 func (f *CSVFormatter) Header(result interface{}) ([]string, error) {
-	v := reflect.ValueOf(result)
-
-	if v.Kind() != reflect.Slice {
-		return nil, errors.New("not a slice")
-	}
-
-	if v.Len() == 0 {
-		return nil, nil
-	}
-
-	line := f.prepareLine(v.Index(0).Interface())
-
-	s := []string{}
-	for column := range line {
-		s = append(s, column)
-	}
-
-	sort.Strings(s)
+	columns, _, err := tabular(result)
 
-	return s, nil
+	return columns, err
 }
@@ -13,6 +13,23 @@
 // value of the property or the result of invoking the method. The above example
 // would return a slice ([]*IndividualNode).
 //
+// Methods that take arguments are called with the arguments in brackets, such
+// as ".Ancestors(3)". Each argument is evaluated with the same input as the
+// accessor and converted to the type of the argument of the method:
+//
+//   .Ancestors(3)                  Constants become numbers, booleans or strings.
+//   .AgeAt(.Deaths)                A slice with one value is used as that value.
+//   .FamilyWithSpouse("P2")        A pointer is used for a node in the document.
+//   .Similarity("P2", {MaxYears: 5})
+//                                  An object is used for a struct. The keys are
+//                                  the names of fields (not case-sensitive).
+//
+// If an argument cannot be converted to the type of the method argument an
+// error is returned.
+//
+// Accessors can be chained together. ".Name.Format("%f %L")" is the same as
+// "(.Name | .Format("%f %L"))".
+//
 // The next expression, ".Name" receives that slice. Since it is a slice the
 // ".Name" accessor is performed on each of the individual slice members,
 // creating a new slice with the results. In this case IndividualNode has a
@@ -74,10 +91,30 @@
 // Some functions are provided as part of the gedcomq language that exist
 // outside of the gedcom package:
 //
+//   Avg
+//   Avg(expr)
+//
+// Avg returns the average (mean) of the numbers in a slice. If an expression
+// is provided it is evaluated for each element and the results are used
+// instead. Values that are not numbers are ignored. The result is nil if there
+// are no numbers.
+//
+//   .Individuals | Avg(.Age | .Years)
+//
 //   Combine(Slices...)
 //
 // Combine will combine multiple slices of the same type into a single slice.
 //
+//   Count
+//   Count(condition)
+//
+// Count returns the number of elements in a slice. If a condition is provided
+// only the elements where the condition is true are counted:
+//
+//   .Individuals | Count(.IsLiving)
+//
+// Unlike Length, a nil input has a count of 0.
+//
 //   First(number)
 //
 // First returns up to the number of elements in a slice.
@@ -90,6 +127,18 @@
 // There must be exactly one argument and it must be 0 or greater. If the number
 // is greater than the length of the slice all elements are returned.
 //
+//   GroupBy(expr)
+//
+// GroupBy splits a slice into groups where the expression has the same value
+// for each element. Each group has a Key (the value of the expression) and
+// Values (the elements in that group). Values are equal in the same way as
+// the "=" operator, except that pointers, such as individuals, are only equal
+// to themselves. The groups are in the order that each key was first found.
+//
+// Count how many people have each surname:
+//
+//   .Individuals | GroupBy(.Name | .Surname) | { surname: .Key, people: .Values | Count }
+//
 //   Last(number)
 //
 // Last returns up to the number of elements in a slice.
@@ -109,10 +158,25 @@
 // This value will be 0 or more. If the input is not a slice then 1 will always
 // be returned.
 //
+//   Max
+//   Max(expr)
+//
+// Max returns the largest value in a slice. If an expression is provided it is
+// evaluated for each element and the largest result is returned. Values are
+// compared in the same way as the comparison operators (see Operators). Nil
+// values are ignored. The result is nil if there are no values.
+//
+//   .Individuals | Max(.Birth | .Years)
+//
 //   MergeDocumentsAndIndividuals(doc1, doc2)
 //
 // Merges two documents while also merging similar individuals.
 //
+//   Min
+//   Min(expr)
+//
+// Min returns the smallest value in a slice. It works in the same way as Max.
+//
 //   NodesWithTagPaths(Tags...)
 //
 // NodesWithTagPath returns all of the nodes that have an exact tag path. The
@@ -142,6 +206,46 @@
 //
 //   .Individuals | Only(.Age > 100)
 //
+//   RelationshipTo(individual)
+//
+// RelationshipTo describes how each individual is related to another
+// individual, like "great-grandfather" or "second cousin once removed". The
+// argument is the pointer of the other individual, or a variable that contains
+// the individual:
+//
+//   .Individuals | RelationshipTo("P1") | .String
+//
+// The result is nil (or an empty string with .String) for individuals that
+// are not related. See gedcom.IndividualNode.RelationshipTo.
+//
+//   Sort(expr)
+//   SortDesc(expr)
+//
+// Sort returns a new slice that is ordered by the value of the expression for
+// each element. SortDesc is the same but in the reverse order. Values are
+// compared in the same way as the comparison operators (see Operators), so
+// numbers are sorted numerically and strings are sorted without case. Nil
+// values are sorted before all other values. Elements with the same value keep
+// their original order.
+//
+// The 10 people that were born first:
+//
+//   .Individuals | Only(.Birth | .Years > 0) | Sort(.Birth | .Years) | First(10)
+//
+//   Sum
+//   Sum(expr)
+//
+// Sum returns the total of the numbers in a slice. If an expression is
+// provided it is evaluated for each element and the results are used instead.
+// Values that are not numbers are ignored.
+//
+//   Unique
+//
+// Unique returns a new slice without the elements that have already appeared.
+// Values are equal in the same way as GroupBy.
+//
+//   .Individuals | .Name | .Surname | Unique
+//
 // The Question Mark
 //
 // "?" is a special function that can be used to show all of the possible next
@@ -236,7 +340,7 @@
 // Operators
 //
 // gedcomq supports several binary operators that can be used for comparison of
-// values. All operators will return a boolean (true/false) result:
+// values. All comparison operators will return a boolean (true/false) result:
 //
 //   =  (equal)
 //   != (not equal)
@@ -249,6 +353,9 @@
 // that "John Smith" is considered to be equal to "  john SMITH ", but not equal
 // to "John  Smith".
 //
+// A node is also equal to its raw value. So ".Sex = "F"" is true for the same
+// individuals as ".Sex = "Female"".
+//
 // Not equal works exactly opposite.
 //
 //   >  (greater than)
@@ -265,6 +372,80 @@
 // One string is greater than another string by comparing each of the
 // characters. So "Jon" is greater than "John" because "n" is greater than "h".
 //
+// Dates and ages that are compared to a number (or another date or age) use
+// their number of years. So ".Age > 80" is true for someone that is 81 years
+// old.
+//
+// Comparing nil (such as the years of a missing date) with ">", ">=", "<" or
+// "<=" is always false.
+//
+//   contains
+//   startsWith
+//   matches
+//
+// "contains" and "startsWith" compare strings without case, in the same way as
+// "=". If the left side is a slice then "contains" is true when any element is
+// equal to the right side.
+//
+// "matches" is true if the left side matches the regular expression on the
+// right side. Regular expressions are case-sensitive unless they start with
+// "(?i)". See https://golang.org/pkg/regexp/syntax/.
+//
+//   .Individuals | Only((.Name | .String) matches "^John .*son$")
+//
+//   and
+//   or
+//   not
+//
+// The logical operators return a boolean. Values that are not a boolean are
+// false if they are nil, zero, an empty string or an empty slice. All other
+// values are true.
+//
+//   +  (add)
+//   -  (subtract)
+//   *  (multiply)
+//   /  (divide)
+//
+// The arithmetic operators work with numbers, or strings that represent
+// numbers. Dates and ages use their number of years. If either side is not a
+// number the result is nil. A date that is missing or cannot be understood is
+// not a number. The only exception is "+" which will join the values as
+// strings instead. Dividing by zero is also nil.
+//
+// A "-" may also be used before a single value to make it negative.
+//
+// The precedence of the operators, from highest to lowest, is:
+//
+//   - (negative)
+//   * /
+//   + -
+//   = != > >= < <= contains startsWith matches
+//   not
+//   and
+//   or
+//
+// Operators of the same precedence are evaluated from left to right. Brackets
+// can be used to change the order:
+//
+//   .Individuals | Only(.Age > 80 and (.IsLiving or .Age < 100))
+//
+// Dates can be subtracted to find the number of years between them. The
+// lifespan will be nil for individuals that do not have both dates:
+//
+//   .Individuals | { name: .Name | .String, lifespan: .Death - .Birth }
+//
+// The years of a missing date are also nil, so this is the same:
+//
+//   .Individuals | { name: .Name | .String, lifespan: .Death.Years - .Birth.Years }
+//
+// The pipe (|) separates expressions, so it is always evaluated last. Brackets
+// are also needed to use the result of a pipe with an operator:
+//
+//   .Individuals | Only((.Families | Length) > 1)
+//
+// The keywords "and", "or", "not", "contains", "startsWith" and "matches"
+// cannot be used as variable names.
+//
 // Creating Objects
 //
 // Custom objects can be constructed on one more items. For example:
@@ -310,6 +491,22 @@
 // This can be controlled with the "-format" option with gedcomq, or by
 // instantiating one of the formatter instances in your own code.
 //
+// The CSV formatter writes each element of a slice as a row, and each key (or
+// property) as a column. A single value, such as the result of Count, is
+// written as one row with a "Value" column. Slices inside each row (such as
+// the Values of GroupBy) are written as one cell separated by commas.
+//
+// Objects inside of each row are flattened into columns that are named with
+// the path to the value. For example:
+//
+//   .Individuals | { name: .Name | .String, born: { date: .Birth | .String, year: .Birth | .Years } }
+//
+// Has the columns "born.date", "born.year" and "name".
+//
+// The TSV, Markdown ("markdown"), XLSX and table formatters write the same
+// rows and columns as the CSV formatter. The YAML formatter has the same
+// structure as the JSON formatter.
+//
 // Examples
 //
 // Count all individuals in a document:
@@ -377,6 +574,25 @@
 //     },
 //   ]
 //
+// Show the Ahnentafel numbers for the parents of an individual:
+//
+//   .Individuals | Only(.Pointer = "P3") | .Ancestors(1) | { number: .Ahnentafel, name: .Individual | .Name | .String }
+//
+// result:
+//
+//   [
+//     [
+//       {
+//         "name": "John Smith",
+//         "number": 2
+//       },
+//       {
+//         "name": "Mary Jones",
+//         "number": 3
+//       }
+//     ]
+//   ]
+//
 // Merge two GEDCOM files (full command):
 //
 //   gedcomq -gedcom file1.ged -gedcom file2.ged -format gedcom \
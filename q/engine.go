@@ -54,3 +54,17 @@ func (e *Engine) StatementByVariableName(name string) (*Statement, error) {
 
 	return nil, fmt.Errorf("no such variable %s", name)
 }
+
+// setVariable adds a variable statement, or replaces the statement of an
+// existing variable with the same name.
+func (e *Engine) setVariable(statement *Statement) {
+	for i, existing := range e.Statements {
+		if existing.VariableName == statement.VariableName {
+			e.Statements[i] = statement
+
+			return
+		}
+	}
+
+	e.Statements = append(e.Statements, statement)
+}
@@ -174,4 +174,66 @@ func TestEngine_Start(t *testing.T) {
 			{"name": "Dina Wyche"},
 		}, nil)
 	}
+
+	engine, err = parser.ParseString(".Individuals | Sort(.Name | .String) | .Name | .String")
+	if assert.NoError(t, err) {
+		Start(engine, documents).Returns([]string{
+			"Dina Wyche",
+			"Elliot Chance",
+		}, nil)
+	}
+
+	engine, err = parser.ParseString(".Individuals | GroupBy(.Name | .Surname) | { surname: .Key, count: .Values | Count }")
+	if assert.NoError(t, err) {
+		Start(engine, documents).Returns([]map[string]interface{}{
+			{"surname": "Chance", "count": 1},
+			{"surname": "Wyche", "count": 1},
+		}, nil)
+	}
+
+	engine, err = parser.ParseString(".Individuals | .Name | .Surname | Max")
+	if assert.NoError(t, err) {
+		Start(engine, documents).Returns("Wyche", nil)
+	}
+
+	engine, err = parser.ParseString(`.Individuals | Only((.Name | .Surname) startsWith "c" or .Pointer = "P3") | .Name | .String`)
+	if assert.NoError(t, err) {
+		Start(engine, documents).Returns([]string{"Elliot Chance"}, nil)
+	}
+
+	engine, err = parser.ParseString(`(.Individuals | Count) * 2 + 1`)
+	if assert.NoError(t, err) {
+		Start(engine, documents).Returns(5.0, nil)
+	}
+
+	engine, err = parser.ParseString(`.Individuals | .Death - .Birth`)
+	if assert.NoError(t, err) {
+		Start(engine, documents).Returns([]interface{}{nil, nil}, nil)
+	}
+
+	living := gedcom.NewDocument()
+	living.AddIndividual("P1",
+		gedcom.NewNameNode("Elliot /Chance/"),
+		gedcom.NewNode(gedcom.TagSex, "M", ""),
+		gedcom.NewBirthNode("", gedcom.NewDateNode("1843")),
+	)
+	living.AddIndividual("P2",
+		gedcom.NewNameNode("Dina /Wyche/"),
+		gedcom.NewNode(gedcom.TagSex, "F", ""),
+		gedcom.NewBirthNode("", gedcom.NewDateNode("1800")),
+		gedcom.NewDeathNode("", gedcom.NewDateNode("1890")),
+	)
+
+	engine, err = parser.ParseString(`.Individuals | Only(.Age > 80 and .Sex = "F") | .Name | .String`)
+	if assert.NoError(t, err) {
+		Start(engine, []*gedcom.Document{living}).Returns([]string{"Dina Wyche"}, nil)
+	}
+
+	engine, err = parser.ParseString(`.Individuals | {span: .Death.Years - .Birth.Years}`)
+	if assert.NoError(t, err) {
+		Start(engine, []*gedcom.Document{living}).Returns([]map[string]interface{}{
+			{"span": nil},
+			{"span": 90.0},
+		}, nil)
+	}
 }
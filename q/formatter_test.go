@@ -1,13 +1,17 @@
 package q_test
 
 import (
+	"archive/zip"
 	"bytes"
+	"encoding/xml"
 	"errors"
+	"io/ioutil"
 	"testing"
 
 	"github.com/elliotchance/gedcom/v39"
 	"github.com/elliotchance/gedcom/v39/q"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var formatterTests = []struct {
@@ -27,6 +31,15 @@ var formatterTests = []struct {
 	// gedcom
 	asGEDCOM    []byte
 	gedcomError error
+
+	// yaml
+	asYAML []byte
+
+	// tsv
+	asTSV []byte
+
+	// markdown
+	asMarkdown []byte
 }{
 	{
 		result:       nil,
@@ -35,6 +48,7 @@ var formatterTests = []struct {
 		asCSV:        []byte(nil),
 		csvHeader:    nil,
 		csvError:     errors.New("not a slice"),
+		asYAML:       []byte("null\n"),
 	},
 	{
 		result: []*gedcom.NameNode{
@@ -61,6 +75,17 @@ NAME,Dina /Wyche/
 		csvHeader: []string{"Tag", "Value"},
 		csvError:  nil,
 		asGEDCOM:  []byte("0 NAME Elliot /Chance/\n0 NAME Dina /Wyche/\n"),
+		asYAML: []byte(`- Tag: NAME
+  Value: Elliot /Chance/
+- Tag: NAME
+  Value: Dina /Wyche/
+`),
+		asTSV: []byte("Tag\tValue\nNAME\tElliot /Chance/\nNAME\tDina /Wyche/\n"),
+		asMarkdown: []byte(`| Tag | Value |
+| --- | --- |
+| NAME | Elliot /Chance/ |
+| NAME | Dina /Wyche/ |
+`),
 	},
 	{
 		result: gedcom.NewDocument().AddIndividual("P1",
@@ -86,6 +111,14 @@ NAME,Dina /Wyche/
 `),
 		csvError: errors.New("not a slice"),
 		asGEDCOM: []byte("0 @P1@ INDI\n1 NAME Elliot /Chance/\n1 NAME Dina /Wyche/\n"),
+		asYAML: []byte(`Nodes:
+  - Tag: NAME
+    Value: Elliot /Chance/
+  - Tag: NAME
+    Value: Dina /Wyche/
+Pointer: P1
+Tag: INDI
+`),
 	},
 	{
 		result: []map[string]interface{}{
@@ -112,6 +145,127 @@ NAME,Dina /Wyche/
 		csvHeader:   []string{"baz", "foo"},
 		csvError:    nil,
 		gedcomError: errors.New("map[string]interface {} does not implement gedcom.GEDCOMStringer"),
+		asYAML: []byte(`- baz: 123
+  foo: "bar,"
+- baz: "q\"ux"
+  foo: 4.56
+`),
+		asTSV: []byte("baz\tfoo\n123\tbar,\nq\"ux\t4.56\n"),
+		asMarkdown: []byte(`| baz | foo |
+| --- | --- |
+| 123 | bar, |
+| q"ux | 4.56 |
+`),
+	},
+	{
+		result:       3,
+		asJSON:       []byte("3\n"),
+		asPrettyJSON: []byte("3\n"),
+		asCSV:        []byte("Value\n3\n"),
+		csvHeader:    []string{"Value"},
+		gedcomError:  errors.New("int does not implement gedcom.GEDCOMStringer"),
+		asYAML:       []byte("3\n"),
+		asTSV:        []byte("Value\n3\n"),
+		asMarkdown:   []byte("| Value |\n| --- |\n| 3 |\n"),
+	},
+	{
+		result: []*q.Group{
+			{Key: "Chance", Values: []*gedcom.NameNode{
+				gedcom.NewNameNode("Elliot /Chance/"),
+				gedcom.NewNameNode("Bob /Chance/"),
+			}},
+		},
+		asJSON: []byte(`[{"Key":"Chance","Values":[{"Tag":"NAME","Value":"Elliot /Chance/"},{"Tag":"NAME","Value":"Bob /Chance/"}]}]
+`),
+		asPrettyJSON: []byte(`[
+  {
+    "Key": "Chance",
+    "Values": [
+      {
+        "Tag": "NAME",
+        "Value": "Elliot /Chance/"
+      },
+      {
+        "Tag": "NAME",
+        "Value": "Bob /Chance/"
+      }
+    ]
+  }
+]
+`),
+		asCSV: []byte(`Key,Values
+Chance,"Elliot Chance, Bob Chance"
+`),
+		csvHeader: []string{"Key", "Values"},
+		asGEDCOM:  []byte("0 NAME Elliot /Chance/\n0 NAME Bob /Chance/\n"),
+		asYAML: []byte(`- Key: Chance
+  Values:
+    - Tag: NAME
+      Value: Elliot /Chance/
+    - Tag: NAME
+      Value: Bob /Chance/
+`),
+		asTSV: []byte("Key\tValues\nChance\tElliot Chance, Bob Chance\n"),
+		asMarkdown: []byte(`| Key | Values |
+| --- | --- |
+| Chance | Elliot Chance, Bob Chance |
+`),
+	},
+	{
+		// Nested objects are flattened into columns.
+		result: []map[string]interface{}{
+			{
+				"name": "Elliot\tChance",
+				"birth": map[string]interface{}{
+					"date":  "1843",
+					"place": "Sydney | Australia",
+				},
+			},
+			{
+				"name": "Dina",
+				"birth": map[string]interface{}{
+					"date":  nil,
+					"place": map[string]interface{}{},
+				},
+			},
+		},
+		asJSON: []byte(`[{"birth":{"date":"1843","place":"Sydney | Australia"},"name":"Elliot\tChance"},{"birth":{"date":null,"place":{}},"name":"Dina"}]
+`),
+		asPrettyJSON: []byte(`[
+  {
+    "birth": {
+      "date": "1843",
+      "place": "Sydney | Australia"
+    },
+    "name": "Elliot\tChance"
+  },
+  {
+    "birth": {
+      "date": null,
+      "place": {}
+    },
+    "name": "Dina"
+  }
+]
+`),
+		asCSV:       []byte("birth.date,birth.place,name\n1843,Sydney | Australia,Elliot\tChance\n,,Dina\n"),
+		csvHeader:   []string{"birth.date", "birth.place", "name"},
+		gedcomError: errors.New("map[string]interface {} does not implement gedcom.GEDCOMStringer"),
+		asYAML: []byte(`- birth:
+    date: "1843"
+    place: "Sydney | Australia"
+  name: "Elliot\tChance"
+- birth:
+    date: null
+    place: {}
+  name: Dina
+`),
+		asTSV: []byte("birth.date\tbirth.place\tname\n1843\tSydney | Australia\tElliot Chance\n\t\tDina\n"),
+		asMarkdown: []byte(`| birth.date | birth.place | name |
+| --- | --- | --- |
+| 1843 | Sydney \| Australia | Elliot	Chance |
+|  |  | Dina |
+`),
 	},
 }
 
@@ -191,3 +345,205 @@ func TestGEDCOMFormatter_Write(t *testing.T) {
 		})
 	}
 }
+
+func TestYAMLFormatter_Write(t *testing.T) {
+	for _, test := range formatterTests {
+		t.Run("", func(t *testing.T) {
+			buffer := bytes.Buffer{}
+			formatter := &q.YAMLFormatter{&buffer}
+			err := formatter.Write(test.result)
+			assert.NoError(t, err)
+			assert.Equal(t, string(test.asYAML), buffer.String())
+		})
+	}
+}
+
+func TestTSVFormatter_Write(t *testing.T) {
+	for _, test := range formatterTests {
+		t.Run("", func(t *testing.T) {
+			buffer := bytes.Buffer{}
+			formatter := &q.TSVFormatter{&buffer}
+			err := formatter.Write(test.result)
+
+			if test.csvError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err, test.csvError)
+			}
+
+			assert.Equal(t, string(test.asTSV), buffer.String())
+		})
+	}
+}
+
+func TestMarkdownFormatter_Write(t *testing.T) {
+	for _, test := range formatterTests {
+		t.Run("", func(t *testing.T) {
+			buffer := bytes.Buffer{}
+			formatter := &q.MarkdownFormatter{&buffer}
+			err := formatter.Write(test.result)
+
+			if test.csvError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err, test.csvError)
+			}
+
+			assert.Equal(t, string(test.asMarkdown), buffer.String())
+		})
+	}
+}
+
+func TestGEDCOMXFormatter_Write(t *testing.T) {
+	document := gedcom.NewDocument()
+	document.AddIndividual("P1", gedcom.NewNameNode("Elliot /Chance/"))
+
+	for _, test := range []struct {
+		result   interface{}
+		expected string
+		err      error
+	}{
+		{
+			result:   nil,
+			expected: "{}\n",
+		},
+		{
+			result: document,
+			expected: `{
+  "persons": [
+    {
+      "id": "P1",
+      "names": [
+        {
+          "nameForms": [
+            {
+              "fullText": "Elliot Chance",
+              "parts": [
+                {
+                  "type": "http://gedcomx.org/Given",
+                  "value": "Elliot"
+                },
+                {
+                  "type": "http://gedcomx.org/Surname",
+                  "value": "Chance"
+                }
+              ]
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}
+`,
+		},
+		{
+			result:   document.Individuals(),
+			expected: "{\n  \"persons\": [\n    {\n      \"id\": \"P1\",\n      \"names\": [\n        {\n          \"nameForms\": [\n            {\n              \"fullText\": \"Elliot Chance\",\n              \"parts\": [\n                {\n                  \"type\": \"http://gedcomx.org/Given\",\n                  \"value\": \"Elliot\"\n                },\n                {\n                  \"type\": \"http://gedcomx.org/Surname\",\n                  \"value\": \"Chance\"\n                }\n              ]\n            }\n          ]\n        }\n      ]\n    }\n  ]\n}\n",
+		},
+		{
+			result: "foo",
+			err:    errors.New("string cannot be converted to GEDCOM X"),
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			buffer := bytes.Buffer{}
+			formatter := &q.GEDCOMXFormatter{Writer: &buffer}
+			err := formatter.Write(test.result)
+
+			assert.Equal(t, test.err, err)
+			assert.Equal(t, test.expected, buffer.String())
+		})
+	}
+}
+
+func TestTableFormatter_Write(t *testing.T) {
+	for _, test := range []struct {
+		result   interface{}
+		expected string
+		err      error
+	}{
+		{
+			result: nil,
+			err:    errors.New("not a slice"),
+		},
+		{
+			result:   []int{},
+			expected: "",
+		},
+		{
+			result:   3,
+			expected: "Value\n-----\n3\n",
+		},
+		{
+			result: []map[string]interface{}{
+				{"Name": "Elliot Chance", "Age": 35},
+				{"Name": "Jane Doe", "Age": 101},
+			},
+			expected: "Age  Name\n---  -------------\n35   Elliot Chance\n101  Jane Doe\n",
+		},
+		{
+			result: []map[string]interface{}{
+				{"Note": "foo\nbar", "Tags": []string{"a", "b"}},
+			},
+			expected: "Note     Tags\n-------  ----\nfoo bar  a, b\n",
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			buffer := bytes.Buffer{}
+			formatter := &q.TableFormatter{Writer: &buffer}
+			err := formatter.Write(test.result)
+
+			assert.Equal(t, test.err, err)
+			assert.Equal(t, test.expected, buffer.String())
+		})
+	}
+}
+
+func TestXLSXFormatter_Write(t *testing.T) {
+	buffer := bytes.Buffer{}
+	formatter := &q.XLSXFormatter{Writer: &buffer}
+	err := formatter.Write([]map[string]interface{}{
+		{"name": "Elliot <Chance>", "age": 35, "living": true},
+		{"name": "Dina", "birth": map[string]interface{}{"date": "1843"}},
+	})
+	require.NoError(t, err)
+
+	reader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()),
+		int64(buffer.Len()))
+	require.NoError(t, err)
+
+	files := map[string]string{}
+	for _, file := range reader.File {
+		r, err := file.Open()
+		require.NoError(t, err)
+
+		data, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+
+		files[file.Name] = string(data)
+	}
+
+	assert.Contains(t, files, "[Content_Types].xml")
+	assert.Contains(t, files, "xl/workbook.xml")
+	assert.Equal(t, xml.Header+`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`+
+		`<row r="1">`+
+		`<c r="A1" t="inlineStr"><is><t xml:space="preserve">age</t></is></c>`+
+		`<c r="B1" t="inlineStr"><is><t xml:space="preserve">birth.date</t></is></c>`+
+		`<c r="C1" t="inlineStr"><is><t xml:space="preserve">living</t></is></c>`+
+		`<c r="D1" t="inlineStr"><is><t xml:space="preserve">name</t></is></c>`+
+		`</row>`+
+		`<row r="2">`+
+		`<c r="A2"><v>35</v></c>`+
+		`<c r="C2" t="b"><v>1</v></c>`+
+		`<c r="D2" t="inlineStr"><is><t xml:space="preserve">Elliot &lt;Chance&gt;</t></is></c>`+
+		`</row>`+
+		`<row r="3">`+
+		`<c r="B3" t="inlineStr"><is><t xml:space="preserve">1843</t></is></c>`+
+		`<c r="D3" t="inlineStr"><is><t xml:space="preserve">Dina</t></is></c>`+
+		`</row>`+
+		`</sheetData></worksheet>`, files["xl/worksheets/sheet1.xml"])
+
+	err = formatter.Write(nil)
+	assert.EqualError(t, err, "not a slice")
+}
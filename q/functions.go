@@ -5,11 +5,21 @@ package q
 // See "Functions" in the package documentation for usage and examples.
 var Functions = map[string]Expression{
 	"?":                            &QuestionMarkExpr{},
+	"Avg":                          &AvgExpr{},
 	"Combine":                      &CombineExpr{},
+	"Count":                        &CountExpr{},
 	"First":                        &FirstExpr{},
+	"GroupBy":                      &GroupByExpr{},
 	"Last":                         &LastExpr{},
 	"Length":                       &LengthExpr{},
+	"Max":                          &MaxExpr{},
 	"MergeDocumentsAndIndividuals": &MergeDocumentsAndIndividualsExpr{},
+	"Min":                          &MinExpr{},
 	"NodesWithTagPath":             &NodesWithTagPathExpr{},
 	"Only":                         &OnlyExpr{},
+	"RelationshipTo":               &RelationshipToExpr{},
+	"Sort":                         &SortExpr{},
+	"SortDesc":                     &SortExpr{Descending: true},
+	"Sum":                          &SumExpr{},
+	"Unique":                       &UniqueExpr{},
 }
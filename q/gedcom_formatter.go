@@ -14,7 +14,7 @@ type GEDCOMFormatter struct {
 
 func (f *GEDCOMFormatter) Write(result interface{}) error {
 	// Nil should be treated as a blank document.
-	if gedcom.IsNil(result) {
+	if isNil(result) {
 		return nil
 	}
 
@@ -0,0 +1,69 @@
+package q
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/gedcomx"
+)
+
+// GEDCOMXFormatter writes the result as GEDCOM X JSON. The result may be a
+// document, or any nodes (or slices of nodes) such as the result of
+// ".Individuals".
+//
+// Only the individuals, families and sources of the result are written. NOTE
+// records are only included when they are also part of the result.
+type GEDCOMXFormatter struct {
+	Writer io.Writer
+}
+
+func (f *GEDCOMXFormatter) Write(result interface{}) error {
+	nodes, err := gedcomxNodes(result)
+	if err != nil {
+		return err
+	}
+
+	document := gedcom.NewDocumentWithNodes(nodes)
+
+	return gedcomx.NewEncoder(f.Writer, document).Encode()
+}
+
+func gedcomxNodes(result interface{}) (gedcom.Nodes, error) {
+	switch r := result.(type) {
+	case nil:
+		// Nil should be treated as a blank document.
+		return nil, nil
+
+	case *gedcom.Document:
+		return r.Nodes(), nil
+
+	case *Group:
+		return gedcomxNodes(r.Values)
+
+	case gedcom.Node:
+		if gedcom.IsNil(r) {
+			return nil, nil
+		}
+
+		return gedcom.Nodes{r}, nil
+	}
+
+	t := reflect.ValueOf(result)
+	if t.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("%s cannot be converted to GEDCOM X", t.Type())
+	}
+
+	nodes := gedcom.Nodes{}
+	for i := 0; i < t.Len(); i++ {
+		n, err := gedcomxNodes(t.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, n...)
+	}
+
+	return nodes, nil
+}
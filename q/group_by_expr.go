@@ -0,0 +1,83 @@
+package q
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/elliotchance/gedcom/v39"
+)
+
+// Group is created by the GroupBy function.
+type Group struct {
+	// Key is the value that is shared by all of the Values. If the values were
+	// not exactly the same (such as "Smith" and "SMITH") then Key is the first
+	// value.
+	Key interface{}
+
+	// Values will be a slice of the same type as the input to GroupBy.
+	Values interface{}
+}
+
+// GEDCOMString returns the GEDCOM of each of the values. This allows the
+// result of GroupBy to be used with the GEDCOMFormatter.
+func (group *Group) GEDCOMString(indent int) string {
+	s := ""
+
+	values := reflect.ValueOf(group.Values)
+	for i := 0; i < values.Len(); i++ {
+		if x, ok := values.Index(i).Interface().(gedcom.GEDCOMStringer); ok {
+			s += x.GEDCOMString(indent)
+		}
+	}
+
+	return s
+}
+
+// GroupByExpr is a function. See Evaluate.
+type GroupByExpr struct{}
+
+// Evaluate returns a slice of groups (see Group) where the argument has the
+// same value for each element. Values are equal in the same way as the "="
+// operator. However, pointers (such as individuals) are only equal to
+// themselves.
+//
+// The groups are in the order that each key was first found.
+//
+// If the input value is not a slice then it is converted into a slice of one
+// element before evaluating. If the input is nil the result will also be nil.
+func (e *GroupByExpr) Evaluate(engine *Engine, input interface{}, args []*Statement) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, errors.New("function GroupBy() must take a single argument")
+	}
+
+	in, ok := valueAsSlice(input)
+	if !ok {
+		return nil, nil
+	}
+
+	keys, err := evaluateEach(engine, in, args, "GroupBy")
+	if err != nil {
+		return nil, err
+	}
+
+	groups := []*Group{}
+	values := []reflect.Value{}
+	index := map[interface{}]int{}
+
+	for i, key := range keys {
+		k := valueKey(key)
+		if _, ok := index[k]; !ok {
+			index[k] = len(groups)
+			groups = append(groups, &Group{Key: key})
+			values = append(values, reflect.MakeSlice(in.Type(), 0, 0))
+		}
+
+		values[index[k]] = reflect.Append(values[index[k]], in.Index(i))
+	}
+
+	for i, group := range groups {
+		group.Values = values[i].Interface()
+	}
+
+	return groups, nil
+}
@@ -0,0 +1,67 @@
+package q_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/q"
+	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupByExpr_Evaluate(t *testing.T) {
+	Evaluate := tf.NamedFunction(t, "GroupByExpr_Evaluate", (*q.GroupByExpr).Evaluate)
+	engine := &q.Engine{}
+
+	argNil := []*q.Statement{}
+	argProperty := []*q.Statement{{Expressions: []q.Expression{
+		&q.AccessorExpr{Query: ".Property"},
+	}}}
+	argSurname := []*q.Statement{{Expressions: []q.Expression{
+		&q.AccessorExpr{Query: ".Surname"},
+	}}}
+
+	Evaluate(&q.GroupByExpr{}, engine, nil, argProperty).Returns(nil, nil)
+	Evaluate(&q.GroupByExpr{}, engine, []MyStruct{}, argNil).
+		Returns(nil, errors.New("function GroupBy() must take a single argument"))
+
+	Evaluate(&q.GroupByExpr{}, engine, []MyStruct{}, argProperty).
+		Returns([]*q.Group{}, nil)
+
+	Evaluate(&q.GroupByExpr{}, engine,
+		[]MyStruct{{Property: 5}, {Property: 13}, {Property: 5}}, argProperty).
+		Returns([]*q.Group{
+			{Key: 5, Values: []MyStruct{{Property: 5}, {Property: 5}}},
+			{Key: 13, Values: []MyStruct{{Property: 13}}},
+		}, nil)
+
+	Evaluate(&q.GroupByExpr{}, engine, MyStruct{Property: 5}, argProperty).
+		Returns([]*q.Group{
+			{Key: 5, Values: []MyStruct{{Property: 5}}},
+		}, nil)
+
+	// Keys are not case sensitive.
+	n1 := gedcom.NewNameNode("Bob /Smith/")
+	n2 := gedcom.NewNameNode("John /Jones/")
+	n3 := gedcom.NewNameNode("Jane /SMITH/")
+
+	Evaluate(&q.GroupByExpr{}, engine, []*gedcom.NameNode{n1, n2, n3}, argSurname).
+		Returns([]*q.Group{
+			{Key: "Smith", Values: []*gedcom.NameNode{n1, n3}},
+			{Key: "Jones", Values: []*gedcom.NameNode{n2}},
+		}, nil)
+}
+
+func TestGroup_GEDCOMString(t *testing.T) {
+	group := &q.Group{
+		Key: "Smith",
+		Values: []*gedcom.NameNode{
+			gedcom.NewNameNode("Bob /Smith/"),
+			gedcom.NewNameNode("Jane /Smith/"),
+		},
+	}
+
+	assert.Equal(t, "1 NAME Bob /Smith/\n1 NAME Jane /Smith/\n",
+		group.GEDCOMString(1))
+}
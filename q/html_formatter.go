@@ -4,7 +4,6 @@ import (
 	"io"
 	"reflect"
 
-	"github.com/elliotchance/gedcom/v39"
 	"github.com/elliotchance/gedcom/v39/html/core"
 )
 
@@ -16,7 +15,7 @@ func (f *HTMLFormatter) Write(result interface{}) error {
 	pageTitle := "gedcom"
 
 	// Nil should be treated as a blank document.
-	if gedcom.IsNil(result) {
+	if isNil(result) {
 		_, err := core.NewPage(pageTitle, core.NewSpace(), "").
 			WriteHTMLTo(f.Writer)
 
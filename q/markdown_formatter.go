@@ -0,0 +1,59 @@
+package q
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MarkdownFormatter writes a GitHub-flavored Markdown table. The rows and
+// columns are the same as CSVFormatter.
+//
+// Nothing is written if there are no rows.
+type MarkdownFormatter struct {
+	Writer io.Writer
+}
+
+func (f *MarkdownFormatter) Write(result interface{}) error {
+	columns, rows, err := tabular(result)
+	if err != nil {
+		return err
+	}
+
+	if len(columns) == 0 {
+		return nil
+	}
+
+	f.writeLine(columns)
+
+	separator := []string{}
+	for range columns {
+		separator = append(separator, "---")
+	}
+
+	f.writeLine(separator)
+
+	for _, row := range rows {
+		line := []string{}
+		for _, name := range columns {
+			line = append(line, csvValue(row[name]))
+		}
+
+		f.writeLine(line)
+	}
+
+	return nil
+}
+
+// markdownReplacer escapes characters that would end the cell or the row.
+var markdownReplacer = strings.NewReplacer(
+	`\`, `\\`, "|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+func (f *MarkdownFormatter) writeLine(fields []string) {
+	values := []string{}
+	for _, field := range fields {
+		values = append(values, markdownReplacer.Replace(field))
+	}
+
+	fmt.Fprintf(f.Writer, "| %s |\n", strings.Join(values, " | "))
+}
@@ -0,0 +1,13 @@
+package q
+
+// MaxExpr is a function. See Evaluate.
+type MaxExpr struct{}
+
+// Evaluate returns the largest value in the slice. If there is an argument
+// then the value of the argument for each element is used instead.
+//
+// Values are compared in the same way as Min. Nil values are ignored. If there
+// are no values the result will be nil.
+func (e *MaxExpr) Evaluate(engine *Engine, input interface{}, args []*Statement) (interface{}, error) {
+	return evaluateExtreme(engine, input, args, "Max", 1)
+}
@@ -0,0 +1,28 @@
+package q_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39/q"
+	"github.com/elliotchance/tf"
+)
+
+func TestMaxExpr_Evaluate(t *testing.T) {
+	Evaluate := tf.NamedFunction(t, "MaxExpr_Evaluate", (*q.MaxExpr).Evaluate)
+	engine := &q.Engine{}
+
+	argProperty := []*q.Statement{{Expressions: []q.Expression{
+		&q.AccessorExpr{Query: ".Property"},
+	}}}
+
+	Evaluate(&q.MaxExpr{}, engine, nil, nil).Returns(nil, nil)
+	Evaluate(&q.MaxExpr{}, engine, []int{}, nil).Returns(nil, nil)
+	Evaluate(&q.MaxExpr{}, engine, []int{3, 10, 2}, nil).Returns(10, nil)
+	Evaluate(&q.MaxExpr{}, engine, []string{"10", "9", "11"}, nil).
+		Returns("11", nil)
+	Evaluate(&q.MaxExpr{}, engine, []string{"b", "A", "C"}, nil).
+		Returns("C", nil)
+	Evaluate(&q.MaxExpr{}, engine,
+		[]MyStruct{{Property: 5}, {Property: 13}}, argProperty).
+		Returns(13, nil)
+}
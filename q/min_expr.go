@@ -0,0 +1,41 @@
+package q
+
+// MinExpr is a function. See Evaluate.
+type MinExpr struct{}
+
+// Evaluate returns the smallest value in the slice. If there is an argument
+// then the value of the argument for each element is used instead.
+//
+// Values are compared in the same way as the comparison operators, so numbers
+// are compared numerically and strings are compared without case. Nil values
+// are ignored. If there are no values the result will be nil.
+func (e *MinExpr) Evaluate(engine *Engine, input interface{}, args []*Statement) (interface{}, error) {
+	return evaluateExtreme(engine, input, args, "Min", -1)
+}
+
+// evaluateExtreme returns the smallest (direction is -1) or largest (direction
+// is 1) value from evaluateEach.
+func evaluateExtreme(engine *Engine, input interface{}, args []*Statement, name string, direction int) (interface{}, error) {
+	in, ok := valueAsSlice(input)
+	if !ok {
+		return nil, nil
+	}
+
+	values, err := evaluateEach(engine, in, args, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var result interface{}
+	for _, value := range values {
+		if isNil(value) {
+			continue
+		}
+
+		if result == nil || compareValues(value, result) == direction {
+			result = value
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,30 @@
+package q_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39/q"
+	"github.com/elliotchance/tf"
+)
+
+func TestMinExpr_Evaluate(t *testing.T) {
+	Evaluate := tf.NamedFunction(t, "MinExpr_Evaluate", (*q.MinExpr).Evaluate)
+	engine := &q.Engine{}
+
+	argProperty := []*q.Statement{{Expressions: []q.Expression{
+		&q.AccessorExpr{Query: ".Property"},
+	}}}
+
+	Evaluate(&q.MinExpr{}, engine, nil, nil).Returns(nil, nil)
+	Evaluate(&q.MinExpr{}, engine, []int{}, nil).Returns(nil, nil)
+	Evaluate(&q.MinExpr{}, engine, []int{3, 10, 2}, nil).Returns(2, nil)
+	Evaluate(&q.MinExpr{}, engine, []string{"10", "9", "11"}, nil).
+		Returns("9", nil)
+	Evaluate(&q.MinExpr{}, engine, []string{"b", "A", "c"}, nil).
+		Returns("A", nil)
+	Evaluate(&q.MinExpr{}, engine, []interface{}{nil, 3, nil}, nil).
+		Returns(3, nil)
+	Evaluate(&q.MinExpr{}, engine,
+		[]MyStruct{{Property: 5}, {Property: 13}}, argProperty).
+		Returns(5, nil)
+}
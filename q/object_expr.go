@@ -12,8 +12,15 @@ func (e *ObjectExpr) Evaluate(engine *Engine, input interface{}, args []*Stateme
 
 	// If it is a slice we need to Evaluate each one.
 	if in.Kind() == reflect.Slice {
-		mapType := reflect.TypeOf([]map[string]interface{}{})
-		results := reflect.MakeSlice(mapType, 0, 0)
+		resultsType := reflect.TypeOf([]map[string]interface{}{})
+
+		// Each element of nested slices (such as a slice of gedcom.Ancestors)
+		// will be a slice of objects.
+		if t := TypeOfSliceElement(input); t != nil && t.Kind() == reflect.Slice {
+			resultsType = reflect.TypeOf([]interface{}{})
+		}
+
+		results := reflect.MakeSlice(resultsType, 0, 0)
 
 		for i := 0; i < in.Len(); i++ {
 			result, err := e.Evaluate(engine, in.Index(i).Interface(), nil)
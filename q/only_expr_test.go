@@ -16,7 +16,7 @@ func TestOnlyExpr_Evaluate(t *testing.T) {
 
 	argNil := []*q.Statement{}
 	argEq := []*q.Statement{{Expressions: []q.Expression{&q.BinaryExpr{
-		Left:     &q.AccessorExpr{".Property"},
+		Left:     &q.AccessorExpr{Query: ".Property"},
 		Operator: "=",
 		Right:    &q.ConstantExpr{"52"},
 	}}}}
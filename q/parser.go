@@ -2,6 +2,7 @@ package q
 
 import (
 	"errors"
+	"fmt"
 )
 
 // Parser converts the query string into an Engine that can be evaluated.
@@ -159,44 +160,159 @@ func (p *Parser) consumeExpressions() (expressions []Expression, err error) {
 	return
 }
 
-//   Expression := Accessor | Word | QuestionMark | BinaryExpression
+//   Expression := BinaryExpression
 func (p *Parser) consumeExpression() (expression Expression, err error) {
+	return p.consumeBinaryExpression(PrecedenceOr)
+}
+
+//   BinaryExpression := UnaryExpression [ Operator BinaryExpression ]...
+//
+// Only operators that have at least minPrecedence are consumed. The right side
+// of each operator must have a higher precedence so that operators of the same
+// precedence are evaluated from left to right.
+func (p *Parser) consumeBinaryExpression(minPrecedence int) (expression Expression, err error) {
+	defer p.tokens.Rollback(p.tokens.Position, &err)
+
+	expression, err = p.consumeUnaryExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		position := p.tokens.Position
+
+		op, precedence, err := p.consumeOperator()
+		if err != nil || precedence < minPrecedence {
+			p.tokens.Position = position
+			break
+		}
+
+		right, err := p.consumeBinaryExpression(precedence + 1)
+		if err != nil {
+			p.tokens.Position = position
+			break
+		}
+
+		expression = &BinaryExpr{
+			Left:     expression,
+			Operator: op,
+			Right:    right,
+		}
+	}
+
+	return expression, nil
+}
+
+//   UnaryExpression := "not" BinaryExpression
+//                    | "-" UnaryExpression
+//                    | Value
+//
+// "not" has a lower precedence than the comparison operators so that
+// "not .A = .B" is the same as "not (.A = .B)".
+func (p *Parser) consumeUnaryExpression() (expression Expression, err error) {
+	defer p.tokens.Rollback(p.tokens.Position, &err)
+
+	if p.consumeKeyword("not") == nil {
+		expression, err = p.consumeBinaryExpression(PrecedenceComparison)
+		if err != nil {
+			return nil, err
+		}
+
+		return &UnaryExpr{Operator: "not", Expr: expression}, nil
+	}
+
+	if _, err := p.tokens.Consume(TokenMinus); err == nil {
+		expression, err = p.consumeUnaryExpression()
+		if err != nil {
+			return nil, err
+		}
+
+		return &UnaryExpr{Operator: "-", Expr: expression}, nil
+	}
+
+	return p.consumeValue()
+}
+
+//   Value := Constant | Accessor | VariableOrFunction | QuestionMark | Object
+//          | Bracket
+func (p *Parser) consumeValue() (expression Expression, err error) {
 	defer p.tokens.Rollback(p.tokens.Position, &err)
 
 	if expression, err = p.consumeConstant(); err == nil {
-		goto end
+		return
 	}
 
 	if expression, err = p.consumeAccessor(); err == nil {
-		goto end
+		return
 	}
 
 	if expression, err = p.consumeVariableOrFunction(); err == nil {
-		goto end
+		return
 	}
 
 	if expression, err = p.consumeQuestionMark(); err == nil {
-		goto end
+		return
 	}
 
 	if expression, err = p.consumeObject(); err == nil {
-		goto end
+		return
+	}
+
+	if expression, err = p.consumeBracket(); err == nil {
+		return
 	}
 
 	return nil, errors.New("expected expression")
+}
 
-end:
-	if op, err := p.consumeOperator(); err == nil {
-		if right, err := p.consumeExpression(); err == nil {
-			return &BinaryExpr{
-				Left:     expression,
-				Operator: op,
-				Right:    right,
-			}, nil
+//   Bracket := "(" Expressions ")"
+func (p *Parser) consumeBracket() (expr *BracketExpr, err error) {
+	defer p.tokens.Rollback(p.tokens.Position, &err)
+
+	_, err = p.tokens.Consume(TokenOpenBracket)
+	if err != nil {
+		return nil, err
+	}
+
+	exprs, err := p.consumeExpressions()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = p.tokens.Consume(TokenCloseBracket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BracketExpr{Statement: &Statement{Expressions: exprs}}, nil
+}
+
+// Keywords are words that are used by operators. They cannot be used as the
+// name of a variable.
+var Keywords = []string{
+	"and", "contains", "matches", "not", "or", "startsWith",
+}
+
+func isKeyword(word string) bool {
+	for _, keyword := range Keywords {
+		if word == keyword {
+			return true
 		}
 	}
 
-	return expression, nil
+	return false
+}
+
+//   Keyword := word
+func (p *Parser) consumeKeyword(keyword string) (err error) {
+	defer p.tokens.Rollback(p.tokens.Position, &err)
+
+	t, err := p.tokens.Consume(TokenWord)
+	if err == nil && t[0].Value != keyword {
+		err = fmt.Errorf("expected %s but found %s", keyword, t[0].Value)
+	}
+
+	return err
 }
 
 //   Constant := number | string
@@ -217,25 +333,56 @@ func (p *Parser) consumeConstant() (_ *ConstantExpr, err error) {
 	return nil, errors.New("no constant found")
 }
 
-//   Operator := "=" | "!=" | ">" | "<" | ">=" | "<="
-func (p *Parser) consumeOperator() (_ string, err error) {
+//   Operator := "=" | "!=" | ">" | "<" | ">=" | "<=" | "+" | "-" | "*" | "/"
+//             | "and" | "or" | "contains" | "startsWith" | "matches"
+func (p *Parser) consumeOperator() (_ string, precedence int, err error) {
 	defer p.tokens.Rollback(p.tokens.Position, &err)
 
 	for _, operator := range Operators {
 		originalPosition := p.tokens.Position
-		_, err := p.tokens.Consume(operator.Tokens...)
-		if err == nil {
-			return operator.Name, nil
+		t, err := p.tokens.Consume(operator.Tokens...)
+		if err == nil && (t[0].Kind != TokenWord || t[0].Value == operator.Name) {
+			return operator.Name, operator.Precedence, nil
 		} else {
 			p.tokens.Position = originalPosition
 		}
 	}
 
-	return "", errors.New("operator expected")
+	return "", 0, errors.New("operator expected")
+}
+
+//   Accessor := SingleAccessor { SingleAccessor }
+//
+// Chained accessors, like ".Name.Format("%f")", are the same as piping each
+// accessor into the next, like "(.Name | .Format("%f"))".
+func (p *Parser) consumeAccessor() (expr Expression, err error) {
+	defer p.tokens.Rollback(p.tokens.Position, &err)
+
+	var accessor *AccessorExpr
+	accessor, err = p.consumeSingleAccessor()
+	if err != nil {
+		return nil, err
+	}
+
+	expressions := []Expression{accessor}
+	for {
+		accessor, err := p.consumeSingleAccessor()
+		if err != nil {
+			break
+		}
+
+		expressions = append(expressions, accessor)
+	}
+
+	if len(expressions) == 1 {
+		return expressions[0], nil
+	}
+
+	return &BracketExpr{Statement: &Statement{Expressions: expressions}}, nil
 }
 
-//   Accessor := accessor
-func (p *Parser) consumeAccessor() (expr *AccessorExpr, err error) {
+//   SingleAccessor := accessor [ FunctionArgs ]
+func (p *Parser) consumeSingleAccessor() (expr *AccessorExpr, err error) {
 	defer p.tokens.Rollback(p.tokens.Position, &err)
 
 	var t []Token
@@ -244,8 +391,12 @@ func (p *Parser) consumeAccessor() (expr *AccessorExpr, err error) {
 		return nil, err
 	}
 
+	// Ignore error because method args are optional.
+	args, _ := p.consumeFunctionArgs()
+
 	return &AccessorExpr{
 		Query: t[0].Value,
+		Args:  args,
 	}, nil
 }
 
@@ -259,6 +410,10 @@ func (p *Parser) consumeVariableOrFunction() (expr Expression, err error) {
 		return nil, err
 	}
 
+	if isKeyword(t[0].Value) {
+		return nil, fmt.Errorf("%s is a keyword", t[0].Value)
+	}
+
 	// Ignore error because function args are optional.
 	args, _ := p.consumeFunctionArgs()
 
@@ -7,6 +7,8 @@ import (
 
 	"github.com/elliotchance/gedcom/v39/q"
 	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewParser(t *testing.T) {
@@ -162,6 +164,51 @@ func TestParser_ParseString(t *testing.T) {
 		}, nil)
 	}
 
+	ParseString(parser, `.Ancestors(3) | .Individual`).Returns(&q.Engine{
+		Statements: []*q.Statement{
+			{
+				Expressions: []q.Expression{
+					&q.AccessorExpr{
+						Query: ".Ancestors",
+						Args: []*q.Statement{
+							{
+								Expressions: []q.Expression{
+									&q.ConstantExpr{Value: "3"},
+								},
+							},
+						},
+					},
+					&q.AccessorExpr{Query: ".Individual"},
+				},
+			},
+		},
+	}, nil)
+
+	ParseString(parser, `.Individuals | .Name.Format("%f %L")`).Returns(&q.Engine{
+		Statements: []*q.Statement{
+			{
+				Expressions: []q.Expression{
+					&q.AccessorExpr{Query: ".Individuals"},
+					&q.BracketExpr{Statement: &q.Statement{
+						Expressions: []q.Expression{
+							&q.AccessorExpr{Query: ".Name"},
+							&q.AccessorExpr{
+								Query: ".Format",
+								Args: []*q.Statement{
+									{
+										Expressions: []q.Expression{
+											&q.ConstantExpr{Value: "%f %L"},
+										},
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+		},
+	}, nil)
+
 	ParseString(parser, `Only(.Foo = "bar")`).Returns(&q.Engine{
 		Statements: []*q.Statement{
 			{
@@ -235,3 +282,121 @@ func TestParser_ParseString(t *testing.T) {
 		},
 	}, nil)
 }
+
+func TestParser_ParseStringOperators(t *testing.T) {
+	parser := q.NewParser()
+
+	// Each query is compared to the same query with explicit brackets.
+	for query, expected := range map[string]q.Expression{
+		`1 + 2 * 3`: &q.BinaryExpr{
+			Left:     &q.ConstantExpr{Value: "1"},
+			Operator: "+",
+			Right: &q.BinaryExpr{
+				Left:     &q.ConstantExpr{Value: "2"},
+				Operator: "*",
+				Right:    &q.ConstantExpr{Value: "3"},
+			},
+		},
+		`1 - 2 - 3`: &q.BinaryExpr{
+			Left: &q.BinaryExpr{
+				Left:     &q.ConstantExpr{Value: "1"},
+				Operator: "-",
+				Right:    &q.ConstantExpr{Value: "2"},
+			},
+			Operator: "-",
+			Right:    &q.ConstantExpr{Value: "3"},
+		},
+		`(1 + 2) * 3`: &q.BinaryExpr{
+			Left: &q.BracketExpr{Statement: &q.Statement{
+				Expressions: []q.Expression{
+					&q.BinaryExpr{
+						Left:     &q.ConstantExpr{Value: "1"},
+						Operator: "+",
+						Right:    &q.ConstantExpr{Value: "2"},
+					},
+				},
+			}},
+			Operator: "*",
+			Right:    &q.ConstantExpr{Value: "3"},
+		},
+		`.Age > 80 and .Sex = "F"`: &q.BinaryExpr{
+			Left: &q.BinaryExpr{
+				Left:     &q.AccessorExpr{Query: ".Age"},
+				Operator: ">",
+				Right:    &q.ConstantExpr{Value: "80"},
+			},
+			Operator: "and",
+			Right: &q.BinaryExpr{
+				Left:     &q.AccessorExpr{Query: ".Sex"},
+				Operator: "=",
+				Right:    &q.ConstantExpr{Value: "F"},
+			},
+		},
+		`.A or .B and .C`: &q.BinaryExpr{
+			Left:     &q.AccessorExpr{Query: ".A"},
+			Operator: "or",
+			Right: &q.BinaryExpr{
+				Left:     &q.AccessorExpr{Query: ".B"},
+				Operator: "and",
+				Right:    &q.AccessorExpr{Query: ".C"},
+			},
+		},
+		`not .A = 1 and .B`: &q.BinaryExpr{
+			Left: &q.UnaryExpr{
+				Operator: "not",
+				Expr: &q.BinaryExpr{
+					Left:     &q.AccessorExpr{Query: ".A"},
+					Operator: "=",
+					Right:    &q.ConstantExpr{Value: "1"},
+				},
+			},
+			Operator: "and",
+			Right:    &q.AccessorExpr{Query: ".B"},
+		},
+		`-.A * 2`: &q.BinaryExpr{
+			Left: &q.UnaryExpr{
+				Operator: "-",
+				Expr:     &q.AccessorExpr{Query: ".A"},
+			},
+			Operator: "*",
+			Right:    &q.ConstantExpr{Value: "2"},
+		},
+		`.Name contains "john" or .Name startsWith "J"`: &q.BinaryExpr{
+			Left: &q.BinaryExpr{
+				Left:     &q.AccessorExpr{Query: ".Name"},
+				Operator: "contains",
+				Right:    &q.ConstantExpr{Value: "john"},
+			},
+			Operator: "or",
+			Right: &q.BinaryExpr{
+				Left:     &q.AccessorExpr{Query: ".Name"},
+				Operator: "startsWith",
+				Right:    &q.ConstantExpr{Value: "J"},
+			},
+		},
+		`(.Death | .Years) - 1.5`: &q.BinaryExpr{
+			Left: &q.BracketExpr{Statement: &q.Statement{
+				Expressions: []q.Expression{
+					&q.AccessorExpr{Query: ".Death"},
+					&q.AccessorExpr{Query: ".Years"},
+				},
+			}},
+			Operator: "-",
+			Right:    &q.ConstantExpr{Value: "1.5"},
+		},
+	} {
+		t.Run(query, func(t *testing.T) {
+			engine, err := parser.ParseString(query)
+			require.NoError(t, err)
+			assert.Equal(t, &q.Engine{
+				Statements: []*q.Statement{
+					{Expressions: []q.Expression{expected}},
+				},
+			}, engine)
+		})
+	}
+
+	// Keywords cannot be used as variables.
+	_, err := parser.ParseString(`and`)
+	assert.Error(t, err)
+}
@@ -12,28 +12,47 @@ var documentChoices = []string{
 	".AddFamilyWithHusbandAndWife",
 	".AddIndividual",
 	".AddNode",
+	".AncestryCycles",
 	".DeleteNode",
 	".Families",
+	".Header",
 	".GEDCOMString",
 	".Individuals",
+	".Multimedia",
 	".NodeByPointer",
 	".Nodes",
+	".Notes",
+	".PedigreeCollapses",
 	".Places",
+	".RecordWarnings",
+	".Repositories",
 	".SetNodes",
+	".SharedNotes",
 	".Sources",
 	".String",
+	".Submitters",
 	".Warnings",
 }
 
 var functionAndVariableChoices = []string{
 	"?",
+	"Avg",
 	"Combine",
+	"Count",
 	"First",
+	"GroupBy",
 	"Last",
 	"Length",
+	"Max",
 	"MergeDocumentsAndIndividuals",
+	"Min",
 	"NodesWithTagPath",
 	"Only",
+	"RelationshipTo",
+	"Sort",
+	"SortDesc",
+	"Sum",
+	"Unique",
 }
 
 func TestQuestionMarkExpr_Evaluate(t *testing.T) {
@@ -41,7 +60,7 @@ func TestQuestionMarkExpr_Evaluate(t *testing.T) {
 		(*q.QuestionMarkExpr).Evaluate)
 	engine := &q.Engine{}
 
-	expected := append([]string{".Baz", ".Foo", ".Panic"},
+	expected := append([]string{".Baz", ".Check", ".Foo", ".Panic", ".Repeat"},
 		functionAndVariableChoices...)
 
 	Evaluate(&q.QuestionMarkExpr{}, engine, &MyStruct{}, nil).
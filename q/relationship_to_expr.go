@@ -0,0 +1,92 @@
+package q
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/elliotchance/gedcom/v39"
+)
+
+// RelationshipToExpr is a function. See Evaluate.
+type RelationshipToExpr struct{}
+
+// Evaluate returns the relationship of each individual to another individual.
+// See gedcom.IndividualNode.RelationshipTo.
+//
+// The argument is the pointer of the other individual, or an expression that
+// returns the individual. The argument is evaluated with the document of the
+// individual as its input:
+//
+//   .Individuals | RelationshipTo("P1") | .String
+//
+// If the input is a slice then the result will be a slice of the same length.
+// The relationship will be nil for individuals that are not related.
+func (e *RelationshipToExpr) Evaluate(engine *Engine, input interface{}, args []*Statement) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, errors.New("function RelationshipTo() must take a single argument")
+	}
+
+	if input == nil {
+		return nil, nil
+	}
+
+	if individual, ok := input.(*gedcom.IndividualNode); ok {
+		other, err := e.other(engine, individual.Document(), args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return individual.RelationshipTo(other), nil
+	}
+
+	in := reflect.ValueOf(input)
+	if in.Kind() != reflect.Slice {
+		return nil, fmt.Errorf(
+			"function RelationshipTo() must be used on individuals, not %s",
+			getType(input))
+	}
+
+	results := []*gedcom.Relationship{}
+	for i := 0; i < in.Len(); i++ {
+		result, err := e.Evaluate(engine, in.Index(i).Interface(), args)
+		if err != nil {
+			return nil, err
+		}
+
+		relationship, _ := result.(*gedcom.Relationship)
+		results = append(results, relationship)
+	}
+
+	return results, nil
+}
+
+// other evaluates the argument to find the other individual.
+func (e *RelationshipToExpr) other(engine *Engine, document *gedcom.Document, arg *Statement) (*gedcom.IndividualNode, error) {
+	value, err := arg.Evaluate(engine, document)
+	if err != nil {
+		return nil, err
+	}
+
+	// An expression like Only() will return a slice.
+	if in := reflect.ValueOf(value); in.Kind() == reflect.Slice && in.Len() == 1 {
+		value = in.Index(0).Interface()
+	}
+
+	switch v := value.(type) {
+	case *gedcom.IndividualNode:
+		return v, nil
+
+	case string:
+		pointer := strings.Trim(v, "@")
+		if individual, ok := document.NodeByPointer(pointer).(*gedcom.IndividualNode); ok {
+			return individual, nil
+		}
+
+		return nil, fmt.Errorf("no individual with pointer %s", pointer)
+	}
+
+	return nil, errors.New(
+		"argument of RelationshipTo() must be a pointer or an individual")
+}
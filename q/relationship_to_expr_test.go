@@ -0,0 +1,65 @@
+package q_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/q"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelationshipToExpr_Evaluate(t *testing.T) {
+	document := gedcom.NewDocument()
+	father := document.AddIndividual("P1", gedcom.NewSexNode(gedcom.SexMale))
+	son := document.AddIndividual("P2", gedcom.NewSexNode(gedcom.SexMale))
+	document.AddIndividual("P3")
+	document.AddFamilyWithHusbandAndWife("F1", father, nil).AddChild(son)
+
+	documents := []*gedcom.Document{document}
+	parser := q.NewParser()
+
+	for _, test := range []struct {
+		query    string
+		expected interface{}
+		err      string
+	}{
+		{
+			query:    `.Individuals | RelationshipTo("P2") | .String`,
+			expected: []string{"father", "self", ""},
+		},
+		{
+			query:    `.Individuals | RelationshipTo("@P1@") | .String`,
+			expected: []string{"self", "son", ""},
+		},
+		{
+			query:    `Son are .Individuals | Only(.Pointer = "P2"); .Individuals | RelationshipTo(Son) | .String`,
+			expected: []string{"father", "self", ""},
+		},
+		{
+			query: `.Individuals | RelationshipTo("P4")`,
+			err:   "no individual with pointer P4",
+		},
+		{
+			query: `.Individuals | RelationshipTo("P1", "P2")`,
+			err:   "function RelationshipTo() must take a single argument",
+		},
+		{
+			query: `.Families | RelationshipTo("P1")`,
+			err:   "function RelationshipTo() must be used on individuals, not FamilyNode",
+		},
+	} {
+		t.Run(test.query, func(t *testing.T) {
+			engine, err := parser.ParseString(test.query)
+			require.NoError(t, err)
+
+			actual, err := engine.Evaluate(documents)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package q
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/elliotchance/gedcom/v39"
+)
+
+// Session evaluates many queries against the same documents, such as when
+// queries are entered one line at a time.
+//
+// Unlike Engine.Evaluate, the variables that are declared in one query can be
+// used by all of the queries that follow.
+type Session struct {
+	Documents []*gedcom.Document
+	engine    *Engine
+}
+
+// NewSession creates a session for the documents. There must be at least one
+// document. Each document is available as the variables Document1, Document2,
+// etc.
+func NewSession(documents []*gedcom.Document) *Session {
+	engine := &Engine{}
+
+	for i, document := range documents {
+		engine.Statements = append(engine.Statements, &Statement{
+			VariableName: fmt.Sprintf("Document%d", i+1),
+			Expressions: []Expression{
+				&ValueExpr{Value: document},
+			},
+		})
+	}
+
+	return &Session{
+		Documents: documents,
+		engine:    engine,
+	}
+}
+
+// Evaluate parses and evaluates a query, returning the result of the last
+// statement.
+//
+// Variables declared in the query are kept for later queries. Each variable
+// is evaluated once and its result is kept. A variable that already exists
+// will be replaced. If any statement returns an error none of
+// the variables are kept.
+func (s *Session) Evaluate(query string) (interface{}, error) {
+	engine, result, err := s.evaluate(query)
+	if err != nil {
+		return nil, err
+	}
+
+	s.engine = engine
+
+	return result, nil
+}
+
+// Variables returns the names of all the variables, in the order they were
+// declared.
+func (s *Session) Variables() []string {
+	names := []string{}
+	for _, statement := range s.engine.Statements {
+		names = append(names, statement.VariableName)
+	}
+
+	return names
+}
+
+// evaluate returns a new engine that contains the existing and new variables
+// without changing the session.
+func (s *Session) evaluate(query string) (*Engine, interface{}, error) {
+	if len(s.Documents) == 0 {
+		return nil, nil, errors.New("there are no documents")
+	}
+
+	parsed, err := NewParser().ParseString(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	engine := &Engine{
+		Statements: append([]*Statement{}, s.engine.Statements...),
+	}
+
+	var result interface{}
+	for _, statement := range parsed.Statements {
+		result, err = statement.Evaluate(engine, s.Documents[0])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The result is kept rather than the statement so that the variable
+		// is not evaluated again each time it is used. This also allows a
+		// variable to be replaced by using its previous value.
+		if statement.VariableName != "" {
+			engine.setVariable(&Statement{
+				VariableName: statement.VariableName,
+				Expressions:  []Expression{&ValueExpr{Value: result}},
+			})
+		}
+	}
+
+	return engine, result, nil
+}
+
+var completeWordRegexp = regexp.MustCompile(`\.?[a-zA-Z0-9_]*$`)
+
+// Complete returns the possible lines that finish the last word of an
+// incomplete query. The choices are the same as would be returned by the "?"
+// function at that point.
+//
+// The result of the query before the word is needed to know which accessors
+// are available. For example, completing ".Individuals | .Na" will evaluate
+// ".Individuals" to find the accessors that begin with ".Na".
+//
+// No completions are returned if the query before the word cannot be
+// evaluated.
+func (s *Session) Complete(line string) []string {
+	word := completeWordRegexp.FindString(line)
+	prefix := line[:len(line)-len(word)]
+
+	input, err := s.completeInput(prefix, word)
+	if err != nil || isNil(input) {
+		return nil
+	}
+
+	choices, err := (&QuestionMarkExpr{}).Evaluate(s.engine, input, nil)
+	if err != nil {
+		return nil
+	}
+
+	lines := []string{}
+	for _, choice := range choices.([]string) {
+		if strings.HasPrefix(choice, word) {
+			lines = append(lines, prefix+choice)
+		}
+	}
+
+	return lines
+}
+
+// completeInput evaluates the part of the query that would be the input to
+// the word being completed.
+func (s *Session) completeInput(prefix, word string) (interface{}, error) {
+	// Chained accessors, like ".Name.Fo", use the whole prefix as the input.
+	if strings.HasPrefix(word, ".") && prefix != "" &&
+		!strings.ContainsAny(prefix[len(prefix)-1:], " \t|(,:;{") {
+		_, result, err := s.evaluate(prefix)
+
+		return result, err
+	}
+
+	query := lastPipeInput(prefix)
+	if strings.TrimSpace(query) == "" {
+		return s.Documents[0], nil
+	}
+
+	_, result, err := s.evaluate(query)
+
+	return result, err
+}
+
+// lastPipeInput returns everything before the last pipe that is not inside
+// brackets or a string. An empty string is returned if there is no pipe, or a
+// new statement has started after the last pipe.
+func lastPipeInput(prefix string) string {
+	depth, inString, lastPipe := 0, false, -1
+
+	for i, c := range prefix {
+		switch {
+		case c == '"':
+			inString = !inString
+
+		case inString:
+			// Ignore everything in strings.
+
+		case c == '(' || c == '{':
+			depth++
+
+		case c == ')' || c == '}':
+			depth--
+
+		case depth == 0 && c == '|':
+			lastPipe = i
+
+		case depth == 0 && c == ';':
+			lastPipe = -1
+		}
+	}
+
+	if lastPipe < 0 {
+		return ""
+	}
+
+	return prefix[:lastPipe]
+}
@@ -0,0 +1,112 @@
+package q_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/q"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSession_Evaluate(t *testing.T) {
+	document := gedcom.NewDocument()
+	document.AddIndividual("P1", gedcom.NewNameNode("Elliot /Chance/"))
+	document.AddIndividual("P2", gedcom.NewNameNode("Dina /Wyche/"))
+
+	session := q.NewSession([]*gedcom.Document{document})
+
+	result, err := session.Evaluate(".Individuals | Length")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result)
+
+	// Variables are kept between queries.
+	result, err = session.Evaluate(`People are .Individuals`)
+	assert.NoError(t, err)
+	assert.Equal(t, document.Individuals(), result)
+
+	result, err = session.Evaluate(`People | First(1) | .Name | .String`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Elliot Chance"}, result)
+
+	// Variables can be replaced.
+	_, err = session.Evaluate(`People are .Individuals | Last(1)`)
+	assert.NoError(t, err)
+
+	result, err = session.Evaluate(`People | .Name | .String`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Dina Wyche"}, result)
+
+	// A variable can be replaced by using its previous value.
+	_, err = session.Evaluate(`Everyone are .Individuals`)
+	assert.NoError(t, err)
+
+	_, err = session.Evaluate(`Everyone are Everyone | First(1)`)
+	assert.NoError(t, err)
+
+	result, err = session.Evaluate(`Everyone | .Name | .String`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Elliot Chance"}, result)
+
+	// Variables are evaluated once.
+	document.AddIndividual("P3", gedcom.NewNameNode("Bob /Smith/"))
+
+	result, err = session.Evaluate(`People | .Name | .String`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Dina Wyche"}, result)
+
+	// Variables are not kept when there is an error.
+	_, err = session.Evaluate(`People are .Families; Foo is .Missing`)
+	assert.EqualError(t, err,
+		`Document does not have a method or property named "Missing"`)
+
+	result, err = session.Evaluate(`People | Length`)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result)
+
+	_, err = session.Evaluate(`Foo`)
+	assert.EqualError(t, err, "no such variable Foo")
+
+	_, err = session.Evaluate(`.Individuals |`)
+	assert.Error(t, err)
+
+	assert.Equal(t, []string{"Document1", "People", "Everyone"},
+		session.Variables())
+}
+
+func TestSession_Complete(t *testing.T) {
+	document := gedcom.NewDocument()
+	document.AddIndividual("P1", gedcom.NewNameNode("Elliot /Chance/"))
+
+	session := q.NewSession([]*gedcom.Document{document})
+	_, err := session.Evaluate(`People are .Individuals`)
+	assert.NoError(t, err)
+
+	for line, expected := range map[string][]string{
+		".Individ": {".Individuals"},
+		".Individuals | .Nam": {
+			".Individuals | .Name",
+			".Individuals | .Names",
+		},
+		".Individuals | .Name.Forma": {".Individuals | .Name.Format"},
+		"People | .Sur": {
+			"People | .SurroundingSimilarity",
+		},
+		"Peo":  {"People"},
+		"Docu": {"Document1"},
+		`.Individuals | Only(.Ag`: {
+			`.Individuals | Only(.Age`,
+			`.Individuals | Only(.AgeAt`,
+		},
+		`.Individuals | Only(.Sex = "a|b" and .Is`: {
+			`.Individuals | Only(.Sex = "a|b" and .Is`,
+			`.Individuals | Only(.Sex = "a|b" and .IsLiving`,
+		},
+		"People | First(1); .Individ": {"People | First(1); .Individuals"},
+		".Missing | .Na":              nil,
+		".Individuals | .Zzz":         {},
+	} {
+		t.Run(line, func(t *testing.T) {
+			assert.Equal(t, expected, session.Complete(line))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package q
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+)
+
+// SortExpr is a function. See Evaluate.
+type SortExpr struct {
+	// Descending reverses the order. It is used by the SortDesc function.
+	Descending bool
+}
+
+// Evaluate returns a new slice that is sorted by the value of the argument for
+// each element. The values are compared in the same way as the comparison
+// operators, so numbers are sorted numerically and strings are sorted without
+// case. Nil values are sorted before all other values.
+//
+// Elements with the same value will retain their original order.
+//
+// If the input is not a slice it is returned unchanged.
+func (e *SortExpr) Evaluate(engine *Engine, input interface{}, args []*Statement) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, errors.New("function " + e.name() + "() must take a single argument")
+	}
+
+	in := reflect.ValueOf(input)
+	if in.Kind() != reflect.Slice {
+		return input, nil
+	}
+
+	keys, err := evaluateEach(engine, in, args, e.name())
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]int, in.Len())
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		if e.Descending {
+			return compareValues(keys[order[i]], keys[order[j]]) > 0
+		}
+
+		return compareValues(keys[order[i]], keys[order[j]]) < 0
+	})
+
+	results := reflect.MakeSlice(in.Type(), 0, in.Len())
+	for _, i := range order {
+		results = reflect.Append(results, in.Index(i))
+	}
+
+	return results.Interface(), nil
+}
+
+func (e *SortExpr) name() string {
+	if e.Descending {
+		return "SortDesc"
+	}
+
+	return "Sort"
+}
@@ -0,0 +1,49 @@
+package q_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/q"
+	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortExpr_Evaluate(t *testing.T) {
+	Evaluate := tf.NamedFunction(t, "SortExpr_Evaluate", (*q.SortExpr).Evaluate)
+	engine := &q.Engine{}
+
+	argNil := []*q.Statement{}
+	argProperty := []*q.Statement{{Expressions: []q.Expression{
+		&q.AccessorExpr{Query: ".Property"},
+	}}}
+	argValue := []*q.Statement{{Expressions: []q.Expression{
+		&q.AccessorExpr{Query: ".Value"},
+	}}}
+
+	structs := []MyStruct{{Property: 5}, {Property: 13}, {Property: 2}, {Property: 5}}
+
+	Evaluate(&q.SortExpr{}, engine, nil, argProperty).Returns(nil, nil)
+	Evaluate(&q.SortExpr{}, engine, structs, argNil).
+		Returns(nil, errors.New("function Sort() must take a single argument"))
+	Evaluate(&q.SortExpr{Descending: true}, engine, structs, argNil).
+		Returns(nil, errors.New("function SortDesc() must take a single argument"))
+
+	Evaluate(&q.SortExpr{}, engine, structs, argProperty).Returns(
+		[]MyStruct{{Property: 2}, {Property: 5}, {Property: 5}, {Property: 13}}, nil)
+	Evaluate(&q.SortExpr{Descending: true}, engine, structs, argProperty).Returns(
+		[]MyStruct{{Property: 13}, {Property: 5}, {Property: 5}, {Property: 2}}, nil)
+
+	// Strings are sorted without case.
+	b, c, a := gedcom.NewNameNode("b"), gedcom.NewNameNode("C"), gedcom.NewNameNode("a")
+	Evaluate(&q.SortExpr{}, engine, []*gedcom.NameNode{b, c, a}, argValue).
+		Returns([]*gedcom.NameNode{a, b, c}, nil)
+
+	// The input is not modified.
+	assert.Equal(t, []MyStruct{{Property: 5}, {Property: 13}, {Property: 2}, {Property: 5}}, structs)
+
+	// Non-slices are returned unchanged.
+	Evaluate(&q.SortExpr{}, engine, MyStruct{Property: 3}, argProperty).
+		Returns(MyStruct{Property: 3}, nil)
+}
@@ -0,0 +1,46 @@
+package q
+
+// SumExpr is a function. See Evaluate.
+type SumExpr struct{}
+
+// Evaluate returns the total of the numbers in the slice. If there is an
+// argument then the value of the argument for each element is used instead.
+//
+// Values that are not numbers (see "Data Types") are ignored. The result is
+// always a float64, and will be 0 if there are no numbers.
+func (e *SumExpr) Evaluate(engine *Engine, input interface{}, args []*Statement) (interface{}, error) {
+	numbers, err := evaluateNumbers(engine, input, args, "Sum")
+	if err != nil {
+		return nil, err
+	}
+
+	sum := 0.0
+	for _, number := range numbers {
+		sum += number
+	}
+
+	return sum, nil
+}
+
+// evaluateNumbers returns the numbers from evaluateEach. Values that are not
+// numbers are ignored.
+func evaluateNumbers(engine *Engine, input interface{}, args []*Statement, name string) ([]float64, error) {
+	in, ok := valueAsSlice(input)
+	if !ok {
+		return nil, nil
+	}
+
+	values, err := evaluateEach(engine, in, args, name)
+	if err != nil {
+		return nil, err
+	}
+
+	numbers := []float64{}
+	for _, value := range values {
+		if number, ok := numberValue(value); ok {
+			numbers = append(numbers, number)
+		}
+	}
+
+	return numbers, nil
+}
@@ -0,0 +1,27 @@
+package q_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39/q"
+	"github.com/elliotchance/tf"
+)
+
+func TestSumExpr_Evaluate(t *testing.T) {
+	Evaluate := tf.NamedFunction(t, "SumExpr_Evaluate", (*q.SumExpr).Evaluate)
+	engine := &q.Engine{}
+
+	argProperty := []*q.Statement{{Expressions: []q.Expression{
+		&q.AccessorExpr{Query: ".Property"},
+	}}}
+
+	Evaluate(&q.SumExpr{}, engine, nil, nil).Returns(0.0, nil)
+	Evaluate(&q.SumExpr{}, engine, []int{}, nil).Returns(0.0, nil)
+	Evaluate(&q.SumExpr{}, engine, []int{1, 2, 3}, nil).Returns(6.0, nil)
+	Evaluate(&q.SumExpr{}, engine, []string{"1.5", "foo", "", " 2 "}, nil).
+		Returns(3.5, nil)
+	Evaluate(&q.SumExpr{}, engine, 7, nil).Returns(7.0, nil)
+	Evaluate(&q.SumExpr{}, engine,
+		[]MyStruct{{Property: 5}, {Property: 13}}, argProperty).
+		Returns(18.0, nil)
+}
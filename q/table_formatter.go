@@ -0,0 +1,76 @@
+package q
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// TableFormatter writes the result as plain text with the columns aligned. It
+// is intended to be read on a terminal.
+//
+// The rows and columns are the same as CSVFormatter.
+type TableFormatter struct {
+	Writer io.Writer
+}
+
+func (f *TableFormatter) Write(result interface{}) error {
+	columns, rows, err := tabular(result)
+	if err != nil {
+		return err
+	}
+
+	lines := [][]string{columns}
+
+	for _, row := range rows {
+		line := []string{}
+
+		// Values that span many lines would break the alignment.
+		for _, name := range columns {
+			cell := strings.Replace(csvValue(row[name]), "\n", " ", -1)
+			line = append(line, cell)
+		}
+
+		lines = append(lines, line)
+	}
+
+	widths := make([]int, len(columns))
+	for _, line := range lines {
+		for i, value := range line {
+			if width := utf8.RuneCountInString(value); width > widths[i] {
+				widths[i] = width
+			}
+		}
+	}
+
+	for i, line := range lines {
+		f.writeLine(line, widths)
+
+		// The header is separated from the rows.
+		if i == 0 {
+			separator := []string{}
+			for _, width := range widths {
+				separator = append(separator, strings.Repeat("-", width))
+			}
+
+			f.writeLine(separator, widths)
+		}
+	}
+
+	return nil
+}
+
+func (f *TableFormatter) writeLine(values []string, widths []int) {
+	if len(values) == 0 {
+		return
+	}
+
+	padded := []string{}
+	for i, value := range values {
+		padding := widths[i] - utf8.RuneCountInString(value)
+		padded = append(padded, value+strings.Repeat(" ", padding))
+	}
+
+	fmt.Fprintln(f.Writer, strings.TrimRight(strings.Join(padded, "  "), " "))
+}
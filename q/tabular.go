@@ -0,0 +1,130 @@
+package q
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"unicode"
+
+	"github.com/elliotchance/gedcom/v39"
+)
+
+// tabular converts a result into rows and columns. It is used by all of the
+// formatters that write a table (such as CSVFormatter) so that the same result
+// always has the same columns.
+//
+// Each element of a slice is a row. A single number, string or boolean (such
+// as the result of Count) is one row with a "Value" column.
+//
+// Objects inside of each row are flattened into more columns. For example,
+// "{name: .Name, born: {date: .Birth, year: .Birth | .Years}}" has the
+// columns "born.date", "born.year" and "name".
+//
+// The columns are sorted by name and include the columns from every row.
+func tabular(result interface{}) (columns []string, rows []map[string]interface{}, err error) {
+	v := reflect.ValueOf(result)
+
+	if isCSVScalar(v) {
+		v = reflect.ValueOf([]interface{}{result})
+	}
+
+	if v.Kind() != reflect.Slice {
+		return nil, nil, errors.New("not a slice")
+	}
+
+	seen := map[string]bool{}
+
+	for i := 0; i < v.Len(); i++ {
+		row := map[string]interface{}{}
+		flattenRow(row, "", tabularRow(v.Index(i).Interface()))
+
+		for name := range row {
+			if !seen[name] {
+				seen[name] = true
+				columns = append(columns, name)
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	sort.Strings(columns)
+
+	return columns, rows, nil
+}
+
+func isCSVScalar(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// flattenRow copies the values into the row. The keys of objects are joined to
+// the name of the object with a ".".
+func flattenRow(row map[string]interface{}, prefix string, values map[string]interface{}) {
+	for key, value := range values {
+		name := key
+		if prefix != "" {
+			name = prefix + "." + key
+		}
+
+		if object, ok := value.(map[string]interface{}); ok {
+			flattenRow(row, name, object)
+			continue
+		}
+
+		row[name] = value
+	}
+}
+
+// tabularRow returns the columns for a single row.
+func tabularRow(line interface{}) map[string]interface{} {
+	if m, ok := line.(gedcom.ObjectMapper); ok {
+		return m.ObjectMap()
+	}
+
+	l := reflect.ValueOf(line)
+
+	if isCSVScalar(l) {
+		return map[string]interface{}{"Value": line}
+	}
+
+	if l.Kind() == reflect.Ptr {
+		l = l.Elem()
+	}
+
+	if l.Kind() == reflect.Map {
+		m := map[string]interface{}{}
+		for _, name := range l.MapKeys() {
+			m[name.Interface().(string)] = l.MapIndex(name).Interface()
+		}
+
+		return m
+	}
+
+	if l.Kind() == reflect.Struct {
+		t := l.Type()
+		m := map[string]interface{}{}
+
+		for i := 0; i < t.NumField(); i++ {
+			name := t.Field(i).Name
+
+			// Ignore unexported
+			if !unicode.IsUpper(rune(name[0])) {
+				continue
+			}
+
+			m[name] = l.FieldByName(name).Interface()
+		}
+
+		return m
+	}
+
+	return nil
+}
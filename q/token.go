@@ -34,6 +34,10 @@ const (
 	TokenNot          = TokenKind("!")
 	TokenGreaterThan  = TokenKind(">")
 	TokenLessThan     = TokenKind("<")
+	TokenPlus         = TokenKind("+")
+	TokenMinus        = TokenKind("-")
+	TokenMultiply     = TokenKind("*")
+	TokenDivide       = TokenKind("/")
 )
 
 var TokenRegexp = []struct {
@@ -54,10 +58,14 @@ var TokenRegexp = []struct {
 	{regexp.MustCompile(`^=$`), TokenEqual},
 	{regexp.MustCompile(`^>$`), TokenGreaterThan},
 	{regexp.MustCompile(`^<$`), TokenLessThan},
+	{regexp.MustCompile(`^\+$`), TokenPlus},
+	{regexp.MustCompile(`^-$`), TokenMinus},
+	{regexp.MustCompile(`^\*$`), TokenMultiply},
+	{regexp.MustCompile(`^/$`), TokenDivide},
 	{regexp.MustCompile(`^".*"$`), TokenString},
 	{regexp.MustCompile(`^\.[a-zA-Z0-9_]*$`), TokenAccessor},
 	{regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`), TokenWord},
-	{regexp.MustCompile(`^[0-9]+$`), TokenNumber},
+	{regexp.MustCompile(`^[0-9]+\.?[0-9]*$`), TokenNumber},
 }
 
 type Token struct {
@@ -53,12 +53,27 @@ func TestTokenizer_TokenizeString(t *testing.T) {
 	for _, operator := range q.Operators {
 		expectedTokens := []q.Token{}
 		for _, token := range operator.Tokens {
-			expectedTokens = append(expectedTokens, q.Token{token, string(token)})
+			value := string(token)
+			if token == q.TokenWord {
+				value = operator.Name
+			}
+
+			expectedTokens = append(expectedTokens, q.Token{token, value})
 		}
 
 		TokenizeString(tz, operator.Name).
 			Returns(&q.Tokens{Tokens: expectedTokens})
 	}
+
+	TokenizeString(tz, "1.5*(2-3)").Returns(&q.Tokens{Tokens: []q.Token{
+		{q.TokenNumber, "1.5"},
+		{q.TokenMultiply, "*"},
+		{q.TokenOpenBracket, "("},
+		{q.TokenNumber, "2"},
+		{q.TokenMinus, "-"},
+		{q.TokenNumber, "3"},
+		{q.TokenCloseBracket, ")"},
+	}})
 }
 
 func TestTokens_Consume(t *testing.T) {
@@ -0,0 +1,47 @@
+package q
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TSVFormatter writes tab-separated values. The rows and columns are the same
+// as CSVFormatter.
+//
+// Values cannot be quoted in TSV, so tabs and new lines in values are replaced
+// with spaces.
+type TSVFormatter struct {
+	Writer io.Writer
+}
+
+func (f *TSVFormatter) Write(result interface{}) error {
+	columns, rows, err := tabular(result)
+	if err != nil {
+		return err
+	}
+
+	f.writeLine(columns)
+
+	for _, row := range rows {
+		line := []string{}
+		for _, name := range columns {
+			line = append(line, csvValue(row[name]))
+		}
+
+		f.writeLine(line)
+	}
+
+	return nil
+}
+
+var tsvReplacer = strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ")
+
+func (f *TSVFormatter) writeLine(fields []string) {
+	values := []string{}
+	for _, field := range fields {
+		values = append(values, tsvReplacer.Replace(field))
+	}
+
+	fmt.Fprintf(f.Writer, "%s\n", strings.Join(values, "\t"))
+}
@@ -0,0 +1,39 @@
+package q
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// UnaryExpr evaluates a unary operator expression. The operator is either
+// "not" or "-" (negative).
+type UnaryExpr struct {
+	Operator string
+	Expr     Expression
+}
+
+func (e *UnaryExpr) Evaluate(engine *Engine, input interface{}, args []*Statement) (interface{}, error) {
+	// If it is a slice we need to Evaluate each one.
+	if in := reflect.ValueOf(input); in.Kind() == reflect.Slice {
+		return evaluateElements(engine, in, e, args)
+	}
+
+	value, err := e.Expr.Evaluate(engine, input, args)
+	if err != nil {
+		return nil, err
+	}
+
+	switch e.Operator {
+	case "not":
+		return !truthy(value), nil
+
+	case "-":
+		if f, ok := arithmeticValue(value); ok {
+			return -f, nil
+		}
+
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("no such operator: %s", e.Operator)
+}
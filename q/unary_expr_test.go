@@ -0,0 +1,30 @@
+package q_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39/q"
+	"github.com/elliotchance/tf"
+)
+
+func TestUnaryExpr_Evaluate(t *testing.T) {
+	Evaluate := tf.NamedFunction(t, "UnaryExpr_Evaluate", (*q.UnaryExpr).Evaluate)
+	engine := &q.Engine{}
+
+	Evaluate(&q.UnaryExpr{"not", &q.ValueExpr{true}}, engine, nil, nil).
+		Returns(false, nil)
+	Evaluate(&q.UnaryExpr{"not", &q.ValueExpr{nil}}, engine, nil, nil).
+		Returns(true, nil)
+	Evaluate(&q.UnaryExpr{"not", &q.ConstantExpr{"foo"}}, engine, nil, nil).
+		Returns(false, nil)
+	Evaluate(&q.UnaryExpr{"-", &q.ConstantExpr{"1.5"}}, engine, nil, nil).
+		Returns(-1.5, nil)
+	Evaluate(&q.UnaryExpr{"-", &q.ConstantExpr{"foo"}}, engine, nil, nil).
+		Returns(nil, nil)
+	Evaluate(&q.UnaryExpr{"~", &q.ConstantExpr{"foo"}}, engine, nil, nil).
+		Errors("no such operator: ~")
+
+	Evaluate(&q.UnaryExpr{"not", &q.AccessorExpr{Query: ".Property"}}, engine,
+		[]MyStruct{{Property: 0}, {Property: 3}}, nil).
+		Returns([]bool{true, false}, nil)
+}
@@ -0,0 +1,39 @@
+package q
+
+import (
+	"errors"
+	"reflect"
+)
+
+// UniqueExpr is a function. See Evaluate.
+type UniqueExpr struct{}
+
+// Evaluate returns a new slice without the elements that have already
+// appeared. Values are equal in the same way as the "=" operator. However,
+// pointers (such as individuals) are only equal to themselves.
+//
+// If the input value is not a slice then it is converted into a slice of one
+// element before evaluating. If the input is nil the result will also be nil.
+func (e *UniqueExpr) Evaluate(engine *Engine, input interface{}, args []*Statement) (interface{}, error) {
+	if len(args) != 0 {
+		return nil, errors.New("function Unique() does not take any arguments")
+	}
+
+	in, ok := valueAsSlice(input)
+	if !ok {
+		return nil, nil
+	}
+
+	results := reflect.MakeSlice(in.Type(), 0, 0)
+	seen := map[interface{}]bool{}
+
+	for i := 0; i < in.Len(); i++ {
+		key := valueKey(in.Index(i).Interface())
+		if !seen[key] {
+			results = reflect.Append(results, in.Index(i))
+			seen[key] = true
+		}
+	}
+
+	return results.Interface(), nil
+}
@@ -0,0 +1,37 @@
+package q_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/gedcom/v39/q"
+	"github.com/elliotchance/tf"
+)
+
+func TestUniqueExpr_Evaluate(t *testing.T) {
+	Evaluate := tf.NamedFunction(t, "UniqueExpr_Evaluate", (*q.UniqueExpr).Evaluate)
+	engine := &q.Engine{}
+
+	argProperty := []*q.Statement{{Expressions: []q.Expression{
+		&q.AccessorExpr{Query: ".Property"},
+	}}}
+
+	Evaluate(&q.UniqueExpr{}, engine, nil, nil).Returns(nil, nil)
+	Evaluate(&q.UniqueExpr{}, engine, []int{1}, argProperty).
+		Returns(nil, errors.New("function Unique() does not take any arguments"))
+
+	Evaluate(&q.UniqueExpr{}, engine, []int{3, 1, 3, 2, 1}, nil).
+		Returns([]int{3, 1, 2}, nil)
+	Evaluate(&q.UniqueExpr{}, engine, []string{"Smith", "Jones", " smith", "1.0", "1"}, nil).
+		Returns([]string{"Smith", "Jones", "1.0"}, nil)
+	Evaluate(&q.UniqueExpr{}, engine, "foo", nil).
+		Returns([]string{"foo"}, nil)
+
+	// Pointers are only equal to themselves.
+	n1 := gedcom.NewNameNode("Bob /Smith/")
+	n2 := gedcom.NewNameNode("Bob /Smith/")
+
+	Evaluate(&q.UniqueExpr{}, engine, []*gedcom.NameNode{n1, n2, n1}, nil).
+		Returns([]*gedcom.NameNode{n1, n2}, nil)
+}
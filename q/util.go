@@ -1,7 +1,10 @@
 package q
 
 import (
+	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 )
 
 func getType(v interface{}) string {
@@ -45,3 +48,145 @@ func ValueToPointer(v reflect.Value) reflect.Value {
 
 	return v
 }
+
+// valueAsSlice converts the input into a slice. If the input value is not a
+// slice then it is converted into a slice of one element. The second return
+// value is false if the input is nil.
+func valueAsSlice(input interface{}) (reflect.Value, bool) {
+	if isNil(input) {
+		return reflect.Value{}, false
+	}
+
+	in := reflect.ValueOf(input)
+
+	if in.Kind() != reflect.Slice {
+		s := reflect.MakeSlice(reflect.SliceOf(in.Type()), 1, 1)
+		s.Index(0).Set(in)
+
+		return s, true
+	}
+
+	return in, true
+}
+
+// evaluateEach returns the value of each element in the slice. If there is an
+// argument it is evaluated with each element, otherwise the element is used as
+// the value.
+//
+// The function name is used for the error when there is more than one
+// argument.
+func evaluateEach(engine *Engine, in reflect.Value, args []*Statement, name string) ([]interface{}, error) {
+	if len(args) > 1 {
+		return nil, fmt.Errorf("function %s() must take zero or one argument", name)
+	}
+
+	values := make([]interface{}, in.Len())
+	for i := range values {
+		values[i] = in.Index(i).Interface()
+
+		if len(args) == 0 {
+			continue
+		}
+
+		var err error
+		values[i], err = args[0].Evaluate(engine, values[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
+
+// compareValues returns -1, 0 or 1 using the same rules as the comparison
+// operators. That is, numbers are compared numerically and all other values
+// are compared as strings without case. A nil value is less than all other
+// values.
+func compareValues(left, right interface{}) int {
+	leftIsNil, rightIsNil := isNil(left), isNil(right)
+
+	switch {
+	case leftIsNil && rightIsNil:
+		return 0
+
+	case leftIsNil:
+		return -1
+
+	case rightIsNil:
+		return 1
+	}
+
+	sLeft, sRight := binaryStrings(left, right)
+
+	if floatLeft, floatRight, ok := binaryFloats(sLeft, sRight); ok {
+		switch {
+		case floatLeft < floatRight:
+			return -1
+
+		case floatLeft > floatRight:
+			return 1
+		}
+
+		return 0
+	}
+
+	return strings.Compare(normalizeString(sLeft), normalizeString(sRight))
+}
+
+// numberValue returns the numerical value of a number, or a string that
+// represents a number.
+func numberValue(value interface{}) (float64, bool) {
+	if isNil(value) {
+		return 0, false
+	}
+
+	s, _ := binaryStrings(value, nil)
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+
+	return f, err == nil
+}
+
+// valueKey is used to find values that are equal with a map. Numbers and
+// strings are equal in the same way as the "=" operator. Pointers, such as an
+// individual, are only equal to themselves.
+func valueKey(value interface{}) interface{} {
+	if isNil(value) {
+		return nil
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Ptr:
+		return value
+
+	case reflect.Map, reflect.Slice:
+		return fmt.Sprintf("%v", value)
+	}
+
+	if f, ok := numberValue(value); ok {
+		return f
+	}
+
+	s, _ := binaryStrings(value, nil)
+
+	return normalizeString(s)
+}
+
+// isNil is the same as gedcom.IsNil except that it can also be used with values
+// that can never be nil, such as numbers.
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface,
+		reflect.Func, reflect.Chan:
+		return v.IsNil()
+	}
+
+	return false
+}
+
+func normalizeString(s string) string {
+	return strings.TrimSpace(strings.ToLower(s))
+}
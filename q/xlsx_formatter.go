@@ -0,0 +1,153 @@
+package q
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+)
+
+// XLSXFormatter writes an Excel workbook (Office Open XML) with a single
+// sheet. The rows and columns are the same as CSVFormatter.
+//
+// Numbers and booleans are written as their own cell types so that they can
+// be used in formulas. All other values are written as text.
+type XLSXFormatter struct {
+	Writer io.Writer
+}
+
+// xlsxFiles are the parts of the workbook that do not change. The sheet is
+// added as "xl/worksheets/sheet1.xml".
+var xlsxFiles = []struct {
+	name, content string
+}{
+	{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Result" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`},
+	{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`},
+}
+
+func (f *XLSXFormatter) Write(result interface{}) error {
+	columns, rows, err := tabular(result)
+	if err != nil {
+		return err
+	}
+
+	sheet := &bytes.Buffer{}
+	sheet.WriteString(xml.Header)
+	sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	if len(columns) > 0 {
+		header := []interface{}{}
+		for _, name := range columns {
+			header = append(header, name)
+		}
+
+		xlsxWriteRow(sheet, 1, header)
+	}
+
+	for i, row := range rows {
+		values := []interface{}{}
+		for _, name := range columns {
+			values = append(values, row[name])
+		}
+
+		xlsxWriteRow(sheet, i+2, values)
+	}
+
+	sheet.WriteString(`</sheetData></worksheet>`)
+
+	archive := zip.NewWriter(f.Writer)
+
+	for _, file := range xlsxFiles {
+		if err := xlsxWriteFile(archive, file.name, []byte(file.content)); err != nil {
+			return err
+		}
+	}
+
+	err = xlsxWriteFile(archive, "xl/worksheets/sheet1.xml", sheet.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+func xlsxWriteFile(archive *zip.Writer, name string, content []byte) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(content)
+
+	return err
+}
+
+func xlsxWriteRow(sheet *bytes.Buffer, rowNumber int, values []interface{}) {
+	fmt.Fprintf(sheet, `<row r="%d">`, rowNumber)
+
+	for i, value := range values {
+		// Missing values are left as empty cells.
+		if isNil(value) {
+			continue
+		}
+
+		ref := xlsxColumnName(i) + strconv.Itoa(rowNumber)
+		v := reflect.ValueOf(value)
+		kind := v.Kind()
+
+		// Types like gedcom.AgeConstraint are numbers that should be shown
+		// as text.
+		if _, ok := value.(fmt.Stringer); ok {
+			kind = reflect.String
+		}
+
+		switch kind {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+			reflect.Uint64, reflect.Float32, reflect.Float64:
+			fmt.Fprintf(sheet, `<c r="%s"><v>%v</v></c>`, ref, value)
+
+		case reflect.Bool:
+			b := 0
+			if v.Bool() {
+				b = 1
+			}
+
+			fmt.Fprintf(sheet, `<c r="%s" t="b"><v>%d</v></c>`, ref, b)
+
+		default:
+			fmt.Fprintf(sheet, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref)
+			xml.EscapeText(sheet, []byte(csvValue(value)))
+			sheet.WriteString(`</t></is></c>`)
+		}
+	}
+
+	sheet.WriteString(`</row>`)
+}
+
+// xlsxColumnName returns the name of a column from its zero-based index, such
+// as "A", "Z", "AA".
+func xlsxColumnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+
+	return name
+}
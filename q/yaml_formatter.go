@@ -0,0 +1,140 @@
+package q
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// YAMLFormatter writes the result as YAML.
+//
+// The result is first encoded as JSON so that it has the same structure as the
+// JSONFormatter. The keys of each object are sorted.
+type YAMLFormatter struct {
+	Writer io.Writer
+}
+
+func (f *YAMLFormatter) Write(result interface{}) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+
+	lines := yamlLines(value)
+	_, err = io.WriteString(f.Writer, strings.Join(lines, "\n")+"\n")
+
+	return err
+}
+
+// yamlLines returns the lines for a value that has been decoded from JSON.
+// Lines after the first line must be indented by the caller.
+func yamlLines(value interface{}) []string {
+	switch v := value.(type) {
+	case []interface{}:
+		if len(v) == 0 {
+			return []string{"[]"}
+		}
+
+		lines := []string{}
+		for _, item := range v {
+			lines = append(lines, yamlNested("- ", yamlLines(item))...)
+		}
+
+		return lines
+
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return []string{"{}"}
+		}
+
+		keys := []string{}
+		for key := range v {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		lines := []string{}
+		for _, key := range keys {
+			item := v[key]
+			prefix := yamlString(key) + ":"
+
+			// Objects and lists start on the next line.
+			if isYAMLCollection(item) {
+				lines = append(lines, prefix)
+				lines = append(lines, yamlNested("  ", yamlLines(item))...)
+			} else {
+				lines = append(lines, prefix+" "+yamlLines(item)[0])
+			}
+		}
+
+		return lines
+
+	case string:
+		return []string{yamlString(v)}
+
+	case json.Number:
+		return []string{v.String()}
+
+	case bool:
+		return []string{strconv.FormatBool(v)}
+	}
+
+	return []string{"null"}
+}
+
+// yamlNested prefixes the first line. The other lines are indented to line up
+// with it.
+func yamlNested(prefix string, lines []string) []string {
+	indent := strings.Repeat(" ", len(prefix))
+
+	for i := range lines {
+		if i == 0 {
+			lines[i] = prefix + lines[i]
+		} else {
+			lines[i] = indent + lines[i]
+		}
+	}
+
+	return lines
+}
+
+func isYAMLCollection(value interface{}) bool {
+	switch v := value.(type) {
+	case []interface{}:
+		return len(v) > 0
+
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+
+	return false
+}
+
+var (
+	yamlPlainRegexp    = regexp.MustCompile(`^[a-zA-Z_/][a-zA-Z0-9_ ./()@-]*$`)
+	yamlReservedRegexp = regexp.MustCompile(`^(?i:y|n|yes|no|on|off|true|false|null)$`)
+)
+
+// yamlString only quotes strings when they would otherwise be read as another
+// type, or contain special characters.
+func yamlString(s string) string {
+	if yamlPlainRegexp.MatchString(s) && !yamlReservedRegexp.MatchString(s) &&
+		!strings.HasSuffix(s, " ") {
+		return s
+	}
+
+	return strconv.Quote(s)
+}
@@ -0,0 +1,386 @@
+// Relationships
+//
+// IndividualNode.RelationshipTo finds how two individuals are related, such as
+// "great-grandfather" or "second cousin once removed".
+//
+// Blood relationships are found through the closest common ancestors of both
+// individuals. If the individuals are not related by blood then the
+// relationships through a spouse of either individual are used instead. These
+// are step relationships (such as "stepson") and in-law relationships (such as
+// "sister-in-law").
+package gedcom
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Relationship describes how Individual is related to Other. The String
+// method returns an English description, like "great-grandfather", that reads
+// as "Individual is the great-grandfather of Other".
+//
+// Relationships through marriage have either SpouseOfIndividual or
+// SpouseOfOther set. In this case the blood relationship (CommonAncestors and
+// generations) is between the spouse and the other individual.
+type Relationship struct {
+	Individual, Other *IndividualNode
+
+	// SpouseOfIndividual is set when Individual is married to a blood relative
+	// of Other, for example a son-in-law or stepfather.
+	SpouseOfIndividual *IndividualNode
+
+	// SpouseOfOther is set when Individual is a blood relative of the spouse
+	// of Other, for example a mother-in-law or stepson.
+	SpouseOfOther *IndividualNode
+
+	// CommonAncestors are the closest ancestors that are shared. This is
+	// usually a couple, or a single individual for half relationships. If one
+	// individual is the ancestor of the other it will be the only common
+	// ancestor.
+	CommonAncestors IndividualNodes
+
+	// Generations is the number of generations between Individual (or
+	// SpouseOfIndividual) and the CommonAncestors. OtherGenerations is the
+	// number of generations between Other (or SpouseOfOther) and the
+	// CommonAncestors.
+	//
+	// For example, Generations is 0 and OtherGenerations is 2 when Individual
+	// is the grandparent of Other. Both are 2 for first cousins.
+	Generations, OtherGenerations int
+
+	// IsHalf is true when the CommonAncestors do not include both parents of
+	// the family that each line descends from, like half-siblings.
+	IsHalf bool
+
+	// IsStep is true for a stepparent (the spouse of an ancestor) or a
+	// stepchild (the descendant of a spouse).
+	IsStep bool
+
+	// IsInLaw is true for all other relationships through marriage.
+	IsInLaw bool
+}
+
+// relationshipAncestor is an ancestor found by relationshipAncestors.
+type relationshipAncestor struct {
+	generations int
+
+	// family is the family where the ancestor is the parent of the previous
+	// individual in the line. It is nil for the individual itself.
+	family *FamilyNode
+}
+
+// relationshipAncestors returns all the ancestors (including the individual)
+// with the fewest generations between them. The individuals are also returned
+// in order of their generation. If maxGenerations is zero (or less) there is
+// no limit.
+func relationshipAncestors(individual *IndividualNode, maxGenerations int) (map[*IndividualNode]relationshipAncestor, IndividualNodes) {
+	ancestors := map[*IndividualNode]relationshipAncestor{
+		individual: {},
+	}
+	order := IndividualNodes{individual}
+
+	// order is extended while it is being iterated so that it also acts as
+	// the queue for a breadth first search. Individuals that have already been
+	// seen are ignored so that cyclic data cannot loop forever.
+	for i := 0; i < len(order); i++ {
+		generations := ancestors[order[i]].generations + 1
+		if maxGenerations > 0 && generations > maxGenerations {
+			break
+		}
+
+		for _, family := range order[i].Parents() {
+			parents := []*IndividualNode{
+				family.Husband().Individual(),
+				family.Wife().Individual(),
+			}
+
+			for _, parent := range parents {
+				if _, ok := ancestors[parent]; ok || parent == nil {
+					continue
+				}
+
+				ancestors[parent] = relationshipAncestor{generations, family}
+				order = append(order, parent)
+			}
+		}
+	}
+
+	return ancestors, order
+}
+
+// bloodRelationship returns the closest blood relationship, or nil if the
+// individuals do not share any ancestors.
+func bloodRelationship(individual, other *IndividualNode) *Relationship {
+	ancestors, order := relationshipAncestors(individual, 0)
+	otherAncestors, _ := relationshipAncestors(other, 0)
+
+	// The closest common ancestors have the fewest generations between both
+	// individuals. If there is a tie the ancestor that is closer to the
+	// individual is used.
+	var relationship *Relationship
+	for _, ancestor := range order {
+		otherAncestor, ok := otherAncestors[ancestor]
+		if !ok {
+			continue
+		}
+
+		candidate := &Relationship{
+			Individual:       individual,
+			Other:            other,
+			CommonAncestors:  IndividualNodes{ancestor},
+			Generations:      ancestors[ancestor].generations,
+			OtherGenerations: otherAncestor.generations,
+			IsHalf: ancestors[ancestor].family != otherAncestor.family &&
+				ancestor != individual && ancestor != other,
+		}
+
+		switch {
+		case candidate.closerThan(relationship):
+			relationship = candidate
+
+		case !relationship.closerThan(candidate) &&
+			candidate.Generations == relationship.Generations:
+			// Usually the other parent of the same family.
+			relationship.CommonAncestors = append(
+				relationship.CommonAncestors, ancestor)
+			relationship.IsHalf = relationship.IsHalf && candidate.IsHalf
+		}
+	}
+
+	return relationship
+}
+
+// closerThan returns true if the relationship has fewer generations than
+// another relationship, which may be nil.
+func (relationship *Relationship) closerThan(other *Relationship) bool {
+	return other == nil ||
+		relationship.Generations+relationship.OtherGenerations <
+			other.Generations+other.OtherGenerations
+}
+
+// RelationshipTo returns the closest relationship between the individual and
+// another individual. See Relationship.
+//
+// Blood relationships are always preferred over relationships through
+// marriage. Only a single marriage is followed, so the relatives of a
+// brother-in-law are not considered.
+//
+// If either individual is nil, or they are not related, the result will be
+// nil.
+func (node *IndividualNode) RelationshipTo(other *IndividualNode) *Relationship {
+	if node == nil || other == nil {
+		return nil
+	}
+
+	if relationship := bloodRelationship(node, other); relationship != nil {
+		return relationship
+	}
+
+	var closest *Relationship
+
+	for _, spouse := range node.Spouses() {
+		relationship := bloodRelationship(spouse, other)
+		if relationship == nil || !relationship.closerThan(closest) {
+			continue
+		}
+
+		relationship.Individual = node
+		relationship.SpouseOfIndividual = spouse
+
+		// The spouse of an ancestor. A spouse of the individual itself is
+		// neither.
+		relationship.IsStep = relationship.Generations == 0 &&
+			relationship.OtherGenerations > 0
+		relationship.IsInLaw = relationship.Generations > 0
+
+		closest = relationship
+	}
+
+	for _, spouse := range other.Spouses() {
+		relationship := bloodRelationship(node, spouse)
+		if relationship == nil || !relationship.closerThan(closest) {
+			continue
+		}
+
+		relationship.Other = other
+		relationship.SpouseOfOther = spouse
+
+		// A descendant of the spouse.
+		relationship.IsStep = relationship.OtherGenerations == 0
+		relationship.IsInLaw = relationship.OtherGenerations > 0
+
+		closest = relationship
+	}
+
+	return closest
+}
+
+// IsSpouse returns true if Individual and Other are married to each other.
+func (relationship *Relationship) IsSpouse() bool {
+	return relationship != nil && relationship.SpouseOfIndividual != nil &&
+		relationship.Generations == 0 && relationship.OtherGenerations == 0
+}
+
+// String returns the English description of the relationship, like "second
+// cousin once removed". The words used depend on the sex of Individual, such
+// as "uncle", "aunt" or "aunt or uncle" when the sex is unknown.
+//
+// If the relationship is nil the result will be an empty string.
+func (relationship *Relationship) String() string {
+	if relationship == nil {
+		return ""
+	}
+
+	sex := relationship.Individual.Sex()
+
+	if relationship.IsSpouse() {
+		return sexWord(sex, "husband", "wife", "spouse")
+	}
+
+	description := relationshipName(relationship.Generations,
+		relationship.OtherGenerations, sex)
+
+	switch {
+	case relationship.IsStep && relationship.closeFamily():
+		return "step" + description
+
+	case relationship.IsStep:
+		return "step-" + description
+
+	case relationship.IsInLaw:
+		return description + "-in-law"
+
+	case relationship.IsHalf && strings.Contains(description, " "):
+		return "half " + description
+
+	case relationship.IsHalf:
+		return "half-" + description
+	}
+
+	return description
+}
+
+// closeFamily is true for parents, children and siblings. These are the
+// relationships that are written as a single word with "step", like
+// "stepfather".
+func (relationship *Relationship) closeFamily() bool {
+	return relationship.Generations <= 1 && relationship.OtherGenerations <= 1
+}
+
+// relationshipName is the blood relationship of an individual to another
+// individual when they are the given number of generations from their common
+// ancestors.
+func relationshipName(generations, otherGenerations int, sex *SexNode) string {
+	switch {
+	case generations == 0 && otherGenerations == 0:
+		return "self"
+
+	case generations == 0:
+		return lineageName(otherGenerations,
+			sexWord(sex, "father", "mother", "parent"))
+
+	case otherGenerations == 0:
+		return lineageName(generations,
+			sexWord(sex, "son", "daughter", "child"))
+
+	case generations == 1 && otherGenerations == 1:
+		return sexWord(sex, "brother", "sister", "sibling")
+
+	case generations == 1:
+		return greatPrefix(otherGenerations-2) +
+			sexWord(sex, "uncle", "aunt", "aunt or uncle")
+
+	case otherGenerations == 1:
+		return greatPrefix(generations-2) +
+			sexWord(sex, "nephew", "niece", "niece or nephew")
+	}
+
+	degree, removed := generations-1, otherGenerations-generations
+	if generations > otherGenerations {
+		degree, removed = otherGenerations-1, generations-otherGenerations
+	}
+
+	cousin := ordinalWord(degree) + " cousin"
+
+	switch removed {
+	case 0:
+		return cousin
+
+	case 1:
+		return cousin + " once removed"
+
+	case 2:
+		return cousin + " twice removed"
+	}
+
+	return fmt.Sprintf("%s %d times removed", cousin, removed)
+}
+
+// lineageName is the name for a direct ancestor or descendant, like
+// "great-grandson".
+func lineageName(generations int, word string) string {
+	if generations == 1 {
+		return word
+	}
+
+	return greatPrefix(generations-2) + "grand" + word
+}
+
+// greatPrefix is "great-" repeated for one or two generations. After that the
+// number is used, like "3rd great-".
+func greatPrefix(generations int) string {
+	switch {
+	case generations < 1:
+		return ""
+
+	case generations < 3:
+		return strings.Repeat("great-", generations)
+	}
+
+	return ordinalNumber(generations) + " great-"
+}
+
+// ordinalWord is the ordinal used for cousins, like "second".
+func ordinalWord(n int) string {
+	words := []string{"", "first", "second", "third", "fourth", "fifth",
+		"sixth", "seventh", "eighth", "ninth", "tenth"}
+
+	if n < len(words) {
+		return words[n]
+	}
+
+	return ordinalNumber(n)
+}
+
+// ordinalNumber is a number with its ordinal suffix, like "3rd" or "11th".
+func ordinalNumber(n int) string {
+	suffix := "th"
+
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+		// 11th, 12th and 13th.
+
+	case n%10 == 1:
+		suffix = "st"
+
+	case n%10 == 2:
+		suffix = "nd"
+
+	case n%10 == 3:
+		suffix = "rd"
+	}
+
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+func sexWord(sex *SexNode, male, female, unknown string) string {
+	switch {
+	case sex.IsMale():
+		return male
+
+	case sex.IsFemale():
+		return female
+	}
+
+	return unknown
+}
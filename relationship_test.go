@@ -0,0 +1,243 @@
+package gedcom_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/stretchr/testify/assert"
+)
+
+// relationshipDocument is four generations of one family.
+//
+// P1 has children with P2 (P4 and P5) and P3 (P6). P7 and P10 marry into the
+// family. P16 is married to P9 and has a child (P17) from another marriage. P18
+// is not related to anyone.
+var relationshipDocument = `0 @P1@ INDI
+1 SEX M
+0 @P2@ INDI
+1 SEX F
+0 @P3@ INDI
+1 SEX F
+0 @P4@ INDI
+1 SEX M
+0 @P5@ INDI
+1 SEX F
+0 @P6@ INDI
+1 SEX M
+0 @P7@ INDI
+1 SEX F
+0 @P8@ INDI
+1 SEX M
+0 @P9@ INDI
+1 SEX F
+0 @P10@ INDI
+1 SEX M
+0 @P11@ INDI
+1 SEX F
+0 @P12@ INDI
+1 SEX F
+0 @P13@ INDI
+1 SEX M
+0 @P14@ INDI
+0 @P15@ INDI
+1 SEX M
+0 @P16@ INDI
+1 SEX M
+0 @P17@ INDI
+1 SEX M
+0 @P18@ INDI
+1 SEX M
+0 @F1@ FAM
+1 HUSB @P1@
+1 WIFE @P2@
+1 CHIL @P4@
+1 CHIL @P5@
+0 @F2@ FAM
+1 HUSB @P1@
+1 WIFE @P3@
+1 CHIL @P6@
+0 @F3@ FAM
+1 HUSB @P4@
+1 WIFE @P7@
+1 CHIL @P8@
+1 CHIL @P9@
+0 @F4@ FAM
+1 HUSB @P10@
+1 WIFE @P5@
+1 CHIL @P11@
+0 @F5@ FAM
+1 HUSB @P8@
+1 WIFE @P12@
+1 CHIL @P13@
+0 @F6@ FAM
+1 WIFE @P11@
+1 CHIL @P14@
+0 @F7@ FAM
+1 HUSB @P13@
+1 CHIL @P15@
+0 @F8@ FAM
+1 HUSB @P16@
+1 WIFE @P9@
+0 @F9@ FAM
+1 HUSB @P16@
+1 CHIL @P17@
+`
+
+func TestIndividualNode_RelationshipTo(t *testing.T) {
+	doc := newDocumentFromString(relationshipDocument)
+
+	for _, test := range []struct {
+		individual, other string
+		expected          string
+	}{
+		{"P1", "P1", "self"},
+
+		// Ancestors and descendants.
+		{"P1", "P4", "father"},
+		{"P4", "P1", "son"},
+		{"P11", "P5", "daughter"},
+		{"P14", "P11", "child"},
+		{"P1", "P8", "grandfather"},
+		{"P8", "P2", "grandson"},
+		{"P1", "P13", "great-grandfather"},
+		{"P1", "P15", "great-great-grandfather"},
+		{"P15", "P2", "great-great-grandson"},
+
+		// Siblings, aunts and uncles.
+		{"P4", "P5", "brother"},
+		{"P5", "P4", "sister"},
+		{"P4", "P6", "half-brother"},
+		{"P6", "P5", "half-brother"},
+		{"P4", "P11", "uncle"},
+		{"P11", "P4", "niece"},
+		{"P5", "P13", "great-aunt"},
+		{"P13", "P5", "great-nephew"},
+		{"P6", "P8", "half-uncle"},
+		{"P8", "P6", "half-nephew"},
+
+		// Cousins.
+		{"P8", "P11", "first cousin"},
+		{"P13", "P11", "first cousin once removed"},
+		{"P15", "P11", "first cousin twice removed"},
+		{"P13", "P14", "second cousin"},
+		{"P14", "P13", "second cousin"},
+		{"P15", "P14", "second cousin once removed"},
+		{"P6", "P9", "half-uncle"},
+		{"P9", "P11", "first cousin"},
+
+		// Marriage.
+		{"P7", "P4", "wife"},
+		{"P4", "P7", "husband"},
+		{"P7", "P1", "daughter-in-law"},
+		{"P1", "P7", "father-in-law"},
+		{"P7", "P5", "sister-in-law"},
+		{"P10", "P8", "uncle-in-law"},
+		{"P3", "P4", "stepmother"},
+		{"P4", "P3", "stepson"},
+		{"P3", "P8", "step-grandmother"},
+		{"P8", "P3", "step-grandson"},
+		{"P17", "P9", "stepson"},
+		{"P9", "P17", "stepmother"},
+
+		// Not related.
+		{"P18", "P1", ""},
+		{"P17", "P1", ""},
+	} {
+		t.Run(test.individual+"-"+test.other, func(t *testing.T) {
+			individual := doc.Individuals().ByPointer(test.individual)
+			other := doc.Individuals().ByPointer(test.other)
+
+			assert.Equal(t, test.expected,
+				individual.RelationshipTo(other).String())
+		})
+	}
+}
+
+func TestIndividualNode_RelationshipToDetails(t *testing.T) {
+	doc := newDocumentFromString(relationshipDocument)
+	individual := func(pointer string) *gedcom.IndividualNode {
+		return doc.Individuals().ByPointer(pointer)
+	}
+
+	t.Run("Nil", func(t *testing.T) {
+		assert.Nil(t, (*gedcom.IndividualNode)(nil).RelationshipTo(individual("P1")))
+		assert.Nil(t, individual("P1").RelationshipTo(nil))
+	})
+
+	t.Run("Cousins", func(t *testing.T) {
+		assert.Equal(t, &gedcom.Relationship{
+			Individual:       individual("P13"),
+			Other:            individual("P11"),
+			CommonAncestors:  gedcom.IndividualNodes{individual("P1"), individual("P2")},
+			Generations:      3,
+			OtherGenerations: 2,
+		}, individual("P13").RelationshipTo(individual("P11")))
+	})
+
+	t.Run("HalfSiblings", func(t *testing.T) {
+		assert.Equal(t, &gedcom.Relationship{
+			Individual:       individual("P6"),
+			Other:            individual("P5"),
+			CommonAncestors:  gedcom.IndividualNodes{individual("P1")},
+			Generations:      1,
+			OtherGenerations: 1,
+			IsHalf:           true,
+		}, individual("P6").RelationshipTo(individual("P5")))
+	})
+
+	t.Run("InLaw", func(t *testing.T) {
+		assert.Equal(t, &gedcom.Relationship{
+			Individual:         individual("P7"),
+			Other:              individual("P5"),
+			SpouseOfIndividual: individual("P4"),
+			CommonAncestors:    gedcom.IndividualNodes{individual("P1"), individual("P2")},
+			Generations:        1,
+			OtherGenerations:   1,
+			IsInLaw:            true,
+		}, individual("P7").RelationshipTo(individual("P5")))
+	})
+
+	t.Run("Stepchild", func(t *testing.T) {
+		assert.Equal(t, &gedcom.Relationship{
+			Individual:       individual("P17"),
+			Other:            individual("P9"),
+			SpouseOfOther:    individual("P16"),
+			CommonAncestors:  gedcom.IndividualNodes{individual("P16")},
+			Generations:      1,
+			OtherGenerations: 0,
+			IsStep:           true,
+		}, individual("P17").RelationshipTo(individual("P9")))
+	})
+
+	t.Run("Spouse", func(t *testing.T) {
+		relationship := individual("P7").RelationshipTo(individual("P4"))
+		assert.True(t, relationship.IsSpouse())
+		assert.False(t, individual("P4").RelationshipTo(individual("P5")).IsSpouse())
+	})
+}
+
+func TestRelationship_StringRemoved(t *testing.T) {
+	for _, test := range []struct {
+		generations, otherGenerations int
+		expected                      string
+	}{
+		{0, 5, "3rd great-grandfather"},
+		{0, 13, "11th great-grandfather"},
+		{6, 0, "4th great-grandson"},
+		{1, 5, "3rd great-uncle"},
+		{6, 6, "fifth cousin"},
+		{12, 12, "11th cousin"},
+		{3, 6, "second cousin 3 times removed"},
+		{7, 3, "second cousin 4 times removed"},
+	} {
+		t.Run(test.expected, func(t *testing.T) {
+			relationship := &gedcom.Relationship{
+				Individual:       gedcom.NewDocument().AddIndividual("P1", gedcom.NewSexNode(gedcom.SexMale)),
+				Generations:      test.generations,
+				OtherGenerations: test.otherGenerations,
+			}
+
+			assert.Equal(t, test.expected, relationship.String())
+		})
+	}
+}
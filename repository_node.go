@@ -0,0 +1,63 @@
+package gedcom
+
+// RepositoryNode represents an institution or person that has the specified
+// item as part of their collection(s).
+//
+// The same node is used for the REPO record and the REPO inside a SOUR that
+// points to it. The accessors of a pointer will return the values of the
+// record it points to.
+type RepositoryNode struct {
+	*simpleDocumentNode
+}
+
+// NewRepositoryNode creates a new REPO node.
+func NewRepositoryNode(value, pointer string, children ...Node) *RepositoryNode {
+	return newRepositoryNode(nil, value, pointer, children...)
+}
+
+func newRepositoryNode(document *Document, value, pointer string, children ...Node) *RepositoryNode {
+	return &RepositoryNode{
+		newSimpleDocumentNode(document, TagRepository, value, pointer, children...),
+	}
+}
+
+// ShallowCopy returns a REPO with the same value and pointer that belongs to
+// the same document.
+func (node *RepositoryNode) ShallowCopy() Node {
+	return newRepositoryNode(node.Document(), node.Value(), node.Pointer())
+}
+
+// Record returns the REPO record. If the node is a pointer it will be
+// resolved, otherwise the node itself is returned.
+//
+// If the node is nil or the pointer cannot be resolved the result will be nil.
+func (node *RepositoryNode) Record() *RepositoryNode {
+	if node == nil {
+		return nil
+	}
+
+	if valueToPointer(node.value) == "" {
+		return node
+	}
+
+	if record, ok := nodeForPointerValue(node.document, node.value).(*RepositoryNode); ok {
+		return record
+	}
+
+	return nil
+}
+
+// Name is the official name of the archive.
+//
+// If the node is nil the result will be an empty string.
+func (node *RepositoryNode) Name() string {
+	return firstValueWithTag(node.Record(), TagName)
+}
+
+// Address is the full address of the repository, which may span several
+// lines.
+//
+// If the node is nil the result will be an empty string.
+func (node *RepositoryNode) Address() string {
+	return firstValueWithTag(node.Record(), TagAddress)
+}
@@ -0,0 +1,109 @@
+package gedcom_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+const repositoryGEDCOM = `0 @R1@ REPO
+1 NAME Family History Library
+1 ADDR 35 N West Temple Street
+2 CONT Salt Lake City, Utah
+0 @S1@ SOUR
+1 TITL Parish Registers
+1 REPO @R1@
+2 CALN 123
+0 @S2@ SOUR
+1 REPO
+2 NOTE Private collection
+0 @S3@ SOUR
+1 REPO @R2@
+0 @S4@ SOUR`
+
+func TestRepositoryNode_Name(t *testing.T) {
+	Name := tf.Function(t, (*gedcom.RepositoryNode).Name)
+
+	Name((*gedcom.RepositoryNode)(nil)).Returns("")
+	Name(gedcom.NewRepositoryNode("", "R1")).Returns("")
+	Name(gedcom.NewRepositoryNode("", "R1",
+		gedcom.NewNode(gedcom.TagName, "Library", ""))).Returns("Library")
+}
+
+func TestRepositoryNode_Address(t *testing.T) {
+	Address := tf.Function(t, (*gedcom.RepositoryNode).Address)
+
+	Address((*gedcom.RepositoryNode)(nil)).Returns("")
+	Address(gedcom.NewRepositoryNode("", "R1",
+		gedcom.NewNode(gedcom.TagAddress, "1 Main St", ""))).Returns("1 Main St")
+}
+
+func TestRepositoryNode_Record(t *testing.T) {
+	doc, err := gedcom.NewDocumentFromString(repositoryGEDCOM)
+	assert.NoError(t, err)
+
+	sources := doc.Sources()
+	repository := doc.Repositories()[0]
+
+	assert.Nil(t, (*gedcom.RepositoryNode)(nil).Record())
+	assert.Equal(t, repository, repository.Record())
+
+	reference := gedcom.First(gedcom.NodesWithTag(sources[0], gedcom.TagRepository)).(*gedcom.RepositoryNode)
+	assert.Equal(t, repository, reference.Record())
+	assert.Equal(t, "Family History Library", reference.Name())
+	assert.Equal(t, "35 N West Temple Street\nSalt Lake City, Utah", reference.Address())
+
+	// A pointer cannot be resolved without a document.
+	assert.Nil(t, gedcom.NewRepositoryNode("@R1@", "").Record())
+}
+
+func TestSourceNode_Repository(t *testing.T) {
+	doc, err := gedcom.NewDocumentFromString(repositoryGEDCOM)
+	assert.NoError(t, err)
+
+	sources := doc.Sources()
+
+	assert.Nil(t, (*gedcom.SourceNode)(nil).Repository())
+	assert.Equal(t, doc.Repositories()[0], sources[0].Repository())
+
+	// A repository without a record.
+	assert.Equal(t, gedcom.First(gedcom.NodesWithTag(sources[1], gedcom.TagRepository)),
+		sources[1].Repository())
+
+	// The record does not exist.
+	assert.Nil(t, sources[2].Repository())
+	assert.Nil(t, sources[3].Repository())
+}
+
+func TestRepositoryNode_ShallowCopy(t *testing.T) {
+	doc, err := gedcom.NewDocumentFromString(repositoryGEDCOM)
+	assert.NoError(t, err)
+
+	reference := gedcom.First(gedcom.NodesWithTag(doc.Sources()[0], gedcom.TagRepository))
+	copied, ok := reference.ShallowCopy().(*gedcom.RepositoryNode)
+
+	assert.True(t, ok)
+	assert.Equal(t, doc, copied.Document())
+	assert.Equal(t, doc.Repositories()[0], copied.Record())
+	assert.Len(t, copied.Nodes(), 0)
+}
+
+func TestSourceNode_RepositoryAfterCopy(t *testing.T) {
+	doc, err := gedcom.NewDocumentFromString(repositoryGEDCOM)
+	assert.NoError(t, err)
+
+	source := doc.Sources()[0]
+	repository := doc.Repositories()[0]
+
+	filtered := gedcom.Filter(source, doc, gedcom.OfficialTagFilter()).(*gedcom.SourceNode)
+	assert.Equal(t, repository, filtered.Repository())
+
+	copied := gedcom.DeepCopy(source, doc).(*gedcom.SourceNode)
+	assert.Equal(t, repository, copied.Repository())
+
+	shallow := source.ShallowCopy().(*gedcom.SourceNode)
+	shallow.AddNode(gedcom.First(gedcom.NodesWithTag(source, gedcom.TagRepository)).ShallowCopy())
+	assert.Equal(t, repository, shallow.Repository())
+}
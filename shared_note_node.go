@@ -0,0 +1,60 @@
+package gedcom
+
+// SharedNoteNode is a GEDCOM 7.0 shared note (SNOTE).
+//
+// The same node is used for the SNOTE record, which contains the text of the
+// note, and the SNOTE inside another record that points to it. In GEDCOM
+// 5.5.1 these are NOTE records and NOTE pointers.
+type SharedNoteNode struct {
+	*simpleDocumentNode
+}
+
+// NewSharedNoteNode creates a new SNOTE node.
+func NewSharedNoteNode(value, pointer string, children ...Node) *SharedNoteNode {
+	return newSharedNoteNode(nil, value, pointer, children...)
+}
+
+func newSharedNoteNode(document *Document, value, pointer string, children ...Node) *SharedNoteNode {
+	return &SharedNoteNode{
+		newSimpleDocumentNode(document, TagSharedNote, value, pointer, children...),
+	}
+}
+
+// ShallowCopy returns a SNOTE with the same value and pointer that belongs to
+// the same document.
+func (node *SharedNoteNode) ShallowCopy() Node {
+	return newSharedNoteNode(node.Document(), node.Value(), node.Pointer())
+}
+
+// Record returns the SNOTE record. If the node is a pointer it will be
+// resolved, otherwise the node itself is returned.
+//
+// If the node is nil or the pointer cannot be resolved (including a "@VOID@"
+// pointer) the result will be nil.
+func (node *SharedNoteNode) Record() *SharedNoteNode {
+	if node == nil {
+		return nil
+	}
+
+	if valueToPointer(node.value) == "" {
+		return node
+	}
+
+	if record, ok := nodeForPointerValue(node.document, node.value).(*SharedNoteNode); ok {
+		return record
+	}
+
+	return nil
+}
+
+// Text is the text of the note, which may span several lines.
+//
+// If the node is nil or the pointer cannot be resolved the result will be an
+// empty string.
+func (node *SharedNoteNode) Text() string {
+	if record := node.Record(); record != nil {
+		return record.Value()
+	}
+
+	return ""
+}
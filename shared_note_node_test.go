@@ -0,0 +1,26 @@
+package gedcom_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharedNoteNode_Text(t *testing.T) {
+	Text := tf.Function(t, (*gedcom.SharedNoteNode).Text)
+
+	Text((*gedcom.SharedNoteNode)(nil)).Returns("")
+	Text(gedcom.NewSharedNoteNode("foo\nbar", "N1")).Returns("foo\nbar")
+
+	// A pointer cannot be resolved without a document.
+	Text(gedcom.NewSharedNoteNode("@N1@", "")).Returns("")
+}
+
+func TestSharedNoteNode_Record(t *testing.T) {
+	note := gedcom.NewSharedNoteNode("foo", "N1")
+
+	assert.Nil(t, (*gedcom.SharedNoteNode)(nil).Record())
+	assert.Equal(t, note, note.Record())
+}
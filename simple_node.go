@@ -14,6 +14,14 @@ type SimpleNode struct {
 	value    string
 	pointer  string
 	children Nodes
+
+	// continuation is only set when the value was decoded from more than one
+	// line (using CONC and/or CONT).
+	continuation *continuation
+
+	// source is only set when the node was decoded with
+	// Decoder.PreserveFormatting.
+	source *nodeSource
 }
 
 // newSimpleNode creates a non-specific node.
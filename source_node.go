@@ -3,12 +3,34 @@ package gedcom
 // SourceNode represents a source.
 type SourceNode struct {
 	*SimpleNode
+	document *Document
 }
 
 func NewSourceNode(value, pointer string, children ...Node) *SourceNode {
+	return newSourceNode(nil, value, pointer, children...)
+}
+
+func newSourceNode(document *Document, value, pointer string, children ...Node) *SourceNode {
 	return &SourceNode{
-		newSimpleNode(TagSource, value, pointer, children...),
+		SimpleNode: newSimpleNode(TagSource, value, pointer, children...),
+		document:   document,
+	}
+}
+
+// Document returns the document that the source belongs to. It is used to
+// resolve the pointer to the repository.
+func (node *SourceNode) Document() *Document {
+	if node == nil {
+		return nil
 	}
+
+	return node.document
+}
+
+// ShallowCopy returns a SOUR with the same value and pointer that belongs to
+// the same document.
+func (node *SourceNode) ShallowCopy() Node {
+	return newSourceNode(node.Document(), node.Value(), node.Pointer())
 }
 
 // If the node is nil the result will be an empty string.
@@ -19,3 +41,16 @@ func (node *SourceNode) Title() string {
 
 	return ""
 }
+
+// Repository returns the repository that holds the source. A pointer to a REPO
+// record is resolved through the document.
+//
+// If the node is nil, there is no REPO or the pointer cannot be resolved the
+// result will be nil.
+func (node *SourceNode) Repository() *RepositoryNode {
+	if n, ok := First(NodesWithTag(node, TagRepository)).(*RepositoryNode); ok {
+		return n.Record()
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/elliotchance/gedcom/v39"
 	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSourceNode_Title(t *testing.T) {
@@ -12,3 +13,16 @@ func TestSourceNode_Title(t *testing.T) {
 
 	Title((*gedcom.SourceNode)(nil)).Returns("")
 }
+
+func TestSourceNode_Document(t *testing.T) {
+	Document := tf.Function(t, (*gedcom.SourceNode).Document)
+
+	document, err := gedcom.NewDocumentFromString("0 @S1@ SOUR\n")
+	require.NoError(t, err)
+	source := document.NodeByPointer("S1").(*gedcom.SourceNode)
+
+	Document((*gedcom.SourceNode)(nil)).Returns((*gedcom.Document)(nil))
+	Document(gedcom.NewSourceNode("", "S1")).Returns((*gedcom.Document)(nil))
+	Document(source).Returns(document)
+	Document(&gedcom.SourceNode{SimpleNode: source.SimpleNode}).Returns((*gedcom.Document)(nil))
+}
@@ -0,0 +1,62 @@
+package gedcom
+
+// SubmitterNode represents an individual or organization who contributes
+// genealogical data to a file or transfers it to someone else.
+//
+// The same node is used for the SUBM record and the SUBM in the HEAD that
+// points to it. The accessors of a pointer will return the values of the
+// record it points to.
+type SubmitterNode struct {
+	*simpleDocumentNode
+}
+
+// NewSubmitterNode creates a new SUBM node.
+func NewSubmitterNode(value, pointer string, children ...Node) *SubmitterNode {
+	return newSubmitterNode(nil, value, pointer, children...)
+}
+
+func newSubmitterNode(document *Document, value, pointer string, children ...Node) *SubmitterNode {
+	return &SubmitterNode{
+		newSimpleDocumentNode(document, TagSubmitter, value, pointer, children...),
+	}
+}
+
+// ShallowCopy returns a SUBM with the same value and pointer that belongs to
+// the same document.
+func (node *SubmitterNode) ShallowCopy() Node {
+	return newSubmitterNode(node.Document(), node.Value(), node.Pointer())
+}
+
+// Record returns the SUBM record. If the node is a pointer it will be
+// resolved, otherwise the node itself is returned.
+//
+// If the node is nil or the pointer cannot be resolved the result will be nil.
+func (node *SubmitterNode) Record() *SubmitterNode {
+	if node == nil {
+		return nil
+	}
+
+	if valueToPointer(node.value) == "" {
+		return node
+	}
+
+	if record, ok := nodeForPointerValue(node.document, node.value).(*SubmitterNode); ok {
+		return record
+	}
+
+	return nil
+}
+
+// Name is the name of the submitter.
+//
+// If the node is nil the result will be an empty string.
+func (node *SubmitterNode) Name() string {
+	return firstValueWithTag(node.Record(), TagName)
+}
+
+// Address is the full address of the submitter, which may span several lines.
+//
+// If the node is nil the result will be an empty string.
+func (node *SubmitterNode) Address() string {
+	return firstValueWithTag(node.Record(), TagAddress)
+}
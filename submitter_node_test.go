@@ -0,0 +1,40 @@
+package gedcom_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/gedcom/v39"
+	"github.com/elliotchance/tf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmitterNode_Name(t *testing.T) {
+	Name := tf.Function(t, (*gedcom.SubmitterNode).Name)
+
+	Name((*gedcom.SubmitterNode)(nil)).Returns("")
+	Name(gedcom.NewSubmitterNode("", "U1")).Returns("")
+	Name(gedcom.NewSubmitterNode("", "U1",
+		gedcom.NewNode(gedcom.TagName, "Bob Smith", ""))).Returns("Bob Smith")
+}
+
+func TestSubmitterNode_Address(t *testing.T) {
+	Address := tf.Function(t, (*gedcom.SubmitterNode).Address)
+
+	Address((*gedcom.SubmitterNode)(nil)).Returns("")
+	Address(gedcom.NewSubmitterNode("", "U1",
+		gedcom.NewNode(gedcom.TagAddress, "1 Main St", ""))).Returns("1 Main St")
+}
+
+func TestSubmitterNode_Record(t *testing.T) {
+	doc, err := gedcom.NewDocumentFromString(
+		"0 HEAD\n1 SUBM @U1@\n0 @U1@ SUBM\n1 NAME Bob Smith")
+	assert.NoError(t, err)
+
+	submitter := doc.Submitters()[0]
+	reference := doc.Nodes()[0].Nodes()[0].(*gedcom.SubmitterNode)
+
+	assert.Nil(t, (*gedcom.SubmitterNode)(nil).Record())
+	assert.Equal(t, submitter, submitter.Record())
+	assert.Equal(t, submitter, reference.Record())
+	assert.Equal(t, "Bob Smith", reference.Name())
+}
@@ -245,6 +245,9 @@ var (
 	// A name of an institution, agency, corporation, or company.
 	TagCorporate = newTag("CORP", "Corporate", tagOptionNone, tagSortIndividualInfo)
 
+	// The date of the creation of a record. New in GEDCOM 7.0.
+	TagCreation = newTag("CREA", "Creation", tagOptionNone, tagSortIndividualInfo)
+
 	// Disposal of the remains of a person's body by fire.
 	TagCremation = newTag("CREM", "Cremation", tagOptionEvent, tagSortIndividualEvents)
 
@@ -300,6 +303,10 @@ var (
 	// See EventNode.
 	TagEvent = newTag("EVEN", "Event", tagOptionEvent, tagSortIndividualEvents)
 
+	// An identifier for the record maintained by an external authority, such
+	// as FamilySearch. New in GEDCOM 7.0.
+	TagExternalIdentifier = newTag("EXID", "External Identifier", tagOptionNone, tagSortIndividualInfo)
+
 	// Pertaining to a noteworthy attribute or fact concerning an individual, a
 	// group, or an organization. A structure is usually qualified or classified
 	// by a subordinate use of the TYPE tag. New in Gedcom 5.5.1.
@@ -412,6 +419,9 @@ var (
 	// which information is stored.
 	TagMedia = newTag("MEDI", "Media", tagOptionNone, tagSortIndividualInfo)
 
+	// The media type (MIME type) of a file. New in GEDCOM 7.0.
+	TagMediaType = newTag("MIME", "Media Type", tagOptionNone, tagSortIndividualInfo)
+
 	// A word or combination of words used to help identify an individual,
 	// title, or other item. More than one NAME line should be used for people
 	// who were known by multiple names.
@@ -479,6 +489,11 @@ var (
 	// A unique number assigned to access a specific telephone.
 	TagPhone = newTag("PHON", "Phone", tagOptionNone, tagSortIndividualInfo)
 
+	// Textual information that cannot be expressed in the value of the
+	// superior structure, such as a date that cannot be parsed. New in GEDCOM
+	// 7.0.
+	TagPhrase = newTag("PHRASE", "Phrase", tagOptionNone, tagSortIndividualInfo)
+
 	// See PlaceNode.
 	TagPlace = newTag("PLAC", "Place", tagOptionNone, tagSortIndividualInfo)
 
@@ -546,6 +561,10 @@ var (
 	// See SexNode.
 	TagSex = newTag("SEX", "Sex", tagOptionNone, tagSortIndividualInfo)
 
+	// A list of the extension tags used in the document and the URIs that
+	// define them. New in GEDCOM 7.0. The URIs are retained but not used.
+	TagSchema = newTag("SCHMA", "Schema", tagOptionNone, tagSortIndividualInfo)
+
 	// A religious event pertaining to the sealing of a child to his or her
 	// parents in an LDS temple ceremony.
 	TagSealingChild = newTag("SLGC", "Sealing Child", tagOptionEvent, tagSortIndividualEvents)
@@ -554,6 +573,10 @@ var (
 	// LDS temple ceremony.
 	TagSealingSpouse = newTag("SLGS", "Sealing Spouse", tagOptionEvent, tagSortIndividualEvents)
 
+	// A note that is a record of its own and can be referenced from other
+	// structures. It replaces a NOTE record in GEDCOM 7.0.
+	TagSharedNote = newTag("SNOTE", "Shared Note", tagOptionNone, tagSortIndividualInfo)
+
 	// The initial or original material from which information was obtained.
 	TagSource = newTag("SOUR", "Source", tagOptionNone, tagSortIndividualInfo)
 
@@ -583,6 +606,9 @@ var (
 	// The NameNode provides a Surname() function.
 	TagSurname = newTag("SURN", "Surname", tagOptionNone, tagSortIndividualInfo)
 
+	// Defines an extension tag inside a SCHMA. New in GEDCOM 7.0.
+	TagExtensionTag = newTag("TAG", "Extension Tag", tagOptionNone, tagSortIndividualInfo)
+
 	// The name or code that represents the name a temple of the LDS Church.
 	TagTemple = newTag("TEMP", "Temple", tagOptionNone, tagSortIndividualInfo)
 
@@ -605,9 +631,17 @@ var (
 	// At level 0, specifies the end of a GEDCOM transmission.
 	TagTrailer = newTag("TRLR", "Trailer", tagOptionNone, tagSortIndividualInfo)
 
+	// A translation or transliteration of the superior value. It replaces FONE
+	// and ROMN in GEDCOM 7.0.
+	TagTranslation = newTag("TRAN", "Translation", tagOptionNone, tagSortIndividualInfo)
+
 	// See TypeNode.
 	TagType = newTag("TYPE", "Type", tagOptionNone, tagSortIndividualInfo)
 
+	// A globally unique identifier for the record. It replaces the unofficial
+	// _UID in GEDCOM 7.0.
+	TagUniqueIdentifier = newTag("UID", "Unique Identifier", tagOptionNone, tagSortIndividualInfo)
+
 	// Indicates which version of a product, item, or publication is being used
 	// or referenced.
 	TagVersion = newTag("VERS", "Version", tagOptionNone, tagSortIndividualInfo)
@@ -730,6 +764,11 @@ func Tags() []Tag {
 		TagSubmission, TagSurname, TagTemple, TagText, TagTime, TagTitle,
 		TagTrailer, TagType, TagVersion, TagWife, TagWWW, TagWill, TagLabel,
 
+		// New in GEDCOM 7.0
+		TagCreation, TagExternalIdentifier, TagMediaType, TagPhrase,
+		TagSchema, TagSharedNote, TagExtensionTag, TagTranslation,
+		TagUniqueIdentifier,
+
 		// Unofficial
 		UnofficialTagFamilySearchID1, UnofficialTagFamilySearchID2,
 		UnofficialTagLatitudeDegrees,
@@ -164,6 +164,20 @@ var tagTests = map[string]struct {
 	"WILL":  {y, y, y, &gedcom.TagWill, "Will"},
 	"WWW":   {y, y, n, &gedcom.TagWWW, "WWW"},
 
+	// New in GEDCOM 7.0
+	//         isKnown
+	//         |  isOfficial
+	//         |  |  isEvent
+	"CREA":   {y, y, n, &gedcom.TagCreation, "Creation"},
+	"EXID":   {y, y, n, &gedcom.TagExternalIdentifier, "External Identifier"},
+	"MIME":   {y, y, n, &gedcom.TagMediaType, "Media Type"},
+	"PHRASE": {y, y, n, &gedcom.TagPhrase, "Phrase"},
+	"SCHMA":  {y, y, n, &gedcom.TagSchema, "Schema"},
+	"SNOTE":  {y, y, n, &gedcom.TagSharedNote, "Shared Note"},
+	"TAG":    {y, y, n, &gedcom.TagExtensionTag, "Extension Tag"},
+	"TRAN":   {y, y, n, &gedcom.TagTranslation, "Translation"},
+	"UID":    {y, y, n, &gedcom.TagUniqueIdentifier, "Unique Identifier"},
+
 	// Unofficial
 	//          isKnown
 	//          |  isOfficial
@@ -280,6 +294,7 @@ func TestTags(t *testing.T) {
 			gedcom.TagCopyright,
 			gedcom.TagCorporate,
 			gedcom.TagCountry,
+			gedcom.TagCreation,
 			gedcom.TagData,
 			gedcom.TagDate,
 			gedcom.TagDescendants,
@@ -287,6 +302,8 @@ func TestTags(t *testing.T) {
 			gedcom.TagDestination,
 			gedcom.TagEducation,
 			gedcom.TagEmail,
+			gedcom.TagExtensionTag,
+			gedcom.TagExternalIdentifier,
 			gedcom.TagFact,
 			gedcom.TagFamily,
 			gedcom.TagFamilyChild,
@@ -309,6 +326,7 @@ func TestTags(t *testing.T) {
 			gedcom.TagMap,
 			gedcom.TagMarriageCount,
 			gedcom.TagMedia,
+			gedcom.TagMediaType,
 			gedcom.TagNamePrefix,
 			gedcom.TagNameSuffix,
 			gedcom.TagNationality,
@@ -321,6 +339,7 @@ func TestTags(t *testing.T) {
 			gedcom.TagPedigree,
 			gedcom.TagPhone,
 			gedcom.TagPhonetic,
+			gedcom.TagPhrase,
 			gedcom.TagPhysicalDescription,
 			gedcom.TagPlace,
 			gedcom.TagPostalCode,
@@ -336,7 +355,9 @@ func TestTags(t *testing.T) {
 			gedcom.TagRestriction,
 			gedcom.TagRole,
 			gedcom.TagRomanized,
+			gedcom.TagSchema,
 			gedcom.TagSex,
+			gedcom.TagSharedNote,
 			gedcom.TagSocialSecurityNumber,
 			gedcom.TagSource,
 			gedcom.TagState,
@@ -350,7 +371,9 @@ func TestTags(t *testing.T) {
 			gedcom.TagTime,
 			gedcom.TagTitle,
 			gedcom.TagTrailer,
+			gedcom.TagTranslation,
 			gedcom.TagType,
+			gedcom.TagUniqueIdentifier,
 			gedcom.TagVersion,
 			gedcom.TagWWW,
 			gedcom.TagWife,
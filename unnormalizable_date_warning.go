@@ -0,0 +1,29 @@
+package gedcom
+
+import (
+	"fmt"
+)
+
+// UnnormalizableDateWarning is produced by NormalizeDatesFilter when a date
+// cannot be written in the strict form of the GEDCOM standard without losing
+// some of its meaning.
+type UnnormalizableDateWarning struct {
+	SimpleWarning
+	Date   *DateNode
+	Reason error
+}
+
+func NewUnnormalizableDateWarning(date *DateNode, reason error) *UnnormalizableDateWarning {
+	return &UnnormalizableDateWarning{
+		Date:   date,
+		Reason: reason,
+	}
+}
+
+func (w *UnnormalizableDateWarning) Name() string {
+	return "UnnormalizableDate"
+}
+
+func (w *UnnormalizableDateWarning) String() string {
+	return fmt.Sprintf(`Cannot normalize date "%s": %s`, w.Date.Value(), w.Reason)
+}
@@ -4,10 +4,15 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 func valueToPointer(val string) string {
 	valLen := len(val)
+	if valLen < 3 {
+		return ""
+	}
+
 	firstCharIsAt := val[0] == '@'
 	lastCharIsAt := val[valLen-1] == '@'
 	if valLen > 2 && firstCharIsAt && lastCharIsAt {
@@ -17,6 +22,22 @@ func valueToPointer(val string) string {
 	return ""
 }
 
+// nodeForPointerValue returns the record that a value like "@R1@" points to. nil
+// is returned if there is no document, the value is not a pointer or the
+// pointer does not exist.
+//
+// In GEDCOM 7.0 "@VOID@" is a pointer to nothing, so it never points to a
+// record.
+func nodeForPointerValue(document *Document, value string) Node {
+	pointer := valueToPointer(value)
+	if document == nil || pointer == "" ||
+		(value == voidPointer && document.Version == GEDCOMVersion70) {
+		return nil
+	}
+
+	return document.NodeByPointer(pointer)
+}
+
 // Atoi is a fault tolerant way to convert a string to an integer.
 //
 // Atoi ultimately uses strconv.Atoi to do the conversion, but will clean the
@@ -155,6 +176,25 @@ func Pointer(node Node) string {
 	return node.Pointer()
 }
 
+// IsValidPointer returns true if the pointer can be written in a GEDCOM file,
+// like "I1" for "@I1@".
+//
+// The pointer must not be empty or contain a space or "@". A pointer that
+// starts with "#" would be confused with an escape, like "@#DJULIAN@".
+func IsValidPointer(pointer string) bool {
+	if pointer == "" || pointer[0] == '#' {
+		return false
+	}
+
+	for _, r := range pointer {
+		if r == '@' || unicode.IsSpace(r) || unicode.IsControl(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // String is a safe way to fetch the String() from a node. If the node is nil
 // then an empty string will be returned.
 func String(node Node) string {
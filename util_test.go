@@ -168,6 +168,19 @@ func TestPointer(t *testing.T) {
 	}
 }
 
+func TestIsValidPointer(t *testing.T) {
+	IsValidPointer := tf.Function(t, gedcom.IsValidPointer)
+
+	IsValidPointer("I1").Returns(true)
+	IsValidPointer("_c5a1b2").Returns(true)
+	IsValidPointer("KWCB-HZ5").Returns(true)
+	IsValidPointer("").Returns(false)
+	IsValidPointer("I2 x").Returns(false)
+	IsValidPointer("I@2").Returns(false)
+	IsValidPointer("I\t2").Returns(false)
+	IsValidPointer("#DJULIAN").Returns(false)
+}
+
 func TestDateAndPlace(t *testing.T) {
 	date3Sep1953 := gedcom.NewDateNode("3 Sep 1953")
 	date1Sep1953 := gedcom.NewDateNode("1 Sep 1953")
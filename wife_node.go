@@ -22,7 +22,7 @@ func (node *WifeNode) Individual() *IndividualNode {
 		return nil
 	}
 
-	n := node.family.document.NodeByPointer(valueToPointer(node.value))
+	n := nodeForPointerValue(node.family.document, node.value)
 
 	if IsNil(n) {
 		return nil